@@ -18,6 +18,8 @@ import (
 type File struct {
 	// Top-level declarations.
 	Decls []Decl
+	// Comment groups of the source file, in source order.
+	Comments []*CommentGroup
 }
 
 // A Node represents a node within the abstract syntax tree, and has one of the
@@ -31,7 +33,10 @@ type File struct {
 type Node interface {
 	fmt.Stringer
 	// Start returns the start position of the node within the input stream.
-	Start() int
+	Start() token.Pos
+	// End returns the position directly after the node within the input
+	// stream.
+	End() token.Pos
 }
 
 // A Decl node represents a declaration, and has one of the following underlying
@@ -78,7 +83,15 @@ type (
 	//
 	//    int puts(char s[]);
 	//    int add(int a, int b) { return a+b; }
+	//    static int sub(int a, int b) { return a-b; }
 	FuncDecl struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
+		// Position of storage-class specifier; only valid if Storage is not
+		// NoStorage.
+		StoragePos token.Pos
+		// Storage-class specifier.
+		Storage StorageClass
 		// Function signature.
 		FuncType *FuncType
 		// Function name.
@@ -94,7 +107,16 @@ type (
 	//
 	//    int x;
 	//    char buf[128];
+	//    extern int y;
 	VarDecl struct {
+		// Associated documentation; or nil. The variable declarations of a
+		// declaration with multiple declarators share the same documentation.
+		Doc *CommentGroup
+		// Position of storage-class specifier; only valid if Storage is not
+		// NoStorage.
+		StoragePos token.Pos
+		// Storage-class specifier.
+		Storage StorageClass
 		// Variable type.
 		VarType Type
 		// Variable name.
@@ -110,8 +132,10 @@ type (
 	//
 	//    typedef int foo;
 	TypeDef struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of `typedef` keyword.
-		Typedef int
+		Typedef token.Pos
 		// Underlying type of type definition.
 		DeclType Type
 		// Type name.
@@ -121,6 +145,33 @@ type (
 	}
 )
 
+// A StorageClass specifies the storage duration and linkage of a declared
+// identifier (see §6.7.1).
+type StorageClass uint8
+
+// Storage-class specifiers.
+const (
+	// NoStorage specifies the absence of a storage-class specifier.
+	NoStorage StorageClass = iota
+	// Extern specifies the "extern" storage-class.
+	Extern
+	// Static specifies the "static" storage-class.
+	Static
+)
+
+func (storage StorageClass) String() string {
+	switch storage {
+	case NoStorage:
+		return ""
+	case Extern:
+		return "extern"
+	case Static:
+		return "static"
+	default:
+		return fmt.Sprintf("unknown storage-class (%d)", uint8(storage))
+	}
+}
+
 // A Stmt node represents a statement, and has one of the following underlying
 // types.
 //
@@ -147,11 +198,11 @@ type (
 	//    { int x; x = 42; }
 	BlockStmt struct {
 		// Position of left-brace `{`.
-		Lbrace int
+		Lbrace token.Pos
 		// List of block items contained within the block.
 		Items []BlockItem
 		// Position of right-brace `}`.
-		Rbrace int
+		Rbrace token.Pos
 	}
 
 	// An EmptyStmt node represents an empty statement (i.e. ";").
@@ -161,7 +212,7 @@ type (
 	//    ;
 	EmptyStmt struct {
 		// Position of semicolon `;`.
-		Semicolon int
+		Semicolon token.Pos
 	}
 
 	// An ExprStmt node represents a stand-alone expression in a statement list.
@@ -173,6 +224,8 @@ type (
 	ExprStmt struct {
 		// Stand-alone expression.
 		X Expr
+		// Position of semicolon `;`.
+		Semicolon token.Pos
 	}
 
 	// An IfStmt node represents an if statement.
@@ -183,7 +236,7 @@ type (
 	//    if (i < max) { i; } else { max; }
 	IfStmt struct {
 		// Position of `if` keyword.
-		If int
+		If token.Pos
 		// Condition.
 		Cond Expr
 		// True branch.
@@ -200,9 +253,11 @@ type (
 	//    return 42;
 	ReturnStmt struct {
 		// Position of `return` keyword.
-		Return int
+		Return token.Pos
 		// Result expression; or nil if void return.
 		Result Expr
+		// Position of semicolon `;`.
+		Semicolon token.Pos
 	}
 
 	// A WhileStmt node represents a while statement.
@@ -212,7 +267,7 @@ type (
 	//    while (i < 10) { i++; }
 	WhileStmt struct {
 		// Position of `while` keyword.
-		While int
+		While token.Pos
 		// Condition.
 		Cond Expr
 		// Loop body.
@@ -259,7 +314,7 @@ type (
 	//    'a'
 	BasicLit struct {
 		// Position of basic literal.
-		ValPos int
+		ValPos token.Pos
 		// Basic literal type, one of the following.
 		//
 		//    token.CharLit
@@ -279,7 +334,7 @@ type (
 		// First operand.
 		X Expr
 		// Position of operator.
-		OpPos int
+		OpPos token.Pos
 		// Operator, one of the following.
 		//    token.Add      // +
 		//    token.Sub      // -
@@ -308,11 +363,11 @@ type (
 		// Function name.
 		Name *Ident
 		// Position of left-parenthesis `(`.
-		Lparen int
+		Lparen token.Pos
 		// Function arguments.
 		Args []Expr
 		// Position of right-parenthesis `)`.
-		Rparen int
+		Rparen token.Pos
 	}
 
 	// An Ident node represents an identifier.
@@ -323,7 +378,7 @@ type (
 	//    int
 	Ident struct {
 		// Position of identifier.
-		NamePos int
+		NamePos token.Pos
 		// Identifier name.
 		Name string
 		// Corresponding function, variable or type definition. The declaration
@@ -341,21 +396,21 @@ type (
 		// Array name.
 		Name *Ident
 		// Position of left-bracket `[`.
-		Lbracket int
+		Lbracket token.Pos
 		// Array index.
 		Index Expr
 		// Position of right-bracket `]`.
-		Rbracket int
+		Rbracket token.Pos
 	}
 
 	// A ParenExpr node represents a parenthesised expression.
 	ParenExpr struct {
 		// Position of left-parenthesis `(`.
-		Lparen int
+		Lparen token.Pos
 		// Parenthesised expression.
 		X Expr
 		// Position of right-parenthesis `)`.
-		Rparen int
+		Rparen token.Pos
 	}
 
 	// An UnaryExpr node represents an unary expression; op X.
@@ -366,7 +421,7 @@ type (
 	//    !(x == 3 || x == 10)
 	UnaryExpr struct {
 		// Position of unary operator.
-		OpPos int
+		OpPos token.Pos
 		// Operator, one of the following.
 		//    token.Sub   // -
 		//    token.Not   // !
@@ -382,6 +437,7 @@ type (
 //    *ArrayType
 //    *FuncType
 //    *Ident
+//    *QualType
 type Type interface {
 	Node
 	// isType ensures that only type nodes can be assigned to the Type interface.
@@ -400,11 +456,11 @@ type (
 		// Element type.
 		Elem Type
 		// Position of left-bracket `[`.
-		Lbracket int
+		Lbracket token.Pos
 		// Array length.
 		Len int
 		// Position of right-bracket `]`.
-		Rbracket int
+		Rbracket token.Pos
 	}
 
 	// A FuncType node represents a function signature.
@@ -417,11 +473,24 @@ type (
 		// Return type.
 		Result Type
 		// Position of left-parenthesis `(`.
-		Lparen int
+		Lparen token.Pos
 		// Function parameters.
 		Params []*VarDecl
 		// Position of right-parenthesis `)`.
-		Rparen int
+		Rparen token.Pos
+	}
+
+	// A QualType node represents a qualified type.
+	//
+	// Examples.
+	//
+	//    const int
+	//    const char
+	QualType struct {
+		// Position of `const` qualifier.
+		Const token.Pos
+		// Unqualified type.
+		Type Type
 	}
 )
 
@@ -482,6 +551,9 @@ func (n *File) String() string {
 
 func (n *FuncDecl) String() string {
 	buf := new(bytes.Buffer)
+	if n.Storage != NoStorage {
+		fmt.Fprintf(buf, "%v ", n.Storage)
+	}
 	fmt.Fprintf(buf, "%v %v(", n.FuncType.Result, n.FuncName)
 	for i, param := range n.FuncType.Params {
 		if i != 0 {
@@ -534,6 +606,10 @@ func (n *ParenExpr) String() string {
 	return fmt.Sprintf("(%v)", n.X)
 }
 
+func (n *QualType) String() string {
+	return fmt.Sprintf("const %v", n.Type)
+}
+
 func (n *ReturnStmt) String() string {
 	if n.Result != nil {
 		return fmt.Sprintf("return %v;", n.Result)
@@ -550,14 +626,18 @@ func (n *UnaryExpr) String() string {
 }
 
 func (n *VarDecl) String() string {
+	storage := ""
+	if n.Storage != NoStorage {
+		storage = n.Storage.String() + " "
+	}
 	switch typ := n.VarType.(type) {
 	case *ArrayType:
 		if typ.Len > 0 {
-			return fmt.Sprintf("%v %v[%d];", typ.Elem, n.VarName, typ.Len)
+			return fmt.Sprintf("%s%v %v[%d];", storage, typ.Elem, n.VarName, typ.Len)
 		}
-		return fmt.Sprintf("%v %v[];", typ.Elem, n.VarName)
+		return fmt.Sprintf("%s%v %v[];", storage, typ.Elem, n.VarName)
 	default:
-		return fmt.Sprintf("%v %v;", typ, n.VarName)
+		return fmt.Sprintf("%s%v %v;", storage, typ, n.VarName)
 	}
 }
 
@@ -566,42 +646,42 @@ func (n *WhileStmt) String() string {
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ArrayType) Start() int {
+func (n *ArrayType) Start() token.Pos {
 	return n.Elem.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *BasicLit) Start() int {
+func (n *BasicLit) Start() token.Pos {
 	return n.ValPos
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *BinaryExpr) Start() int {
+func (n *BinaryExpr) Start() token.Pos {
 	return n.X.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *BlockStmt) Start() int {
+func (n *BlockStmt) Start() token.Pos {
 	return n.Lbrace
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *CallExpr) Start() int {
+func (n *CallExpr) Start() token.Pos {
 	return n.Name.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *EmptyStmt) Start() int {
+func (n *EmptyStmt) Start() token.Pos {
 	return n.Semicolon
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ExprStmt) Start() int {
+func (n *ExprStmt) Start() token.Pos {
 	return n.X.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *File) Start() int {
+func (n *File) Start() token.Pos {
 	if len(n.Decls) > 0 {
 		return n.Decls[0].Start()
 	}
@@ -609,60 +689,196 @@ func (n *File) Start() int {
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *FuncDecl) Start() int {
+func (n *FuncDecl) Start() token.Pos {
+	if n.Storage != NoStorage {
+		return n.StoragePos
+	}
 	return n.FuncType.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *FuncType) Start() int {
+func (n *FuncType) Start() token.Pos {
 	return n.Result.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *Ident) Start() int {
+func (n *Ident) Start() token.Pos {
 	return n.NamePos
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *IfStmt) Start() int {
+func (n *IfStmt) Start() token.Pos {
 	return n.If
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *IndexExpr) Start() int {
+func (n *IndexExpr) Start() token.Pos {
 	return n.Name.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ParenExpr) Start() int {
+func (n *ParenExpr) Start() token.Pos {
 	return n.Lparen
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ReturnStmt) Start() int {
+func (n *QualType) Start() token.Pos {
+	return n.Const
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *ReturnStmt) Start() token.Pos {
 	return n.Return
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *TypeDef) Start() int {
+func (n *TypeDef) Start() token.Pos {
 	return n.Typedef
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *UnaryExpr) Start() int {
+func (n *UnaryExpr) Start() token.Pos {
 	return n.OpPos
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *VarDecl) Start() int {
+func (n *VarDecl) Start() token.Pos {
+	if n.Storage != NoStorage {
+		return n.StoragePos
+	}
 	return n.VarType.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *WhileStmt) Start() int {
+func (n *WhileStmt) Start() token.Pos {
 	return n.While
 }
 
+// End returns the position directly after the node within the input stream.
+func (n *ArrayType) End() token.Pos {
+	return n.Rbracket + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *BasicLit) End() token.Pos {
+	return n.ValPos + token.Pos(len(n.Val))
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *BinaryExpr) End() token.Pos {
+	return n.Y.End()
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *BlockStmt) End() token.Pos {
+	return n.Rbrace + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *CallExpr) End() token.Pos {
+	return n.Rparen + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *EmptyStmt) End() token.Pos {
+	return n.Semicolon + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *ExprStmt) End() token.Pos {
+	return n.Semicolon + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *File) End() token.Pos {
+	if len(n.Decls) > 0 {
+		return n.Decls[len(n.Decls)-1].End()
+	}
+	return 0
+}
+
+// End returns the position directly after the node within the input stream.
+//
+// The terminating semicolon of function declarations is not part of the node.
+func (n *FuncDecl) End() token.Pos {
+	if n.Body != nil {
+		return n.Body.End()
+	}
+	return n.FuncType.End()
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *FuncType) End() token.Pos {
+	return n.Rparen + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *Ident) End() token.Pos {
+	return n.NamePos + token.Pos(len(n.Name))
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *IfStmt) End() token.Pos {
+	if n.Else != nil {
+		return n.Else.End()
+	}
+	return n.Body.End()
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *IndexExpr) End() token.Pos {
+	return n.Rbracket + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *ParenExpr) End() token.Pos {
+	return n.Rparen + 1
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *QualType) End() token.Pos {
+	return n.Type.End()
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *ReturnStmt) End() token.Pos {
+	return n.Semicolon + 1
+}
+
+// End returns the position directly after the node within the input stream.
+//
+// The terminating semicolon of type definitions is not part of the node.
+func (n *TypeDef) End() token.Pos {
+	return n.TypeName.End()
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *UnaryExpr) End() token.Pos {
+	return n.X.End()
+}
+
+// End returns the position directly after the node within the input stream.
+//
+// The terminating semicolon of variable declarations is not part of the node,
+// as it is shared by the declarations of a declaration with multiple
+// declarators (e.g. `int a, b;`).
+func (n *VarDecl) End() token.Pos {
+	if n.VarName == nil {
+		// Anonymous parameter.
+		return n.VarType.End()
+	}
+	if end := n.VarType.End(); end > n.VarName.End() {
+		// Array declaration; e.g. `int a[10]`.
+		return end
+	}
+	return n.VarName.End()
+}
+
+// End returns the position directly after the node within the input stream.
+func (n *WhileStmt) End() token.Pos {
+	return n.Body.End()
+}
+
 // Verify that all nodes implement the Node interface.
 var (
 	_ Node = &ArrayType{}
@@ -679,6 +895,7 @@ var (
 	_ Node = &IfStmt{}
 	_ Node = &IndexExpr{}
 	_ Node = &ParenExpr{}
+	_ Node = &QualType{}
 	_ Node = &ReturnStmt{}
 	_ Node = &TypeDef{}
 	_ Node = &UnaryExpr{}
@@ -848,10 +1065,12 @@ var (
 func (n *Ident) isType()     {}
 func (n *ArrayType) isType() {}
 func (n *FuncType) isType()  {}
+func (n *QualType) isType()  {}
 
 // Verify that the type nodes implement the Type interface.
 var (
 	_ Type = &Ident{}
 	_ Type = &ArrayType{}
 	_ Type = &FuncType{}
+	_ Type = &QualType{}
 )
@@ -0,0 +1,139 @@
+// Package astdot implements output of parse trees in Graphviz DOT format.
+//
+// Each node of the parse tree is output as a box, labelled with the node type
+// (e.g. "BinaryExpr"), and for nodes carrying a value, the value of the node;
+// i.e. the name of identifiers, the value of basic literals, the operator of
+// unary and binary expressions, the storage-class of declarations and the
+// length of array types. Solid edges connect nodes to their children, in the
+// order traversed by astutil.Walk, e.g.
+//
+//    digraph {
+//       node [shape=box];
+//       n0 [label="File"];
+//       n1 [label="VarDecl"];
+//       n0 -> n1;
+//       n2 [label="Ident\nint"];
+//       n1 -> n2;
+//       ...
+//    }
+//
+// The identifier to declaration mapping of resolved parse trees is output as
+// dashed edges from identifiers to the declarations they refer to. Declarations
+// outside of the parse tree (e.g. the predeclared types of the universe scope)
+// are output as dashed boxes. Undeclared identifiers are output without
+// declaration edges.
+package astdot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem"
+)
+
+// Fprint writes the given parse tree in Graphviz DOT format to w, as a
+// directed graph.
+func Fprint(w io.Writer, node ast.Node) error {
+	bw := bufio.NewWriter(w)
+	p := &printer{w: bw, ids: make(map[ast.Node]int)}
+	fmt.Fprintln(p.w, "digraph {")
+	fmt.Fprintln(p.w, "   node [shape=box];")
+	if err := astutil.InspectBeforeAfter(node, p.before, p.after); err != nil {
+		return errutil.Err(err)
+	}
+	// Output declaration edges once all nodes of the parse tree are known.
+	for _, ident := range p.idents {
+		id, ok := p.ids[ident.Decl]
+		if !ok {
+			// Declarations outside of the parse tree are labelled by name, as
+			// their children are not output.
+			id = p.newID(ident.Decl)
+			fmt.Fprintf(p.w, "   n%d [label=%s, style=dashed];\n", id, quote(label(ident.Decl)+"\n"+ident.Decl.Name().Name))
+		}
+		fmt.Fprintf(p.w, "   n%d -> n%d [style=dashed, constraint=false];\n", p.ids[ident], id)
+	}
+	fmt.Fprintln(p.w, "}")
+	if err := bw.Flush(); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// A printer keeps track of the state of a Fprint operation.
+type printer struct {
+	// Output writer.
+	w io.Writer
+	// Map from nodes to node IDs.
+	ids map[ast.Node]int
+	// Stack of node IDs of the ancestors of the current node.
+	parents []int
+	// Resolved identifiers, which do not declare the declaration they refer to.
+	idents []*ast.Ident
+}
+
+// newID returns a new node ID for the given node.
+func (p *printer) newID(n ast.Node) int {
+	id := len(p.ids)
+	p.ids[n] = id
+	return id
+}
+
+// before outputs the given node and the edge from its parent.
+func (p *printer) before(n ast.Node) (bool, error) {
+	id := p.newID(n)
+	fmt.Fprintf(p.w, "   n%d [label=%s];\n", id, quote(label(n)))
+	if len(p.parents) > 0 {
+		fmt.Fprintf(p.w, "   n%d -> n%d;\n", p.parents[len(p.parents)-1], id)
+	}
+	if ident, ok := n.(*ast.Ident); ok && ident.Decl != nil && ident.Decl.Name() != ident && !sem.IsUndeclared(ident.Decl) {
+		p.idents = append(p.idents, ident)
+	}
+	p.parents = append(p.parents, id)
+	return true, nil
+}
+
+// after pops the given node from the stack of ancestors.
+func (p *printer) after(n ast.Node) error {
+	p.parents = p.parents[:len(p.parents)-1]
+	return nil
+}
+
+// label returns the label of the given node; i.e. its node type, followed by
+// its value, if any, on a separate line.
+func label(n ast.Node) string {
+	kind := strings.TrimPrefix(fmt.Sprintf("%T", n), "*ast.")
+	var val string
+	switch n := n.(type) {
+	case *ast.ArrayType:
+		if n.Len > 0 {
+			val = fmt.Sprint(n.Len)
+		}
+	case *ast.BasicLit:
+		val = n.Val
+	case *ast.BinaryExpr:
+		val = n.Op.String()
+	case *ast.FuncDecl:
+		val = n.Storage.String()
+	case *ast.Ident:
+		val = n.Name
+	case *ast.UnaryExpr:
+		val = n.Op.String()
+	case *ast.VarDecl:
+		val = n.Storage.String()
+	}
+	if len(val) > 0 {
+		return kind + "\n" + val
+	}
+	return kind
+}
+
+// quote returns the given string as a double-quoted DOT string.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
@@ -0,0 +1,103 @@
+package astdot_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mewmew/uc/ast/astdot"
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestFprint(t *testing.T) {
+	golden := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "int x;",
+			want: `digraph {
+   node [shape=box];
+   n0 [label="File"];
+   n1 [label="VarDecl"];
+   n0 -> n1;
+   n2 [label="Ident\nint"];
+   n1 -> n2;
+   n3 [label="Ident\nx"];
+   n1 -> n3;
+   n4 [label="TypeDef\nint", style=dashed];
+   n2 -> n4 [style=dashed, constraint=false];
+}
+`,
+		},
+		{
+			input: `static char c; int f(void) { c = '\n'; return -c; }`,
+			want: `digraph {
+   node [shape=box];
+   n0 [label="File"];
+   n1 [label="VarDecl\nstatic"];
+   n0 -> n1;
+   n2 [label="Ident\nchar"];
+   n1 -> n2;
+   n3 [label="Ident\nc"];
+   n1 -> n3;
+   n4 [label="FuncDecl"];
+   n0 -> n4;
+   n5 [label="Ident\nf"];
+   n4 -> n5;
+   n6 [label="FuncType"];
+   n4 -> n6;
+   n7 [label="Ident\nint"];
+   n6 -> n7;
+   n8 [label="VarDecl"];
+   n6 -> n8;
+   n9 [label="Ident\nvoid"];
+   n8 -> n9;
+   n10 [label="BlockStmt"];
+   n4 -> n10;
+   n11 [label="ExprStmt"];
+   n10 -> n11;
+   n12 [label="BinaryExpr\n="];
+   n11 -> n12;
+   n13 [label="Ident\nc"];
+   n12 -> n13;
+   n14 [label="BasicLit\n'\\n'"];
+   n12 -> n14;
+   n15 [label="ReturnStmt"];
+   n10 -> n15;
+   n16 [label="UnaryExpr\n-"];
+   n15 -> n16;
+   n17 [label="Ident\nc"];
+   n16 -> n17;
+   n18 [label="TypeDef\nchar", style=dashed];
+   n2 -> n18 [style=dashed, constraint=false];
+   n19 [label="TypeDef\nint", style=dashed];
+   n7 -> n19 [style=dashed, constraint=false];
+   n20 [label="TypeDef\nvoid", style=dashed];
+   n9 -> n20 [style=dashed, constraint=false];
+   n13 -> n1 [style=dashed, constraint=false];
+   n17 -> n1 [style=dashed, constraint=false];
+}
+`,
+		},
+	}
+	for _, g := range golden {
+		file, err := parser.ParseString(g.input, nil)
+		if err != nil {
+			t.Errorf("%q: unable to parse input; %v", g.input, err)
+			continue
+		}
+		if _, err := sem.Check(file); err != nil {
+			t.Errorf("%q: unable to resolve identifiers; %v", g.input, err)
+			continue
+		}
+		buf := &bytes.Buffer{}
+		if err := astdot.Fprint(buf, file); err != nil {
+			t.Errorf("%q: unable to output parse tree; %v", g.input, err)
+			continue
+		}
+		if got := buf.String(); got != g.want {
+			t.Errorf("%q: output mismatch; expected %s, got %s", g.input, g.want, got)
+		}
+	}
+}
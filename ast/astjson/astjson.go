@@ -0,0 +1,471 @@
+// Package astjson implements encoding and decoding of parse trees in JSON
+// format.
+//
+// Each node is encoded as a JSON object, the first members of which are the
+// node type discriminator "NodeType" (e.g. "BinaryExpr"), and the start and end
+// positions of the node within the input stream, "Start" and "End". The
+// remaining members correspond to the fields of the node, in declaration
+// order, using the field names of package ast; e.g.
+//
+//    {
+//       "NodeType": "BinaryExpr",
+//       "Start": 19,
+//       "End": 24,
+//       "X": {
+//          "NodeType": "Ident",
+//          "Start": 19,
+//          "End": 20,
+//          "NamePos": 19,
+//          "Name": "x",
+//          "Decl": 4
+//       },
+//       "OpPos": 21,
+//       "Op": "Assign",
+//       "Y": ...
+//    }
+//
+// Absent optional nodes are encoded as null. Token kinds and storage-classes
+// are encoded by name (e.g. "Assign", "IntLit" and "extern"), and comment
+// groups are encoded as a list of comments, each with a "Slash" position and a
+// "Text" member. As JSON strings are UTF-8 encoded, invalid UTF-8 within
+// identifiers, literals and comments is replaced by the Unicode replacement
+// character U+FFFD.
+//
+// The identifier to declaration mapping of resolved parse trees is encoded in
+// the "Decl" member of identifiers, as the position of the declared identifier
+// (-1 for predeclared types); or null if unresolved or undeclared. The "Decl"
+// member is not used by the decoder, which re-runs identifier resolution
+// instead.
+package astjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// Marshal returns the JSON encoding of the given parse tree, indented by
+// three spaces.
+func Marshal(node ast.Node) ([]byte, error) {
+	v, err := encodeNode(node)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	buf, err := json.MarshalIndent(v, "", "   ")
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return buf, nil
+}
+
+// Encode writes the JSON encoding of the given parse tree to w, followed by a
+// newline.
+func Encode(w io.Writer, node ast.Node) error {
+	buf, err := Marshal(node)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	buf = append(buf, '\n')
+	if _, err := w.Write(buf); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// Unmarshal parses the JSON encoding of a source file. The identifiers of the
+// returned file are resolved to their declarations by semantic analysis.
+//
+// Semantic errors of the source file are not reported by Unmarshal, as
+// identifier resolution continues past errors; undeclared identifiers are
+// bound to declarations of invalid type.
+func Unmarshal(data []byte) (*ast.File, error) {
+	node, err := decodeNode(data)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	file, ok := node.(*ast.File)
+	if !ok {
+		return nil, errutil.Newf("invalid node type; expected *ast.File, got %T", node)
+	}
+	shareDocs(file)
+	if _, err := sem.Check(file); err != nil {
+		if _, ok := err.(semerrors.List); !ok {
+			return nil, errutil.Err(err)
+		}
+	}
+	return file, nil
+}
+
+// Decode reads the JSON encoding of a source file from r. See Unmarshal for
+// details.
+func Decode(r io.Reader) (*ast.File, error) {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, errutil.Err(err)
+	}
+	return Unmarshal(buf.Bytes())
+}
+
+// nodeTypes maps from node type names to the underlying struct types of nodes.
+var nodeTypes = make(map[string]reflect.Type)
+
+func init() {
+	nodes := []ast.Node{
+		// Source file.
+		&ast.File{},
+		// Declarations.
+		&ast.FuncDecl{},
+		&ast.VarDecl{},
+		&ast.TypeDef{},
+		// Statements.
+		&ast.BlockStmt{},
+		&ast.EmptyStmt{},
+		&ast.ExprStmt{},
+		&ast.IfStmt{},
+		&ast.ReturnStmt{},
+		&ast.WhileStmt{},
+		// Expressions.
+		&ast.BasicLit{},
+		&ast.BinaryExpr{},
+		&ast.CallExpr{},
+		&ast.Ident{},
+		&ast.IndexExpr{},
+		&ast.ParenExpr{},
+		&ast.UnaryExpr{},
+		// Types.
+		&ast.ArrayType{},
+		&ast.FuncType{},
+		&ast.QualType{},
+	}
+	for _, n := range nodes {
+		t := reflect.TypeOf(n).Elem()
+		nodeTypes[t.Name()] = t
+	}
+	for kind := token.EOF; !strings.HasPrefix(kind.GoString(), "Kind("); kind++ {
+		kinds[kind.GoString()] = kind
+	}
+	for _, storage := range []ast.StorageClass{ast.NoStorage, ast.Extern, ast.Static} {
+		storageClasses[storage.String()] = storage
+	}
+}
+
+// kinds maps from token kind names to token kinds.
+var kinds = make(map[string]token.Kind)
+
+// storageClasses maps from storage-class names to storage-classes.
+var storageClasses = make(map[string]ast.StorageClass)
+
+// Reflection types of fields which require special handling.
+var (
+	nodeType         = reflect.TypeOf((*ast.Node)(nil)).Elem()
+	declType         = reflect.TypeOf((*ast.Decl)(nil)).Elem()
+	kindType         = reflect.TypeOf(token.Kind(0))
+	storageType      = reflect.TypeOf(ast.StorageClass(0))
+	commentGroupType = reflect.TypeOf((*ast.CommentGroup)(nil))
+)
+
+// === [ Encoding ] ===
+
+// An object is a JSON object with members in insertion order.
+type object []member
+
+// A member is a name/value pair of a JSON object.
+type member struct {
+	name string
+	val  interface{}
+}
+
+// MarshalJSON returns the JSON encoding of the object.
+func (obj object) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("{")
+	for i, m := range obj {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		name, err := json.Marshal(m.name)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		val, err := json.Marshal(m.val)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		buf.Write(name)
+		buf.WriteString(":")
+		buf.Write(val)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// encodeNode returns the JSON value of the given node; or nil if the node is
+// nil.
+func encodeNode(node ast.Node) (interface{}, error) {
+	if node == nil {
+		return nil, nil
+	}
+	v := reflect.ValueOf(node)
+	if v.Kind() != reflect.Ptr {
+		return nil, errutil.Newf("support for encoding node of type %T not yet implemented", node)
+	}
+	if v.IsNil() {
+		return nil, nil
+	}
+	v = v.Elem()
+	t := v.Type()
+	if nodeTypes[t.Name()] != t {
+		return nil, errutil.Newf("support for encoding node of type %T not yet implemented", node)
+	}
+	obj := object{
+		{name: "NodeType", val: t.Name()},
+		{name: "Start", val: node.Start()},
+		{name: "End", val: node.End()},
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch {
+		case field.Type == declType:
+			// Encode the identifier to declaration mapping as the position of
+			// the declared identifier.
+			var val interface{}
+			if decl, ok := v.Field(i).Interface().(ast.Decl); ok && decl != nil && !sem.IsUndeclared(decl) {
+				val = decl.Name().Start()
+			}
+			obj = append(obj, member{name: field.Name, val: val})
+			continue
+		case field.Name == "Val" && t.Name() == "TypeDef":
+			// Skip the types.Type of type definitions, which is derived from
+			// the declared type.
+			continue
+		}
+		val, err := encodeValue(v.Field(i))
+		if err != nil {
+			return nil, errutil.Newf("unable to encode field %s.%s; %v", t.Name(), field.Name, err)
+		}
+		obj = append(obj, member{name: field.Name, val: val})
+	}
+	return obj, nil
+}
+
+// encodeValue returns the JSON value of the given node field value.
+func encodeValue(v reflect.Value) (interface{}, error) {
+	switch {
+	case v.Type() == kindType:
+		return v.Interface().(token.Kind).GoString(), nil
+	case v.Type() == storageType:
+		return v.Interface().(ast.StorageClass).String(), nil
+	case v.Type() == commentGroupType:
+		return encodeCommentGroup(v.Interface().(*ast.CommentGroup)), nil
+	case v.Type().Implements(nodeType):
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeNode(v.Interface().(ast.Node))
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.String:
+		return v.Interface(), nil
+	case reflect.Slice:
+		vals := make([]interface{}, v.Len())
+		for i := range vals {
+			val, err := encodeValue(v.Index(i))
+			if err != nil {
+				return nil, errutil.Err(err)
+			}
+			vals[i] = val
+		}
+		return vals, nil
+	}
+	return nil, errutil.Newf("support for encoding value of type %v not yet implemented", v.Type())
+}
+
+// encodeCommentGroup returns the JSON value of the given comment group; or nil
+// if the comment group is nil.
+func encodeCommentGroup(group *ast.CommentGroup) interface{} {
+	if group == nil {
+		return nil
+	}
+	var comments []object
+	for _, c := range group.List {
+		comments = append(comments, object{
+			{name: "Slash", val: c.Slash},
+			{name: "Text", val: c.Text},
+		})
+	}
+	return comments
+}
+
+// === [ Decoding ] ===
+
+// decodeNode decodes the JSON encoding of a node; or returns nil if the JSON
+// value is null.
+func decodeNode(data []byte) (ast.Node, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, errutil.Err(err)
+	}
+	var name string
+	if err := json.Unmarshal(members["NodeType"], &name); err != nil {
+		return nil, errutil.Newf("invalid node type discriminator; %v", err)
+	}
+	t, ok := nodeTypes[name]
+	if !ok {
+		return nil, errutil.Newf("invalid node type %q", name)
+	}
+	v := reflect.New(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type == declType || (field.Name == "Val" && name == "TypeDef") {
+			// Derived fields, restored by semantic analysis.
+			continue
+		}
+		data, ok := members[field.Name]
+		if !ok {
+			return nil, errutil.Newf("missing field %s.%s", name, field.Name)
+		}
+		if err := decodeValue(v.Elem().Field(i), data); err != nil {
+			return nil, errutil.Newf("unable to decode field %s.%s; %v", name, field.Name, err)
+		}
+	}
+	return v.Interface().(ast.Node), nil
+}
+
+// decodeValue decodes the JSON encoding of a node field value, and stores the
+// result in v.
+func decodeValue(v reflect.Value, data []byte) error {
+	switch {
+	case v.Type() == kindType:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errutil.Err(err)
+		}
+		kind, ok := kinds[s]
+		if !ok {
+			return errutil.Newf("invalid token kind %q", s)
+		}
+		v.Set(reflect.ValueOf(kind))
+		return nil
+	case v.Type() == storageType:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errutil.Err(err)
+		}
+		storage, ok := storageClasses[s]
+		if !ok {
+			return errutil.Newf("invalid storage-class %q", s)
+		}
+		v.Set(reflect.ValueOf(storage))
+		return nil
+	case v.Type() == commentGroupType:
+		group, err := decodeCommentGroup(data)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		v.Set(reflect.ValueOf(group))
+		return nil
+	case v.Type().Implements(nodeType):
+		node, err := decodeNode(data)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		if node == nil {
+			return nil
+		}
+		val := reflect.ValueOf(node)
+		if !val.Type().AssignableTo(v.Type()) {
+			return errutil.Newf("invalid node type; expected %v, got %T", v.Type(), node)
+		}
+		v.Set(val)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.String:
+		return json.Unmarshal(data, v.Addr().Interface())
+	case reflect.Slice:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return errutil.Err(err)
+		}
+		if elems == nil {
+			return nil
+		}
+		s := reflect.MakeSlice(v.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := decodeValue(s.Index(i), elem); err != nil {
+				return errutil.Err(err)
+			}
+		}
+		v.Set(s)
+		return nil
+	}
+	return errutil.Newf("support for decoding value of type %v not yet implemented", v.Type())
+}
+
+// decodeCommentGroup decodes the JSON encoding of a comment group; or returns
+// nil if the JSON value is null.
+func decodeCommentGroup(data []byte) (*ast.CommentGroup, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+	group := new(ast.CommentGroup)
+	if err := json.Unmarshal(data, &group.List); err != nil {
+		return nil, errutil.Err(err)
+	}
+	if len(group.List) == 0 {
+		return nil, errutil.Newf("invalid comment group; empty list of comments")
+	}
+	return group, nil
+}
+
+// shareDocs replaces the doc comments of declarations with the corresponding
+// comment groups of the given file, as doc comments are encoded once per
+// declaration.
+func shareDocs(file *ast.File) {
+	groups := make(map[token.Pos]*ast.CommentGroup)
+	for _, group := range file.Comments {
+		groups[group.Start()] = group
+	}
+	share := func(doc **ast.CommentGroup) {
+		if *doc == nil {
+			return
+		}
+		if group, ok := groups[(*doc).Start()]; ok {
+			*doc = group
+		} else {
+			groups[(*doc).Start()] = *doc
+		}
+	}
+	f := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			share(&n.Doc)
+		case *ast.VarDecl:
+			share(&n.Doc)
+		case *ast.TypeDef:
+			share(&n.Doc)
+		case ast.Expr:
+			// Expressions contain no declarations.
+			return false, nil
+		}
+		return true, nil
+	}
+	astutil.Inspect(file, f)
+}
+
+// isNull reports whether the given JSON value is null.
+func isNull(data []byte) bool {
+	return len(data) == 0 || string(bytes.TrimSpace(data)) == "null"
+}
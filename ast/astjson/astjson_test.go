@@ -0,0 +1,136 @@
+package astjson_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astjson"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob("../../testdata/*/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		if !utf8.Valid(buf) {
+			// Skip files which are not UTF-8 encoded, as JSON strings cannot
+			// represent invalid UTF-8.
+			continue
+		}
+		file, err := parser.ParseString(string(buf), nil)
+		if err != nil {
+			// Skip files containing syntax errors.
+			continue
+		}
+		// Resolve identifiers, so that the identifier to declaration mapping is
+		// part of the encoding.
+		sem.Check(file)
+		want, err := astjson.Marshal(file)
+		if err != nil {
+			t.Errorf("%q: unable to encode file; %v", path, err)
+			continue
+		}
+		decoded, err := astjson.Unmarshal(want)
+		if err != nil {
+			t.Errorf("%q: unable to decode file; %v", path, err)
+			continue
+		}
+		if !astutil.Equal(file, decoded) {
+			t.Errorf("%q: AST mismatch after round-trip", path)
+		}
+		got, err := astjson.Marshal(decoded)
+		if err != nil {
+			t.Errorf("%q: unable to encode decoded file; %v", path, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q: JSON encoding mismatch after round-trip", path)
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	const input = "int x; int f(int x) { return x; } int g(void) { return x; }"
+	file, err := parser.ParseString(input, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := astjson.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := astjson.Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Locate the identifiers of the return statements, and verify that they
+	// have been resolved to the parameter and the global variable respectively.
+	var results []*ast.Ident
+	for _, decl := range decoded.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			ret := fn.Body.Items[0].(*ast.ReturnStmt)
+			results = append(results, ret.Result.(*ast.Ident))
+		}
+	}
+	fn := decoded.Decls[1].(*ast.FuncDecl)
+	golden := []struct {
+		ident *ast.Ident
+		want  ast.Decl
+	}{
+		{ident: results[0], want: fn.FuncType.Params[0]},
+		{ident: results[1], want: decoded.Decls[0]},
+	}
+	for i, g := range golden {
+		if g.ident.Decl != g.want {
+			t.Errorf("i=%d: declaration mismatch of %q; expected %v, got %v", i, g.ident, g.want, g.ident.Decl)
+		}
+	}
+}
+
+func TestUnmarshalError(t *testing.T) {
+	golden := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: `{"NodeType": "Foo"}`,
+			want:  `invalid node type "Foo"`,
+		},
+		{
+			input: `{"NodeType": "Ident", "Start": 0, "End": 1, "NamePos": 0, "Name": "x"}`,
+			want:  "invalid node type; expected *ast.File, got *ast.Ident",
+		},
+		{
+			input: `{"NodeType": "File", "Decls": [{"NodeType": "EmptyStmt", "Semicolon": 0}], "Comments": null}`,
+			want:  "invalid node type; expected ast.Decl, got *ast.EmptyStmt",
+		},
+		{
+			input: `{"NodeType": "File", "Decls": []}`,
+			want:  "missing field File.Comments",
+		},
+	}
+	for i, g := range golden {
+		_, err := astjson.Unmarshal([]byte(g.input))
+		if err == nil {
+			t.Errorf("i=%d: expected error %q, got nil", i, g.want)
+			continue
+		}
+		if got := err.Error(); !strings.HasSuffix(got, g.want) {
+			t.Errorf("i=%d: error mismatch; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package astutil
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/mewmew/uc/ast"
+)
+
+// An ApplyFunc is invoked by Apply for each node n, even if n is nil, before
+// and/or after the node's children, using a Cursor describing the current node
+// and providing operations on it.
+//
+// The return value of ApplyFunc controls the syntax tree traversal. See Apply
+// for details.
+type ApplyFunc func(*Cursor) bool
+
+// Apply traverses the given parse tree recursively, starting with root, and
+// calling pre and post for each node as described below. Apply returns the
+// parse tree, possibly modified. The root node is replaced if pre or post
+// calls Replace on the root.
+//
+// If pre is not nil, it is called for each node before the node's children are
+// traversed (pre-order). If pre returns false, no children are traversed, and
+// post is not called for that node.
+//
+// If post is not nil, and a prior call of pre did not return false, post is
+// called for each node after its children are traversed (post-order). If post
+// returns false, traversal is terminated and Apply returns immediately.
+//
+// Only fields that refer to AST nodes are considered children; i.e. comments
+// are not traversed. Children are traversed in the same order as by Walk.
+//
+// The Cursor operations are used to replace, delete or insert nodes, and take
+// effect immediately. Nodes inserted or replacing the current node are not
+// traversed. If pre replaces or deletes the current node, the children of the
+// replaced or deleted node are not traversed, and post is not called for the
+// node.
+func Apply(root ast.Node, pre, post ApplyFunc) (result ast.Node) {
+	parent := &rootParent{Node: root}
+	defer func() {
+		if e := recover(); e != nil && e != errAbort {
+			panic(e)
+		}
+		result = parent.Node
+	}()
+	a := &application{pre: pre, post: post}
+	a.apply(parent, "Node", nil, root)
+	return parent.Node
+}
+
+// errAbort is raised (using panic) by post to terminate the traversal of Apply.
+var errAbort = new(int)
+
+// A rootParent is the pseudo-parent of the root node traversed by Apply.
+type rootParent struct {
+	ast.Node
+}
+
+// A Cursor describes a node encountered during Apply. Information about the
+// node and its parent is available through the Node, Parent, Name and Index
+// methods.
+//
+// If p is a variable of type and value of the current parent node c.Parent(),
+// and f is the field identifier with name c.Name(), the following invariants
+// hold:
+//
+//    p.f            == c.Node()  if c.Index() <  0
+//    p.f[c.Index()] == c.Node()  if c.Index() >= 0
+//
+// The methods Replace, Delete, InsertBefore and InsertAfter are used to modify
+// the parse tree at the position of the current node.
+type Cursor struct {
+	// Parent of the current node.
+	parent ast.Node
+	// Name of the parent field containing the current node.
+	name string
+	// Iterator of the parent slice containing the current node; or nil if the
+	// current node is not part of a slice.
+	iter *iterator
+	// Current node.
+	node ast.Node
+}
+
+// An iterator keeps track of the position within a slice of nodes.
+type iterator struct {
+	// Index of the current node.
+	index int
+	// Number of slice elements to advance after traversing the current node.
+	step int
+}
+
+// Node returns the current node.
+func (c *Cursor) Node() ast.Node {
+	return c.node
+}
+
+// Parent returns the parent of the current node; or nil if the current node is
+// the root of the traversal.
+func (c *Cursor) Parent() ast.Node {
+	if _, ok := c.parent.(*rootParent); ok {
+		return nil
+	}
+	return c.parent
+}
+
+// Name returns the name of the parent node field that contains the current
+// node; e.g. "Items" for block items, or "X" for the first operand of binary
+// expressions.
+func (c *Cursor) Name() string {
+	return c.name
+}
+
+// Index reports the index of the current node within the slice of the parent
+// node field that contains it, or a value < 0 if the current node is not part
+// of a slice.
+func (c *Cursor) Index() int {
+	if c.iter != nil {
+		return c.iter.index
+	}
+	return -1
+}
+
+// field returns the parent node field containing the current node.
+func (c *Cursor) field() reflect.Value {
+	return reflect.Indirect(reflect.ValueOf(c.parent)).FieldByName(c.name)
+}
+
+// Replace replaces the current node with n. The replacement node is not
+// traversed by Apply.
+func (c *Cursor) Replace(n ast.Node) {
+	v := c.field()
+	if i := c.Index(); i >= 0 {
+		v = v.Index(i)
+	}
+	v.Set(nodeValue(n, v.Type()))
+	c.node = n
+}
+
+// Delete deletes the current node from its containing slice; e.g. a
+// declaration, a block item, an argument or a parameter. If the current node
+// is not part of a slice, the parent node field containing it is set to nil,
+// which is only valid for optional fields; e.g. the false branch of an if
+// statement, or the result of a return statement. Node returns nil after
+// Delete.
+func (c *Cursor) Delete() {
+	v := c.field()
+	i := c.Index()
+	if i < 0 {
+		v.Set(reflect.Zero(v.Type()))
+		c.node = nil
+		return
+	}
+	l := v.Len()
+	reflect.Copy(v.Slice(i, l), v.Slice(i+1, l))
+	v.Index(l - 1).Set(reflect.Zero(v.Type().Elem()))
+	v.SetLen(l - 1)
+	c.iter.step--
+	c.node = nil
+}
+
+// InsertAfter inserts n after the current node in its containing slice. The
+// inserted node is not traversed by Apply. InsertAfter panics if the current
+// node is not part of a slice.
+func (c *Cursor) InsertAfter(n ast.Node) {
+	i := c.Index()
+	if i < 0 {
+		panic(fmt.Sprintf("unable to insert after %T node in field %q; node not part of slice", c.node, c.name))
+	}
+	v := c.field()
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	l := v.Len()
+	reflect.Copy(v.Slice(i+2, l), v.Slice(i+1, l))
+	v.Index(i + 1).Set(nodeValue(n, v.Type().Elem()))
+	c.iter.step++
+}
+
+// InsertBefore inserts n before the current node in its containing slice. The
+// inserted node is not traversed by Apply. InsertBefore panics if the current
+// node is not part of a slice.
+func (c *Cursor) InsertBefore(n ast.Node) {
+	i := c.Index()
+	if i < 0 {
+		panic(fmt.Sprintf("unable to insert before %T node in field %q; node not part of slice", c.node, c.name))
+	}
+	v := c.field()
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	l := v.Len()
+	reflect.Copy(v.Slice(i+1, l), v.Slice(i, l))
+	v.Index(i).Set(nodeValue(n, v.Type().Elem()))
+	c.iter.index++
+}
+
+// nodeValue returns the value of n to be stored in a field of the given type.
+func nodeValue(n ast.Node, typ reflect.Type) reflect.Value {
+	if n == nil {
+		return reflect.Zero(typ)
+	}
+	return reflect.ValueOf(n)
+}
+
+// An application keeps track of the state of an Apply traversal.
+type application struct {
+	// Functions invoked before and after traversing the children of nodes.
+	pre, post ApplyFunc
+	// Cursor of the current node; reused to avoid allocations.
+	cursor Cursor
+	// Iterator of the current slice; reused to avoid allocations.
+	iter iterator
+}
+
+// apply traverses the given node, located in the named field of parent (at the
+// position of iter if the field is a slice).
+func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.Node) {
+	// Nil pointers of optional fields (e.g. the body of function declarations)
+	// are presented as nil nodes.
+	if isNil(n) {
+		n = nil
+	}
+	saved := a.cursor
+	a.cursor.parent = parent
+	a.cursor.name = name
+	a.cursor.iter = iter
+	a.cursor.node = n
+	if a.pre != nil && (!a.pre(&a.cursor) || a.cursor.node != n) {
+		// Skip the children of nodes replaced or deleted by pre.
+		a.cursor = saved
+		return
+	}
+
+	// Traverse children, in the same order as Walk.
+	switch n := n.(type) {
+	case nil:
+		// Nothing to do.
+
+	// Source file.
+	case *ast.File:
+		a.applyList(n, "Decls")
+
+	// Declarations.
+	case *ast.FuncDecl:
+		a.apply(n, "FuncName", nil, n.FuncName)
+		a.apply(n, "FuncType", nil, n.FuncType)
+		a.apply(n, "Body", nil, n.Body)
+	case *ast.VarDecl:
+		a.apply(n, "VarType", nil, n.VarType)
+		a.apply(n, "VarName", nil, n.VarName)
+		a.apply(n, "Val", nil, n.Val)
+	case *ast.TypeDef:
+		a.apply(n, "DeclType", nil, n.DeclType)
+		a.apply(n, "TypeName", nil, n.TypeName)
+
+	// Statements.
+	case *ast.BlockStmt:
+		a.applyList(n, "Items")
+	case *ast.EmptyStmt:
+		// Nothing to do.
+	case *ast.ExprStmt:
+		a.apply(n, "X", nil, n.X)
+	case *ast.IfStmt:
+		a.apply(n, "Cond", nil, n.Cond)
+		a.apply(n, "Body", nil, n.Body)
+		a.apply(n, "Else", nil, n.Else)
+	case *ast.ReturnStmt:
+		a.apply(n, "Result", nil, n.Result)
+	case *ast.WhileStmt:
+		a.apply(n, "Cond", nil, n.Cond)
+		a.apply(n, "Body", nil, n.Body)
+
+	// Expressions.
+	case *ast.BasicLit:
+		// Nothing to do.
+	case *ast.BinaryExpr:
+		a.apply(n, "X", nil, n.X)
+		a.apply(n, "Y", nil, n.Y)
+	case *ast.CallExpr:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Args")
+	case *ast.Ident:
+		// Nothing to do.
+	case *ast.IndexExpr:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Index", nil, n.Index)
+	case *ast.ParenExpr:
+		a.apply(n, "X", nil, n.X)
+	case *ast.UnaryExpr:
+		a.apply(n, "X", nil, n.X)
+
+	// Types.
+	case *ast.ArrayType:
+		a.apply(n, "Elem", nil, n.Elem)
+	case *ast.FuncType:
+		a.apply(n, "Result", nil, n.Result)
+		a.applyList(n, "Params")
+	case *ast.QualType:
+		a.apply(n, "Type", nil, n.Type)
+
+	default:
+		panic(fmt.Sprintf("support for applying node of type %T not yet implemented", n))
+	}
+
+	if a.post != nil && !a.post(&a.cursor) {
+		panic(errAbort)
+	}
+	a.cursor = saved
+}
+
+// applyList traverses the nodes of the named slice field of parent.
+func (a *application) applyList(parent ast.Node, name string) {
+	saved := a.iter
+	a.iter.index = 0
+	for {
+		// Reload the slice on each iteration, as it may be modified by cursor
+		// operations.
+		v := reflect.Indirect(reflect.ValueOf(parent)).FieldByName(name)
+		if a.iter.index >= v.Len() {
+			break
+		}
+		var n ast.Node
+		if e := v.Index(a.iter.index); !e.IsNil() {
+			n = e.Interface().(ast.Node)
+		}
+		a.iter.step = 1
+		a.apply(parent, name, &a.iter, n)
+		a.iter.index += a.iter.step
+	}
+	a.iter = saved
+}
@@ -0,0 +1,249 @@
+package astutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/printer"
+	"github.com/mewmew/uc/token"
+)
+
+func TestApply(t *testing.T) {
+	var golden = []struct {
+		name      string
+		input     string
+		pre, post astutil.ApplyFunc
+		want      string
+	}{
+		// Replace expression operands.
+		{
+			name:  "remove parentheses",
+			input: "int f(int x) { x = (1 + (2)) * ((x)); return (f((x))); }",
+			post: func(c *astutil.Cursor) bool {
+				if paren, ok := c.Node().(*ast.ParenExpr); ok {
+					if _, ok := paren.X.(*ast.BinaryExpr); !ok {
+						c.Replace(paren.X)
+					}
+				}
+				return true
+			},
+			want: `int f(int x) {
+	x = (1 + 2) * x;
+	return f(x);
+}
+`,
+		},
+		// Delete and insert block items.
+		{
+			name:  "delete and insert block items",
+			input: "void flush(void); void f(void) { ; flush(); ; while (1) { ; return; } }",
+			pre: func(c *astutil.Cursor) bool {
+				switch n := c.Node().(type) {
+				case *ast.EmptyStmt:
+					c.Delete()
+				case *ast.ReturnStmt:
+					c.InsertBefore(&ast.ExprStmt{X: call("flush")})
+					c.InsertAfter(&ast.EmptyStmt{})
+				case *ast.ExprStmt:
+					if _, ok := n.X.(*ast.CallExpr); ok {
+						// Not traversed, as inserted nodes are skipped.
+						c.InsertAfter(&ast.ExprStmt{X: call("f")})
+					}
+				}
+				return true
+			},
+			want: `void flush(void);
+
+void f(void) {
+	flush();
+	f();
+	while (1) {
+		flush();
+		return;
+		;
+	}
+}
+`,
+		},
+		// Delete, insert and replace call arguments.
+		{
+			name:  "call arguments",
+			input: "int g(int a, int b, int c); int f(int x) { return g(1, 2, 3); }",
+			pre: func(c *astutil.Cursor) bool {
+				if lit, ok := c.Node().(*ast.BasicLit); ok && c.Name() == "Args" {
+					switch lit.Val {
+					case "1":
+						c.InsertBefore(&ast.Ident{Name: "x"})
+						c.Delete()
+					case "2":
+						c.Replace(&ast.UnaryExpr{Op: token.Sub, X: lit})
+					case "3":
+						c.InsertAfter(&ast.BasicLit{Kind: token.IntLit, Val: "4"})
+					}
+				}
+				return true
+			},
+			want: `int g(int a, int b, int c);
+
+int f(int x) {
+	return g(x, -2, 3, 4);
+}
+`,
+		},
+		// Delete and insert top-level declarations, and fill optional fields.
+		{
+			name:  "declarations",
+			input: "typedef int foo; int x; int main(void) { if (x) return 1; return; }",
+			pre: func(c *astutil.Cursor) bool {
+				switch n := c.Node().(type) {
+				case *ast.TypeDef:
+					c.Delete()
+				case *ast.VarDecl:
+					if c.Name() == "Decls" {
+						y := &ast.VarDecl{VarType: &ast.Ident{Name: "char"}, VarName: &ast.Ident{Name: "y"}}
+						c.InsertAfter(y)
+					}
+				case *ast.IfStmt:
+					n.Else = &ast.ReturnStmt{Result: &ast.BasicLit{Kind: token.IntLit, Val: "2"}}
+				case *ast.ReturnStmt:
+					if n.Result != nil {
+						return false
+					}
+				case nil:
+					if _, ok := c.Parent().(*ast.ReturnStmt); ok && c.Name() == "Result" {
+						c.Replace(&ast.BasicLit{Kind: token.IntLit, Val: "0"})
+					}
+				}
+				return true
+			},
+			want: `int x;
+char y;
+
+int main(void) {
+	if (x)
+		return 1;
+	else
+		return 2;
+	return 0;
+}
+`,
+		},
+		// Delete optional fields.
+		{
+			name:  "delete optional field",
+			input: "int main(void) { if (1) return 1; else return 2; }",
+			post: func(c *astutil.Cursor) bool {
+				if c.Name() == "Else" && c.Node() != nil {
+					c.Delete()
+				}
+				return true
+			},
+			want: `int main(void) {
+	if (1)
+		return 1;
+}
+`,
+		},
+		// Replace nodes in pre.
+		{
+			name:  "replace in pre",
+			input: "int f(int x) { return (x); }",
+			pre: func(c *astutil.Cursor) bool {
+				switch n := c.Node().(type) {
+				case *ast.ParenExpr:
+					// The replacement node is not traversed.
+					c.Replace(n.X)
+				case *ast.Ident:
+					if n.Name == "x" {
+						n.Name = "y"
+					}
+				}
+				return true
+			},
+			want: `int f(int y) {
+	return x;
+}
+`,
+		},
+		// Abort traversal.
+		{
+			name:  "abort",
+			input: "int a; int b; int c;",
+			post: func(c *astutil.Cursor) bool {
+				if decl, ok := c.Node().(*ast.VarDecl); ok {
+					decl.VarName.Name += "2"
+					return decl.VarName.Name != "b2"
+				}
+				return true
+			},
+			want: `int a2;
+int b2;
+int c;
+`,
+		},
+	}
+
+	for _, g := range golden {
+		file, err := parser.ParseString(g.input, nil)
+		if err != nil {
+			t.Errorf("%s: unable to parse input; %v", g.name, err)
+			continue
+		}
+		root := astutil.Apply(file, g.pre, g.post)
+		buf := new(bytes.Buffer)
+		if err := printer.Fprint(buf, nil, root); err != nil {
+			t.Errorf("%s: %v", g.name, err)
+			continue
+		}
+		if got := buf.String(); got != g.want {
+			t.Errorf("%s: output mismatch; expected `%v`, got `%v`", g.name, g.want, got)
+		}
+	}
+}
+
+func TestApplyReplaceRoot(t *testing.T) {
+	x := &ast.BinaryExpr{X: &ast.Ident{Name: "x"}, Op: token.Add, Y: &ast.BasicLit{Kind: token.IntLit, Val: "0"}}
+	post := func(c *astutil.Cursor) bool {
+		if expr, ok := c.Node().(*ast.BinaryExpr); ok && c.Parent() == nil {
+			// Simplify `x + 0` to `x`.
+			c.Replace(expr.X)
+		}
+		return true
+	}
+	got := astutil.Apply(x, nil, post)
+	if ident, ok := got.(*ast.Ident); !ok || ident.Name != "x" {
+		t.Errorf("root mismatch; expected `x`, got `%v`", got)
+	}
+}
+
+func TestApplyDelete(t *testing.T) {
+	file, err := parser.ParseString("void f(void) { ; return; }", nil)
+	if err != nil {
+		t.Fatalf("unable to parse input; %v", err)
+	}
+	pre := func(c *astutil.Cursor) bool {
+		if _, ok := c.Node().(*ast.EmptyStmt); ok {
+			c.Delete()
+			if c.Node() != nil {
+				t.Errorf("node mismatch; expected nil after delete, got %T", c.Node())
+			}
+		}
+		return true
+	}
+	post := func(c *astutil.Cursor) bool {
+		if c.Name() == "Items" && c.Node() == nil {
+			t.Errorf("post called for deleted block item %d", c.Index())
+		}
+		return true
+	}
+	astutil.Apply(file, pre, post)
+}
+
+// call returns a new call expression of the named function, without
+// arguments.
+func call(name string) *ast.CallExpr {
+	return &ast.CallExpr{Name: &ast.Ident{Name: name}}
+}
@@ -5,8 +5,10 @@ import "github.com/mewmew/uc/ast"
 
 // IsDef reports whether the given declaration is a definition.
 func IsDef(decl ast.Decl) bool {
-	if _, ok := decl.(*ast.VarDecl); ok {
-		return true
+	if decl, ok := decl.(*ast.VarDecl); ok {
+		// Variable declarations with the storage-class specifier extern and
+		// without an initializer refer to variables defined elsewhere.
+		return decl.Storage != ast.Extern || decl.Val != nil
 	}
 	return decl.Value() != nil
 }
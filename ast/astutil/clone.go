@@ -0,0 +1,99 @@
+package astutil
+
+import (
+	"reflect"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/types"
+)
+
+// Clone returns a deep copy of the given parse tree.
+//
+// Identifiers referring to declarations within the parse tree are mapped to the
+// corresponding declarations of the copy, while identifiers referring to
+// declarations outside of the parse tree (e.g. the predeclared types of the
+// universe scope) keep referring to the original declarations. Comment groups
+// shared between nodes (e.g. doc comments and the comments of a file) are
+// shared within the copy as well. The cached type of type definitions is not
+// copied, as types are immutable.
+func Clone(node ast.Node) ast.Node {
+	if isNil(node) {
+		return node
+	}
+	c := &cloner{copies: make(map[interface{}]reflect.Value)}
+	n := c.clone(reflect.ValueOf(node)).Interface().(ast.Node)
+	// Map declaration links to the copied declarations.
+	for _, ident := range c.idents {
+		if decl, ok := c.copies[ident.Decl]; ok {
+			ident.Decl = decl.Interface().(ast.Decl)
+		}
+	}
+	return n
+}
+
+// Reflection types of fields which are not traversed by Clone and Equal.
+var (
+	// Identifier to declaration mapping.
+	declType = reflect.TypeOf((*ast.Decl)(nil)).Elem()
+	// Cached type of type definitions.
+	typeType = reflect.TypeOf((*types.Type)(nil)).Elem()
+)
+
+// A cloner keeps track of the state of a Clone operation.
+type cloner struct {
+	// Map from original pointers to their copies.
+	copies map[interface{}]reflect.Value
+	// Identifiers of the copy.
+	idents []*ast.Ident
+}
+
+// clone returns a deep copy of the given value.
+func (c *cloner) clone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		if dup, ok := c.copies[v.Interface()]; ok {
+			return dup
+		}
+		dup := reflect.New(v.Type().Elem())
+		c.copies[v.Interface()] = dup
+		dup.Elem().Set(c.clone(v.Elem()))
+		if ident, ok := dup.Interface().(*ast.Ident); ok && ident.Decl != nil {
+			c.idents = append(c.idents, ident)
+		}
+		return dup
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		dup := reflect.New(v.Type()).Elem()
+		dup.Set(c.clone(v.Elem()))
+		return dup
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		dup := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			dup.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return dup
+	case reflect.Struct:
+		dup := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			switch field.Type() {
+			case declType, typeType:
+				// Shallow copy; declaration links are mapped by Clone.
+				dup.Field(i).Set(field)
+			default:
+				dup.Field(i).Set(c.clone(field))
+			}
+		}
+		return dup
+	default:
+		return v
+	}
+}
@@ -0,0 +1,103 @@
+package astutil_test
+
+import (
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestClone(t *testing.T) {
+	const input = `
+// x is a global.
+int x;
+typedef int foo;
+int f(foo a) { x = a; return x; }
+`
+	file := parseResolved(t, input)
+	dup := astutil.Clone(file).(*ast.File)
+	if !astutil.Equal(file, dup) {
+		t.Fatalf("clone not equal to original")
+	}
+
+	// Verify that identifiers of the copy refer to declarations of the copy,
+	// and that predeclared types are shared.
+	x := dup.Decls[0].(*ast.VarDecl)
+	fn := dup.Decls[2].(*ast.FuncDecl)
+	stmt := fn.Body.Items[0].(*ast.ExprStmt).X.(*ast.BinaryExpr)
+	if got := stmt.X.(*ast.Ident).Decl; got != x {
+		t.Errorf("declaration mismatch of x; expected copy %p, got %p", x, got)
+	}
+	if got, want := stmt.Y.(*ast.Ident).Decl, ast.Decl(fn.FuncType.Params[0]); got != want {
+		t.Errorf("declaration mismatch of a; expected copy %p, got %p", want, got)
+	}
+	orig := file.Decls[0].(*ast.VarDecl)
+	if got, want := x.VarType.(*ast.Ident).Decl, orig.VarType.(*ast.Ident).Decl; got != want {
+		t.Errorf("declaration mismatch of int; expected universe declaration %p, got %p", want, got)
+	}
+	if x.Doc == nil || x.Doc != dup.Comments[0] {
+		t.Errorf("doc comment of x not shared with comments of file")
+	}
+	if x.Doc == file.Comments[0] {
+		t.Errorf("doc comment of x shared with original")
+	}
+
+	// Modify the copy, and verify that the original is left intact.
+	x.VarName.Name = "y"
+	if astutil.Equal(file, dup) {
+		t.Errorf("modified clone equal to original")
+	}
+	if got := orig.VarName.Name; got != "x" {
+		t.Errorf("name mismatch of original; expected x, got %v", got)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	golden := []struct {
+		a, b string
+		opts []astutil.EqualOption
+		want bool
+	}{
+		{a: "int x;", b: "int x;", want: true},
+		{a: "int x;", b: "int  x;", want: false},
+		{a: "int x;", b: "int  x;", opts: []astutil.EqualOption{astutil.IgnorePos}, want: true},
+		{a: "int x;", b: "int y;", opts: []astutil.EqualOption{astutil.IgnorePos}, want: false},
+		{a: "int x[10];", b: "int x[11];", opts: []astutil.EqualOption{astutil.IgnorePos}, want: false},
+		{a: "int f(void) { return 1; }", b: "int f(void) { return 1 ; }", want: false},
+		{a: "int f(void) { return 1; }", b: "int f(void) { return 1 ; }", opts: []astutil.EqualOption{astutil.IgnorePos}, want: true},
+		{a: "int f(void) { return -1; }", b: "int f(void) { return !1; }", opts: []astutil.EqualOption{astutil.IgnorePos}, want: false},
+		// Comments.
+		{a: "// foo\nint x;", b: "// foo\nint x;", want: true},
+		{a: "// foo\nint x;", b: "// bar\nint x;", want: false},
+		{a: "// foo\nint x;", b: "\n// foo\nint x;", opts: []astutil.EqualOption{astutil.IgnorePos}, want: true},
+		// Declaration links.
+		{a: "int x; int f(int x) { return x; }", b: "int x; int f(int y) { return x; }", opts: []astutil.EqualOption{astutil.IgnorePos}, want: false},
+	}
+	for i, g := range golden {
+		a := parseResolved(t, g.a)
+		b := parseResolved(t, g.b)
+		if got := astutil.Equal(a, b, g.opts...); got != g.want {
+			t.Errorf("i=%d: equality mismatch of %q and %q; expected %v, got %v", i, g.a, g.b, g.want, got)
+		}
+	}
+
+	// Identifiers of equal names referring to non-corresponding declarations.
+	a := parseResolved(t, "int x; int f(int y) { return x; }")
+	b := parseResolved(t, "int x; int f(int x) { return x; }")
+	b.Decls[1].(*ast.FuncDecl).FuncType.Params[0].VarName.Name = "y"
+	if astutil.Equal(a, b, astutil.IgnorePos) {
+		t.Errorf("expected identifiers referring to non-corresponding declarations to differ")
+	}
+}
+
+// parseResolved parses the given input and resolves its identifiers.
+func parseResolved(t *testing.T, input string) *ast.File {
+	file, err := parser.ParseString(input, nil)
+	if err != nil {
+		t.Fatalf("unable to parse %q; %v", input, err)
+	}
+	sem.Check(file)
+	return file
+}
@@ -0,0 +1,145 @@
+package astutil
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/token"
+)
+
+// An EqualOption controls the comparison of Equal.
+type EqualOption uint8
+
+// Comparison options.
+const (
+	// IgnorePos ignores the source positions of nodes and comments.
+	IgnorePos EqualOption = 1 << iota
+)
+
+// Equal reports whether the given parse trees are structurally equal; i.e.
+// whether they have the same node types, identifier names, literal values,
+// operators, storage-classes and comments, and unless IgnorePos is given, the
+// same source positions.
+//
+// Identifiers are equal only if they refer to corresponding declarations. For
+// declarations within the parse trees, corresponding declarations are those at
+// the same location of the trees. Declarations outside of the parse trees
+// (e.g. the predeclared types of the universe scope) correspond if they have
+// the same name, and unless IgnorePos is given, the same position. The cached
+// type of type definitions is not compared.
+func Equal(a, b ast.Node, opts ...EqualOption) bool {
+	c := &comparer{decls: make(map[ast.Decl]ast.Decl)}
+	for _, opt := range opts {
+		c.ignorePos = c.ignorePos || opt&IgnorePos != 0
+	}
+	if !c.equal(reflect.ValueOf(a), reflect.ValueOf(b)) {
+		return false
+	}
+	// Compare declaration links, once the corresponding declarations of the
+	// parse trees are known.
+	for _, pair := range c.idents {
+		if !c.equalDecl(pair[0].Decl, pair[1].Decl) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reflection type of source positions, which are ignored by Equal if IgnorePos
+// is given.
+var posType = reflect.TypeOf(token.Pos(0))
+
+// A comparer keeps track of the state of an Equal operation.
+type comparer struct {
+	// Ignore source positions.
+	ignorePos bool
+	// Map from declarations of the first parse tree to the corresponding
+	// declarations of the second.
+	decls map[ast.Decl]ast.Decl
+	// Pairs of identifiers at corresponding locations of the parse trees.
+	idents [][2]*ast.Ident
+}
+
+// equal reports whether the given values are structurally equal.
+func (c *comparer) equal(a, b reflect.Value) bool {
+	if a.IsValid() != b.IsValid() {
+		return false
+	}
+	if !a.IsValid() {
+		return true
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if a.Kind() == reflect.Ptr {
+			switch x := a.Interface().(type) {
+			case ast.Decl:
+				c.decls[x] = b.Interface().(ast.Decl)
+			case *ast.Ident:
+				c.idents = append(c.idents, [2]*ast.Ident{x, b.Interface().(*ast.Ident)})
+			}
+		}
+		return c.equal(a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !c.equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			switch {
+			case field.Type == declType, field.Type == typeType:
+				// Compared by Equal, and not compared, respectively.
+				continue
+			case field.Type == posType:
+				// Source positions.
+				if c.ignorePos {
+					continue
+				}
+			}
+			if !c.equal(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Int:
+		return a.Int() == b.Int()
+	case reflect.Uint8, reflect.Uint16:
+		return a.Uint() == b.Uint()
+	case reflect.String:
+		return a.String() == b.String()
+	default:
+		panic(fmt.Sprintf("support for comparing values of type %v not yet implemented", a.Type()))
+	}
+}
+
+// equalDecl reports whether the given declarations, referred to by identifiers
+// at corresponding locations of the parse trees, correspond.
+func (c *comparer) equalDecl(a, b ast.Decl) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if decl, ok := c.decls[a]; ok {
+		return decl == b
+	}
+	if a == b {
+		return true
+	}
+	aName, bName := a.Name(), b.Name()
+	if aName == nil || bName == nil {
+		return aName == nil && bName == nil
+	}
+	return aName.Name == bName.Name && (c.ignorePos || aName.Start() == bName.Start())
+}
@@ -0,0 +1,191 @@
+package astutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/mewmew/uc/ast"
+)
+
+// Stop may be returned by the functions invoked by Inspect and
+// InspectBeforeAfter to terminate the traversal early. The traversal functions
+// never return Stop as an error.
+var Stop = errors.New("stop traversal")
+
+// Inspect traverses the given parse tree in depth first order, calling f(n) for
+// each non-nil node n before traversing the node's children. The children of n
+// are traversed only if f returns true.
+//
+// If f returns a non-nil error, the traversal is terminated and Inspect returns
+// the error unchanged (so that it may be compared against sentinel errors),
+// unless the error is Stop, in which case Inspect returns nil.
+func Inspect(node ast.Node, f func(ast.Node) (bool, error)) error {
+	return InspectBeforeAfter(node, f, nil)
+}
+
+// InspectBeforeAfter traverses the given parse tree in depth first order,
+// calling before(n) for each non-nil node n before traversing the node's
+// children, and after(n) afterwards. If before returns false, the children of n
+// are not traversed, and after is not called for n. The after function may be
+// nil.
+//
+// Errors are handled as by Inspect.
+func InspectBeforeAfter(node ast.Node, before func(ast.Node) (bool, error), after func(ast.Node) error) error {
+	in := &inspector{before: before, after: after}
+	if err := in.inspect(node); err != nil && err != Stop {
+		return err
+	}
+	return nil
+}
+
+// An inspector keeps track of the state of an Inspect traversal.
+type inspector struct {
+	// Functions invoked before and after traversing the children of nodes.
+	before func(ast.Node) (bool, error)
+	after  func(ast.Node) error
+}
+
+// inspect traverses the given node and its children, in the same order as
+// Walk.
+func (in *inspector) inspect(node ast.Node) error {
+	if isNil(node) {
+		return nil
+	}
+	descend, err := in.before(node)
+	if err != nil {
+		return err
+	}
+	if !descend {
+		return nil
+	}
+
+	switch n := node.(type) {
+
+	// Source file.
+	case *ast.File:
+		for _, decl := range n.Decls {
+			if err := in.inspect(decl); err != nil {
+				return err
+			}
+		}
+
+	// Declarations.
+	case *ast.FuncDecl:
+		if err := in.inspectAll(n.FuncName, n.FuncType, n.Body); err != nil {
+			return err
+		}
+	case *ast.VarDecl:
+		if err := in.inspectAll(n.VarType, n.VarName, n.Val); err != nil {
+			return err
+		}
+	case *ast.TypeDef:
+		if err := in.inspectAll(n.DeclType, n.TypeName); err != nil {
+			return err
+		}
+
+	// Statements.
+	case *ast.BlockStmt:
+		for _, item := range n.Items {
+			if err := in.inspect(item); err != nil {
+				return err
+			}
+		}
+	case *ast.EmptyStmt:
+		// Nothing to do.
+	case *ast.ExprStmt:
+		if err := in.inspect(n.X); err != nil {
+			return err
+		}
+	case *ast.IfStmt:
+		if err := in.inspectAll(n.Cond, n.Body, n.Else); err != nil {
+			return err
+		}
+	case *ast.ReturnStmt:
+		if err := in.inspect(n.Result); err != nil {
+			return err
+		}
+	case *ast.WhileStmt:
+		if err := in.inspectAll(n.Cond, n.Body); err != nil {
+			return err
+		}
+
+	// Expressions.
+	case *ast.BasicLit:
+		// Nothing to do.
+	case *ast.BinaryExpr:
+		if err := in.inspectAll(n.X, n.Y); err != nil {
+			return err
+		}
+	case *ast.CallExpr:
+		if err := in.inspect(n.Name); err != nil {
+			return err
+		}
+		for _, arg := range n.Args {
+			if err := in.inspect(arg); err != nil {
+				return err
+			}
+		}
+	case *ast.Ident:
+		// Nothing to do.
+	case *ast.IndexExpr:
+		if err := in.inspectAll(n.Name, n.Index); err != nil {
+			return err
+		}
+	case *ast.ParenExpr:
+		if err := in.inspect(n.X); err != nil {
+			return err
+		}
+	case *ast.UnaryExpr:
+		if err := in.inspect(n.X); err != nil {
+			return err
+		}
+
+	// Types.
+	case *ast.ArrayType:
+		if err := in.inspect(n.Elem); err != nil {
+			return err
+		}
+	case *ast.FuncType:
+		if err := in.inspect(n.Result); err != nil {
+			return err
+		}
+		for _, param := range n.Params {
+			if err := in.inspect(param); err != nil {
+				return err
+			}
+		}
+	case *ast.QualType:
+		if err := in.inspect(n.Type); err != nil {
+			return err
+		}
+
+	default:
+		panic(fmt.Sprintf("support for inspecting node of type %T not yet implemented", node))
+	}
+
+	if in.after != nil {
+		return in.after(node)
+	}
+	return nil
+}
+
+// inspectAll traverses the given nodes in order.
+func (in *inspector) inspectAll(nodes ...ast.Node) error {
+	for _, n := range nodes {
+		if err := in.inspect(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isNil reports whether the given node is nil, or a nil pointer of an optional
+// field (e.g. the body of function declarations).
+func isNil(n ast.Node) bool {
+	if n == nil {
+		return true
+	}
+	v := reflect.ValueOf(n)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
@@ -0,0 +1,139 @@
+package astutil_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/hand/parser"
+)
+
+func TestInspect(t *testing.T) {
+	errFoo := errors.New("foo")
+	var golden = []struct {
+		name  string
+		input string
+		f     func(names *[]string) func(ast.Node) (bool, error)
+		want  string
+		err   error
+	}{
+		// Visit all identifiers.
+		{
+			name:  "all",
+			input: "int x; int f(int a) { return a + x; }",
+			f: func(names *[]string) func(ast.Node) (bool, error) {
+				return func(n ast.Node) (bool, error) {
+					if ident, ok := n.(*ast.Ident); ok {
+						*names = append(*names, ident.Name)
+					}
+					return true, nil
+				}
+			},
+			want: "int x f int int a a x",
+		},
+		// Skip function bodies.
+		{
+			name:  "prune",
+			input: "int x; int f(int a) { return a + x; } void g(void) { f(x); }",
+			f: func(names *[]string) func(ast.Node) (bool, error) {
+				return func(n ast.Node) (bool, error) {
+					switch n := n.(type) {
+					case *ast.Ident:
+						*names = append(*names, n.Name)
+					case *ast.BlockStmt:
+						return false, nil
+					}
+					return true, nil
+				}
+			},
+			want: "int x f int int a g void void",
+		},
+		// Stop traversal.
+		{
+			name:  "stop",
+			input: "int x; int f(int a) { return a + x; } int y;",
+			f: func(names *[]string) func(ast.Node) (bool, error) {
+				return func(n ast.Node) (bool, error) {
+					if ident, ok := n.(*ast.Ident); ok {
+						*names = append(*names, ident.Name)
+						if ident.Name == "a" {
+							return false, astutil.Stop
+						}
+					}
+					return true, nil
+				}
+			},
+			want: "int x f int int a",
+		},
+		// Terminate traversal with error.
+		{
+			name:  "error",
+			input: "int x; int f(int a) { return a + x; }",
+			f: func(names *[]string) func(ast.Node) (bool, error) {
+				return func(n ast.Node) (bool, error) {
+					if _, ok := n.(*ast.ReturnStmt); ok {
+						return false, errFoo
+					}
+					if ident, ok := n.(*ast.Ident); ok {
+						*names = append(*names, ident.Name)
+					}
+					return true, nil
+				}
+			},
+			want: "int x f int int a",
+			err:  errFoo,
+		},
+	}
+
+	for _, g := range golden {
+		file, err := parser.ParseString(g.input, nil)
+		if err != nil {
+			t.Errorf("%s: unable to parse input; %v", g.name, err)
+			continue
+		}
+		var names []string
+		err = astutil.Inspect(file, g.f(&names))
+		if err != g.err {
+			t.Errorf("%s: error mismatch; expected %v, got %v", g.name, g.err, err)
+			continue
+		}
+		if got := strings.Join(names, " "); got != g.want {
+			t.Errorf("%s: identifiers mismatch; expected `%v`, got `%v`", g.name, g.want, got)
+		}
+	}
+}
+
+func TestInspectBeforeAfter(t *testing.T) {
+	file, err := parser.ParseString("int f(void) { { return 1; } }", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []string
+	before := func(n ast.Node) (bool, error) {
+		switch n.(type) {
+		case *ast.FuncDecl, *ast.BlockStmt, *ast.ReturnStmt:
+			events = append(events, fmt.Sprintf("before %T", n))
+			// Skip the children of return statements.
+			_, ok := n.(*ast.ReturnStmt)
+			return !ok, nil
+		}
+		return true, nil
+	}
+	after := func(n ast.Node) error {
+		switch n.(type) {
+		case *ast.FuncDecl, *ast.BlockStmt, *ast.ReturnStmt:
+			events = append(events, fmt.Sprintf("after %T", n))
+		}
+		return nil
+	}
+	if err := astutil.InspectBeforeAfter(file, before, after); err != nil {
+		t.Fatal(err)
+	}
+	want := "before *ast.FuncDecl, before *ast.BlockStmt, before *ast.BlockStmt, before *ast.ReturnStmt, after *ast.BlockStmt, after *ast.BlockStmt, after *ast.FuncDecl"
+	if got := strings.Join(events, ", "); got != want {
+		t.Errorf("events mismatch; expected `%v`, got `%v`", want, got)
+	}
+}
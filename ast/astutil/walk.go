@@ -105,6 +105,10 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkFuncType(n, before, after)
 		}
+	case *ast.QualType:
+		if n != nil {
+			return walkQualType(n, before, after)
+		}
 
 	case nil:
 		// Nothing to do.
@@ -444,3 +448,18 @@ func walkFuncType(fn *ast.FuncType, before, after func(ast.Node) error) error {
 	}
 	return nil
 }
+
+// walkQualType walks the parse tree of the given qualified type in depth first
+// order.
+func walkQualType(qual *ast.QualType, before, after func(ast.Node) error) error {
+	if err := before(qual); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(qual.Type, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(qual); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
@@ -30,10 +30,7 @@ func NewFile(decls interface{}) (*ast.File, error) {
 //       : Decl
 //    ;
 func NewDeclList(decl interface{}) ([]ast.Decl, error) {
-	if decl, ok := decl.(ast.Decl); ok {
-		return []ast.Decl{decl}, nil
-	}
-	return nil, errutil.Newf("invalid declaration list declaration type; expected ast.Decl, got %T", decl)
+	return AppendDecl([]ast.Decl(nil), decl)
 }
 
 // AppendDecl appends decl to the declaration list, based on the following
@@ -42,15 +39,27 @@ func NewDeclList(decl interface{}) ([]ast.Decl, error) {
 //    DeclList
 //       : DeclList Decl
 //    ;
+//
+// Each variable declaration of a declaration with multiple declarators (e.g.
+// `int a, b;`) is appended separately. Declarations omitted due to syntax errors
+// (i.e. nil) are skipped.
 func AppendDecl(list, decl interface{}) ([]ast.Decl, error) {
 	lst, ok := list.([]ast.Decl)
 	if !ok {
 		return nil, errutil.Newf("invalid declaration list type; expected []ast.Decl, got %T", list)
 	}
-	if decl, ok := decl.(ast.Decl); ok {
+	switch decl := decl.(type) {
+	case nil:
+		return lst, nil
+	case ast.Decl:
 		return append(lst, decl), nil
+	case []*ast.VarDecl:
+		for _, d := range decl {
+			lst = append(lst, d)
+		}
+		return lst, nil
 	}
-	return nil, errutil.Newf("invalid declaration list declaration type; expected ast.Decl, got %T", decl)
+	return nil, errutil.Newf("invalid declaration list declaration type; expected ast.Decl or []*ast.VarDecl, got %T", decl)
 }
 
 // NewFuncDecl returns a new function declaration node, based on the following
@@ -90,7 +99,7 @@ func NewFuncDecl(resultType, name, lparen, params, rparen interface{}) (*ast.Fun
 	if !ok {
 		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
 	}
-	typ := &ast.FuncType{Result: resType, Lparen: lpar.Offset, Params: pars, Rparen: rpar.Offset}
+	typ := &ast.FuncType{Result: resType, Lparen: token.Pos(lpar.Offset), Params: pars, Rparen: token.Pos(rpar.Offset)}
 	return &ast.FuncDecl{FuncType: typ, FuncName: ident}, nil
 }
 
@@ -113,6 +122,146 @@ func SetFuncBody(f, body interface{}) (*ast.FuncDecl, error) {
 
 }
 
+// SetStorageClass sets the storage-class specifier of the given variable or
+// function declaration, based on the following production rules.
+//
+//    Decl
+//       : StorageClass FuncDecl ";"
+//       | StorageClass FuncDef
+//    ;
+//
+//    StorageClass
+//       : "extern"
+//       | "static"
+//    ;
+func SetStorageClass(storageToken, decl interface{}) (ast.Decl, error) {
+	storageTok, ok := storageToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid storage-class specifier type; expected *gocctoken.Token, got %T", storageToken)
+	}
+	var storage ast.StorageClass
+	switch lit := string(storageTok.Lit); lit {
+	case "extern":
+		storage = ast.Extern
+	case "static":
+		storage = ast.Static
+	default:
+		return nil, errutil.Newf(`invalid storage-class specifier; expected "extern" or "static", got %q`, lit)
+	}
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		decl.StoragePos = token.Pos(storageTok.Offset)
+		decl.Storage = storage
+		return decl, nil
+	case *ast.VarDecl:
+		decl.StoragePos = token.Pos(storageTok.Offset)
+		decl.Storage = storage
+		return decl, nil
+	}
+	return nil, errutil.Newf("invalid storage-class declaration type; expected *ast.FuncDecl or *ast.VarDecl, got %T", decl)
+}
+
+// SetStorageClassList sets the storage-class specifier of the given variable
+// declarations, based on the following production rules.
+//
+//    Decl
+//       : StorageClass VarDecls ";"
+//    ;
+func SetStorageClassList(storageToken, decls interface{}) ([]*ast.VarDecl, error) {
+	ds, ok := decls.([]*ast.VarDecl)
+	if !ok {
+		return nil, errutil.Newf("invalid variable declarations type; expected []*ast.VarDecl, got %T", decls)
+	}
+	for _, decl := range ds {
+		if _, err := SetStorageClass(storageToken, decl); err != nil {
+			return nil, errutil.Err(err)
+		}
+	}
+	return ds, nil
+}
+
+// NewVarDeclList returns a new variable declaration list, based on the
+// following production rule.
+//
+//    VarDecls
+//       : VarDecl
+//    ;
+func NewVarDeclList(decl interface{}) ([]*ast.VarDecl, error) {
+	if decl, ok := decl.(*ast.VarDecl); ok {
+		return []*ast.VarDecl{decl}, nil
+	}
+	return nil, errutil.Newf("invalid variable declaration list declaration type; expected *ast.VarDecl, got %T", decl)
+}
+
+// AppendScalarDecl appends a scalar declaration to the variable declaration
+// list, based on the following production rule.
+//
+//    VarDecls
+//       : VarDecls "," ident
+//    ;
+//
+// The type of the scalar declaration is a copy of the basic type of the first
+// declaration in the list, at the same source position.
+func AppendScalarDecl(list, name interface{}) ([]*ast.VarDecl, error) {
+	lst, typ, err := varDeclList(list)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	decl, err := NewScalarDecl(typ, name)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return append(lst, decl), nil
+}
+
+// AppendArrayDecl appends an array declaration to the variable declaration
+// list, based on the following production rules.
+//
+//    VarDecls
+//       : VarDecls "," ident "[" IntLit "]"
+//       | VarDecls "," ident "[" "]"
+//    ;
+//
+// The element type of the array declaration is a copy of the basic type of the
+// first declaration in the list, at the same source position.
+func AppendArrayDecl(list, name, lbracket, length, rbracket interface{}) ([]*ast.VarDecl, error) {
+	lst, elem, err := varDeclList(list)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	decl, err := NewArrayDecl(elem, name, lbracket, length, rbracket)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return append(lst, decl), nil
+}
+
+// varDeclList returns the given variable declaration list and a copy of the
+// basic type shared by its declarations.
+func varDeclList(list interface{}) ([]*ast.VarDecl, ast.Type, error) {
+	lst, ok := list.([]*ast.VarDecl)
+	if !ok || len(lst) == 0 {
+		return nil, nil, errutil.Newf("invalid variable declaration list type; expected non-empty []*ast.VarDecl, got %T", list)
+	}
+	typ := lst[0].VarType
+	if arr, ok := typ.(*ast.ArrayType); ok {
+		typ = arr.Elem
+	}
+	return lst, copyType(typ), nil
+}
+
+// copyType returns a copy of the given basic type node.
+func copyType(typ ast.Type) ast.Type {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		return &ast.Ident{NamePos: typ.NamePos, Name: typ.Name}
+	case *ast.QualType:
+		return &ast.QualType{Const: typ.Const, Type: copyType(typ.Type)}
+	default:
+		return typ
+	}
+}
+
 // NewScalarDecl returns a new scalar declaration node, based on the following
 // production rule.
 //
@@ -197,7 +346,7 @@ func NewTypeDef(typedefTok, typ, name interface{}) (*ast.TypeDef, error) {
 	if err != nil {
 		return nil, errutil.Newf("invalid type definition identifier; %v", err)
 	}
-	return &ast.TypeDef{Typedef: typedef.Offset, DeclType: declType, TypeName: ident}, nil
+	return &ast.TypeDef{Typedef: token.Pos(typedef.Offset), DeclType: declType, TypeName: ident}, nil
 }
 
 // NewParamList returns a new parameter list, based on the following production
@@ -250,29 +399,38 @@ func NewAnonParam(typ interface{}) (*ast.VarDecl, error) {
 //    Stmt
 //       : Expr ";"
 //    ;
-func NewExprStmt(x interface{}) (*ast.ExprStmt, error) {
+func NewExprStmt(x, semicolon interface{}) (*ast.ExprStmt, error) {
+	semiTok, ok := semicolon.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid semicolon type; expected *gocctoken.Token, got %T", semicolon)
+	}
 	if x, ok := x.(ast.Expr); ok {
-		return &ast.ExprStmt{X: x}, nil
+		return &ast.ExprStmt{X: x, Semicolon: token.Pos(semiTok.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid expression statement expression type; expected ast.Expr, got %T", x)
 }
 
 // NewReturnStmt returns a new return statement, based on the following
-// production rule.
+// production rules.
 //
 //    Stmt
 //       : "return" Expr ";"
+//       | "return" ";"
 //    ;
-func NewReturnStmt(returnToken, result interface{}) (*ast.ReturnStmt, error) {
+func NewReturnStmt(returnToken, result, semicolon interface{}) (*ast.ReturnStmt, error) {
 	retTok, ok := returnToken.(*gocctoken.Token)
 	if !ok {
 		return nil, errutil.Newf("invalid return keyword type; expected *gocctoken.Token, got %T", returnToken)
 	}
+	semiTok, ok := semicolon.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid semicolon type; expected *gocctoken.Token, got %T", semicolon)
+	}
 	if result == nil {
-		return &ast.ReturnStmt{Return: retTok.Offset}, nil
+		return &ast.ReturnStmt{Return: token.Pos(retTok.Offset), Semicolon: token.Pos(semiTok.Offset)}, nil
 	}
 	if result, ok := result.(ast.Expr); ok {
-		return &ast.ReturnStmt{Return: retTok.Offset, Result: result}, nil
+		return &ast.ReturnStmt{Return: token.Pos(retTok.Offset), Result: result, Semicolon: token.Pos(semiTok.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid return statement result type; expected ast.Expr, got %T", result)
 }
@@ -296,7 +454,7 @@ func NewWhileStmt(whileToken, cond, body interface{}) (*ast.WhileStmt, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid while statement body type; expected ast.Stmt, got %T", body)
 	}
-	return &ast.WhileStmt{While: whileTok.Offset, Cond: condExpr, Body: bodyStmt}, nil
+	return &ast.WhileStmt{While: token.Pos(whileTok.Offset), Cond: condExpr, Body: bodyStmt}, nil
 }
 
 // NewIfStmt returns a new if statement, based on the following production
@@ -324,10 +482,10 @@ func NewIfStmt(ifToken, cond, trueBranch, falseBranch interface{}) (*ast.IfStmt,
 		return nil, errutil.Newf("invalid if statement body type; expected ast.Stmt, got %T", trueBranch)
 	}
 	if falseBranch == nil {
-		return &ast.IfStmt{If: ifTok.Offset, Cond: condExpr, Body: bodyStmt}, nil
+		return &ast.IfStmt{If: token.Pos(ifTok.Offset), Cond: condExpr, Body: bodyStmt}, nil
 	}
 	if elseStmt, ok := falseBranch.(ast.Stmt); ok {
-		return &ast.IfStmt{If: ifTok.Offset, Cond: condExpr, Body: bodyStmt, Else: elseStmt}, nil
+		return &ast.IfStmt{If: token.Pos(ifTok.Offset), Cond: condExpr, Body: bodyStmt, Else: elseStmt}, nil
 	}
 	return nil, errutil.Newf("invalid if statement else-body type; expected ast.Stmt, got %T", falseBranch)
 }
@@ -348,10 +506,10 @@ func NewBlockStmt(lbrace, items, rbrace interface{}) (*ast.BlockStmt, error) {
 		return nil, errutil.Newf("invalid right-brace type; expectd *gocctoken.Token, got %T", rbrace)
 	}
 	if items == nil {
-		return &ast.BlockStmt{Lbrace: lbra.Offset, Rbrace: rbra.Offset}, nil
+		return &ast.BlockStmt{Lbrace: token.Pos(lbra.Offset), Rbrace: token.Pos(rbra.Offset)}, nil
 	}
 	if items, ok := items.([]ast.BlockItem); ok {
-		return &ast.BlockStmt{Lbrace: lbra.Offset, Items: items, Rbrace: rbra.Offset}, nil
+		return &ast.BlockStmt{Lbrace: token.Pos(lbra.Offset), Items: items, Rbrace: token.Pos(rbra.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid block statements type; expected []ast.BlockItem, got %T", items)
 }
@@ -363,10 +521,7 @@ func NewBlockStmt(lbrace, items, rbrace interface{}) (*ast.BlockStmt, error) {
 //       : BlockItem
 //    ;
 func NewBlockItemList(item interface{}) ([]ast.BlockItem, error) {
-	if item, ok := item.(ast.BlockItem); ok {
-		return []ast.BlockItem{item}, nil
-	}
-	return nil, errutil.Newf("invalid block item list block item type; expected ast.BlockItem, got %T", item)
+	return AppendBlockItem([]ast.BlockItem(nil), item)
 }
 
 // AppendBlockItem appends item to the block item list, based on the following
@@ -375,15 +530,27 @@ func NewBlockItemList(item interface{}) ([]ast.BlockItem, error) {
 //    BlockItemList
 //       : BlockItemList BlockItem
 //    ;
+//
+// Each variable declaration of a declaration with multiple declarators (e.g.
+// `int a, b;`) is appended separately. Block items omitted due to syntax errors
+// (i.e. nil) are skipped.
 func AppendBlockItem(list, item interface{}) ([]ast.BlockItem, error) {
 	lst, ok := list.([]ast.BlockItem)
 	if !ok {
 		return nil, errutil.Newf("invalid block item list type; expected []ast.BlockItem, got %T", list)
 	}
-	if item, ok := item.(ast.BlockItem); ok {
+	switch item := item.(type) {
+	case nil:
+		return lst, nil
+	case ast.BlockItem:
 		return append(lst, item), nil
+	case []*ast.VarDecl:
+		for _, decl := range item {
+			lst = append(lst, decl)
+		}
+		return lst, nil
 	}
-	return nil, errutil.Newf("invalid block item list block item type; expected ast.BlockItem, got %T", item)
+	return nil, errutil.Newf("invalid block item list block item type; expected ast.BlockItem or []*ast.VarDecl, got %T", item)
 }
 
 // NewEmptyStmt returns a new empty statement, based on the following production
@@ -397,7 +564,7 @@ func NewEmptyStmt(semicolonToken interface{}) (*ast.EmptyStmt, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid semicolon type; expected *gocctoken.Token, got %T", semicolonToken)
 	}
-	return &ast.EmptyStmt{Semicolon: semiTok.Offset}, nil
+	return &ast.EmptyStmt{Semicolon: token.Pos(semiTok.Offset)}, nil
 }
 
 // NewBinaryExpr returns a new binary experssion node, based on the following
@@ -475,7 +642,7 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid second binary operand type; expected ast.Expr, got %T", y)
 	}
-	return &ast.BinaryExpr{X: arg0, OpPos: opTok.Offset, Op: op, Y: arg1}, nil
+	return &ast.BinaryExpr{X: arg0, OpPos: token.Pos(opTok.Offset), Op: op, Y: arg1}, nil
 }
 
 // NewUnaryExpr returns a new unary experssion node, based on the following
@@ -500,7 +667,7 @@ func NewUnaryExpr(opToken, x interface{}) (*ast.UnaryExpr, error) {
 		return nil, errutil.Newf(`invalid unary operator; expected "-" or "!", got %q`, lit)
 	}
 	if x, ok := x.(ast.Expr); ok {
-		return &ast.UnaryExpr{OpPos: opTok.Offset, Op: op, X: x}, nil
+		return &ast.UnaryExpr{OpPos: token.Pos(opTok.Offset), Op: op, X: x}, nil
 	}
 	return nil, errutil.Newf("invalid unary operand type; expected ast.Expr, got %T", x)
 }
@@ -510,6 +677,7 @@ func NewUnaryExpr(opToken, x interface{}) (*ast.UnaryExpr, error) {
 //
 //    Expr15
 //       : int_lit
+//       | float_lit
 //       | char_lit
 //    ;
 func NewBasicLit(valToken interface{}, kind token.Kind) (*ast.BasicLit, error) {
@@ -518,12 +686,12 @@ func NewBasicLit(valToken interface{}, kind token.Kind) (*ast.BasicLit, error) {
 		return nil, errutil.Newf("invalid basic literal type; expected *gocctoken.Token, got %T", valToken)
 	}
 	switch kind {
-	case token.CharLit, token.IntLit:
+	case token.CharLit, token.IntLit, token.FloatLit:
 		// Valid kind.
 	default:
-		return nil, errutil.Newf("invalid basic literal kind; expected CharLit or IntLit, got %v", kind)
+		return nil, errutil.Newf("invalid basic literal kind; expected CharLit, IntLit or FloatLit, got %v", kind)
 	}
-	return &ast.BasicLit{ValPos: valTok.Offset, Kind: kind, Val: string(valTok.Lit)}, nil
+	return &ast.BasicLit{ValPos: token.Pos(valTok.Offset), Kind: kind, Val: string(valTok.Lit)}, nil
 }
 
 // NewIdent returns a new identifier experssion node, based on the following
@@ -537,7 +705,7 @@ func NewIdent(nameToken interface{}) (*ast.Ident, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid identifier type; expected *gocctoken.Token, got %T", nameToken)
 	}
-	return &ast.Ident{NamePos: nameTok.Offset, Name: string(nameTok.Lit)}, nil
+	return &ast.Ident{NamePos: token.Pos(nameTok.Offset), Name: string(nameTok.Lit)}, nil
 }
 
 // NewIndexExpr returns a new index expression, based on the following
@@ -560,7 +728,7 @@ func NewIndexExpr(name, lbracket, index, rbracket interface{}) (*ast.IndexExpr,
 		return nil, errutil.Newf("invalid right-bracket type; expectd *gocctoken.Token, got %T", rbracket)
 	}
 	if index, ok := index.(ast.Expr); ok {
-		return &ast.IndexExpr{Name: ident, Lbracket: lbrack.Offset, Index: index, Rbracket: rbrack.Offset}, nil
+		return &ast.IndexExpr{Name: ident, Lbracket: token.Pos(lbrack.Offset), Index: index, Rbracket: token.Pos(rbrack.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid index expression type; expected ast.Expr, got %T", index)
 }
@@ -585,10 +753,10 @@ func NewCallExpr(name, lparen, args, rparen interface{}) (*ast.CallExpr, error)
 		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
 	}
 	if args == nil {
-		return &ast.CallExpr{Name: ident, Lparen: lpar.Offset, Rparen: rpar.Offset}, nil
+		return &ast.CallExpr{Name: ident, Lparen: token.Pos(lpar.Offset), Rparen: token.Pos(rpar.Offset)}, nil
 	}
 	if args, ok := args.([]ast.Expr); ok {
-		return &ast.CallExpr{Name: ident, Lparen: lpar.Offset, Args: args, Rparen: rpar.Offset}, nil
+		return &ast.CallExpr{Name: ident, Lparen: token.Pos(lpar.Offset), Args: args, Rparen: token.Pos(rpar.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid function arguments type; expected []ast.Expr, got %T", args)
 }
@@ -609,7 +777,7 @@ func NewParenExpr(lparen, x, rparen interface{}) (*ast.ParenExpr, error) {
 		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
 	}
 	if x, ok := x.(ast.Expr); ok {
-		return &ast.ParenExpr{Lparen: lpar.Offset, X: x, Rparen: rpar.Offset}, nil
+		return &ast.ParenExpr{Lparen: token.Pos(lpar.Offset), X: x, Rparen: token.Pos(rpar.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid parenthesized expression type; expected ast.Expr, got %T", x)
 }
@@ -0,0 +1,101 @@
+package astx
+
+import (
+	"strings"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/token"
+)
+
+// AddComments groups the given comments into comment groups, records the
+// comment groups of the given source file, and attaches doc comments to the
+// declarations they precede. The comments are in source order, and line breaks
+// are located using the given source input, the first byte of which is located
+// at the given base position.
+//
+// Comments are grouped if separated by white space containing at most one
+// line break. A comment group is the doc comment of a function, variable or
+// type declaration if it starts on a line of its own, and ends on the line
+// directly preceding the declaration; e.g.
+//
+//    // x is the answer.
+//    int x;
+func AddComments(file *ast.File, comments []*ast.Comment, input string, base token.Pos) {
+	var groups []*ast.CommentGroup
+	for i, c := range comments {
+		if i > 0 && isAdjacent(input, base, comments[i-1], c) {
+			group := groups[len(groups)-1]
+			group.List = append(group.List, c)
+			continue
+		}
+		groups = append(groups, &ast.CommentGroup{List: []*ast.Comment{c}})
+	}
+	file.Comments = groups
+
+	// Map from the position of the token directly following a doc comment to
+	// its comment group.
+	docs := make(map[token.Pos]*ast.CommentGroup)
+	for _, group := range groups {
+		groupStart, end := int(group.Start()-base), int(group.End()-base)
+		if groupStart < 0 || end > len(input) {
+			// Invalid source input.
+			break
+		}
+		start := strings.LastIndexByte(input[:groupStart], '\n') + 1
+		if !isSpace(input[start:groupStart]) {
+			// Trailing comment of a preceding token.
+			continue
+		}
+		next := end
+		for next < len(input) && strings.IndexByte(whitespace, input[next]) != -1 {
+			next++
+		}
+		if lineBreaks(input, end, next) == 1 {
+			docs[base+token.Pos(next)] = group
+		}
+	}
+	if len(docs) == 0 {
+		return
+	}
+	f := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			n.Doc = docs[n.Start()]
+		case *ast.VarDecl:
+			n.Doc = docs[n.Start()]
+		case *ast.TypeDef:
+			n.Doc = docs[n.Start()]
+		}
+		return nil
+	}
+	for _, decl := range file.Decls {
+		astutil.Walk(decl, f)
+	}
+}
+
+// isAdjacent reports whether the given comments are separated by white space
+// containing at most one line break. The first byte of input is located at the
+// given base position.
+func isAdjacent(input string, base token.Pos, prev, c *ast.Comment) bool {
+	n := lineBreaks(input, int(prev.End()-base), int(c.Start()-base))
+	return n != -1 && n <= 1
+}
+
+// whitespace specifies the white-space characters of µC.
+const whitespace = " \t\n\v\f\r"
+
+// lineBreaks returns the number of line breaks within input[start:end], or -1
+// if the range contains other characters than white space or is outside of the
+// input.
+func lineBreaks(input string, start, end int) int {
+	if start < 0 || start > end || end > len(input) || !isSpace(input[start:end]) {
+		return -1
+	}
+	return strings.Count(input[start:end], "\n")
+}
+
+// isSpace reports whether s consists of white space only.
+func isSpace(s string) bool {
+	return strings.Trim(s, whitespace) == ""
+}
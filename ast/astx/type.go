@@ -4,6 +4,7 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/token"
 )
 
 // NewType returns a new type of µC.
@@ -22,22 +23,22 @@ func NewArrayType(elem, lbracket, length, rbracket interface{}) (*ast.ArrayType,
 		return nil, errutil.Newf("invalid array length type; %T", length)
 	}
 
-	var lbrack, rbrack int
+	var lbrack, rbrack token.Pos
 	switch lbracket := lbracket.(type) {
 	case *gocctoken.Token:
-		lbrack = lbracket.Offset
-	case int:
+		lbrack = token.Pos(lbracket.Offset)
+	case token.Pos:
 		lbrack = lbracket
 	default:
-		return nil, errutil.Newf("invalid left-bracket type; expectd *gocctoken.Token or int, got %T", lbracket)
+		return nil, errutil.Newf("invalid left-bracket type; expectd *gocctoken.Token or token.Pos, got %T", lbracket)
 	}
 	switch rbracket := rbracket.(type) {
 	case *gocctoken.Token:
-		rbrack = rbracket.Offset
-	case int:
+		rbrack = token.Pos(rbracket.Offset)
+	case token.Pos:
 		rbrack = rbracket
 	default:
-		return nil, errutil.Newf("invalid right-bracket type; expectd *gocctoken.Token or int, got %T", rbracket)
+		return nil, errutil.Newf("invalid right-bracket type; expectd *gocctoken.Token or token.Pos, got %T", rbracket)
 	}
 
 	elemType, err := NewType(elem)
@@ -46,3 +47,21 @@ func NewArrayType(elem, lbracket, length, rbracket interface{}) (*ast.ArrayType,
 	}
 	return &ast.ArrayType{Elem: elemType, Lbracket: lbrack, Len: len, Rbracket: rbrack}, nil
 }
+
+// NewQualType returns a new qualified type, based on the following production
+// rule.
+//
+//    BasicType
+//       : "const" ident
+//    ;
+func NewQualType(constToken, name interface{}) (*ast.QualType, error) {
+	constTok, ok := constToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid const qualifier type; expected *gocctoken.Token, got %T", constToken)
+	}
+	ident, err := NewIdent(name)
+	if err != nil {
+		return nil, errutil.Newf("invalid qualified type identifier; %v", err)
+	}
+	return &ast.QualType{Const: token.Pos(constTok.Offset), Type: ident}, nil
+}
@@ -0,0 +1,97 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/mewmew/uc/token"
+)
+
+// A Comment represents a single line comment or block comment.
+//
+// Examples.
+//
+//    // line comment
+//    /* block comment */
+type Comment struct {
+	// Position of the slash `/` starting the comment.
+	Slash token.Pos
+	// Comment text, including the comment markers (i.e. `//`, `/*` and `*/`).
+	Text string
+}
+
+// A CommentGroup represents a sequence of comments without any other tokens or
+// blank lines between them.
+//
+// Examples.
+//
+//    // foo returns the number of bars; i.e.
+//    // 42.
+type CommentGroup struct {
+	// Comments of the group; non-empty.
+	List []*Comment
+}
+
+// Start returns the start position of the comment within the input stream.
+func (c *Comment) Start() token.Pos {
+	return c.Slash
+}
+
+// End returns the position directly after the comment within the input
+// stream.
+func (c *Comment) End() token.Pos {
+	return c.Slash + token.Pos(len(c.Text))
+}
+
+// Start returns the start position of the comment group within the input
+// stream.
+func (g *CommentGroup) Start() token.Pos {
+	return g.List[0].Start()
+}
+
+// End returns the position directly after the comment group within the input
+// stream.
+func (g *CommentGroup) End() token.Pos {
+	return g.List[len(g.List)-1].End()
+}
+
+// Text returns the text of the comment group, with comment markers, the
+// leading space of line comments and single-line block comments, and leading
+// and trailing blank lines removed; e.g.
+//
+//    foo returns the number of bars; i.e.
+//    42.
+//
+// The returned text is empty if the comment group is nil. Otherwise, lines are
+// terminated by a newline.
+func (g *CommentGroup) Text() string {
+	if g == nil {
+		return ""
+	}
+	var lines []string
+	for _, c := range g.List {
+		text := c.Text
+		if strings.HasPrefix(text, "//") {
+			text = strings.TrimPrefix(text[len("//"):], " ")
+		} else {
+			text = strings.TrimPrefix(text, "/*")
+			text = strings.TrimSuffix(text, "*/")
+			if !strings.Contains(text, "\n") {
+				text = strings.TrimPrefix(text, " ")
+			}
+		}
+		for _, line := range strings.Split(text, "\n") {
+			lines = append(lines, strings.TrimRight(line, " \t\r"))
+		}
+	}
+	// Remove leading and trailing blank lines.
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -17,6 +17,8 @@ func newType(n Node) types.Type {
 			params[i] = newField(n.Params[i])
 		}
 		return &types.Func{Result: newType(n.Result), Params: params}
+	case *QualType:
+		return types.NewConst(newType(n.Type))
 	case *Ident:
 		if n.Decl == nil {
 			return newBasic(n)
@@ -59,6 +61,20 @@ func newBasic(ident *Ident) types.Type {
 		intIdent.Decl = intDecl
 		ident.Decl = intDecl
 		return intType
+	case "float":
+		floatIdent := &Ident{NamePos: universePos, Name: "float"}
+		floatType := &types.Basic{Kind: types.Float}
+		floatDecl := &TypeDef{DeclType: floatIdent, TypeName: floatIdent, Val: floatType}
+		floatIdent.Decl = floatDecl
+		ident.Decl = floatDecl
+		return floatType
+	case "double":
+		doubleIdent := &Ident{NamePos: universePos, Name: "double"}
+		doubleType := &types.Basic{Kind: types.Double}
+		doubleDecl := &TypeDef{DeclType: doubleIdent, TypeName: doubleIdent, Val: doubleType}
+		doubleIdent.Decl = doubleDecl
+		ident.Decl = doubleDecl
+		return doubleType
 	case "void":
 		voidIdent := &Ident{NamePos: universePos, Name: "void"}
 		voidType := &types.Basic{Kind: types.Void}
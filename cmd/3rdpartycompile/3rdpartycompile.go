@@ -28,8 +28,6 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewkiz/pkg/goutil"
 	"github.com/mewkiz/pkg/ioutilx"
-	"github.com/mewmew/uc/ast"
-	goccerrors "github.com/mewmew/uc/gocc/errors"
 	"github.com/mewmew/uc/gocc/parser"
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
 	handscanner "github.com/mewmew/uc/hand/scanner"
@@ -109,27 +107,23 @@ func compileFile(path string, outputPath string, goccLexer bool) error {
 	}
 
 	// Parse input.
+	input := string(buf)
+	src := semerrors.NewSource(path, input)
 	p := parser.NewParser()
-	f, err := p.Parse(s)
+	file, err := p.ParseFile(s, src)
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(semerrors.List); ok {
+			// Report all syntax errors.
+			return errs
 		}
 		return errutil.Err(err)
 	}
-	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
 	info, err := sem.Check(file)
 	if err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
+		if errs, ok := err.(semerrors.List); ok {
+			// Add input source information to semantic analysis errors.
+			errs.SetSource(src)
+			return errs
 		}
 		return errutil.Err(err)
 	}
@@ -5,10 +5,17 @@
 //
 // If FILE is -, read standard input.
 //
+//   -W value
+//        enable (name), disable (no-name) or promote to error (error=name) the
+//        named warning; or promote all warnings to errors (error)
+//   -cfg-dot
+//        output control flow graphs of functions in Graphviz DOT format
 //   -debug
 //        enable debug output
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -hand-parser
+//        use hand-written parser
 //   -no-colors
 //        disable colors in output
 //   -no-nested-functions
@@ -28,11 +35,12 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewkiz/pkg/ioutilx"
 	"github.com/mewmew/uc/ast"
-	goccerrors "github.com/mewmew/uc/gocc/errors"
 	"github.com/mewmew/uc/gocc/parser"
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
+	handparser "github.com/mewmew/uc/hand/parser"
 	handscanner "github.com/mewmew/uc/hand/scanner"
 	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/irgen/irdot"
 	"github.com/mewmew/uc/sem"
 	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
@@ -53,19 +61,30 @@ var debug bool
 
 func main() {
 	var (
+		// cfgDot specifies whether to output the control flow graphs of
+		// functions in Graphviz DOT format, instead of LLVM IR assembly.
+		cfgDot bool
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
 		// the hand-written lexer.
 		goccLexer bool
+		// handParser specifies whether to use the hand-written parser, instead
+		// of the Gocc generated parser.
+		handParser bool
 		// noColors specifies whether to disable colors in output.
 		noColors bool
 		// outputPath specifies the output path for the generated LLVM IR.
 		outputPath string
+		// warnings specifies the state of warnings.
+		warnings = semerrors.NewWarningConfig()
 	)
+	flag.BoolVar(&cfgDot, "cfg-dot", false, "output control flow graphs of functions in Graphviz DOT format")
 	flag.BoolVar(&debug, "debug", false, "enable debug output")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.BoolVar(&handParser, "hand-parser", false, "use hand-written parser")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
 	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
 	flag.StringVar(&outputPath, "o", "", "output path")
+	flag.Var(warnings, "W", "enable (name), disable (no-name) or promote to error (error=name) the named warning; or promote all warnings to errors (error)")
 	flag.Usage = usage
 	flag.Parse()
 	semerrors.UseColor = !noColors
@@ -73,6 +92,11 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
+	if goccLexer && handParser {
+		// The hand-written parser operates on the tokens of the hand-written
+		// lexer.
+		log.Fatal("invalid use of -gocc-lexer with -hand-parser; the hand-written parser requires the hand-written lexer")
+	}
 	// TODO: Remove once nested functions are supported. For now, disallow during
 	// semantic analysis.
 	semcheck.NoNestedFunctions = true
@@ -88,15 +112,21 @@ func main() {
 		defer output.Close()
 	}
 	for _, path := range flag.Args() {
-		err := compileFile(path, output, goccLexer)
+		err := compileFile(path, output, goccLexer, handParser, cfgDot, warnings)
 		if err != nil {
+			switch err.(type) {
+			case semerrors.List:
+				elog.Fatal(err)
+			}
 			log.Fatal(err)
 		}
 	}
 }
 
-// checkFile performs a static semantic analysis check on the given file.
-func compileFile(path string, output io.Writer, goccLexer bool) error {
+// compileFile compiles the given file and writes the corresponding LLVM IR
+// assembly to output; or if cfgDot is set, the control flow graphs of its
+// functions in Graphviz DOT format.
+func compileFile(path string, output io.Writer, goccLexer, handParser, cfgDot bool, warnings semerrors.WarningConfig) error {
 	// Lexical analysis
 	// Syntactic analysis
 	// Semantic analysis
@@ -121,33 +151,45 @@ func compileFile(path string, output io.Writer, goccLexer bool) error {
 	}
 
 	// Parse input.
-	p := parser.NewParser()
-	f, err := p.Parse(s)
+	input := string(buf)
+	src := semerrors.NewSource(path, input)
+	var file *ast.File
+	if handParser {
+		file, err = handparser.ParseString(input, src)
+	} else {
+		p := parser.NewParser()
+		file, err = p.ParseFile(s, src)
+	}
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(semerrors.List); ok {
+			// Report all syntax errors.
+			return errs
 		}
 		return errutil.Err(err)
 	}
-	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
-	info, err := sem.Check(file)
+	info, err := sem.CheckWarnings(file, warnings)
 	if err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
+		if errs, ok := err.(semerrors.List); ok {
+			// Add input source information to semantic analysis errors.
+			errs.SetSource(src)
+			return errs
 		}
 		return errutil.Err(err)
 	}
+	if len(info.Diagnostics) > 0 {
+		// Report warnings.
+		info.Diagnostics.SetSource(src)
+		elog.Print(info.Diagnostics)
+	}
 
 	// Generate LLVM IR module based on the syntax tree of the given file.
 	module := irgen.Gen(file, info)
+	if cfgDot {
+		if err := irdot.Fprint(output, module); err != nil {
+			return errutil.Err(err)
+		}
+		return nil
+	}
 	if _, err := fmt.Fprint(output, module); err != nil {
 		return errutil.Err(err)
 	}
@@ -157,3 +199,7 @@ func compileFile(path string, output io.Writer, goccLexer bool) error {
 
 	return nil
 }
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
@@ -0,0 +1,47 @@
+// ucls is a language server for the µC language, which communicates with
+// editors using the Language Server Protocol over standard input and standard
+// output.
+//
+// Usage: ucls [OPTION]...
+//
+// The server publishes diagnostics of open text documents, and provides hover,
+// go-to-definition, find-references, document symbol and completion support.
+//
+//   -no-nested-functions
+//        disable support for nested functions
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mewmew/uc/lsp"
+	"github.com/mewmew/uc/sem/semcheck"
+)
+
+func usage() {
+	const use = `
+Usage: ucls [OPTION]...
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// Serve requests. Standard output is reserved for protocol messages, so
+	// errors are logged to standard error.
+	s := lsp.NewServer(os.Stdin, os.Stdout)
+	if err := s.Serve(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,125 @@
+// udoc generates API documentation of µC source code from the doc comments of
+// functions, global variables and type definitions, and prints it to standard
+// output as HTML or Markdown.
+//
+// Usage: udoc [OPTION]... FILE...
+//
+// If FILE is -, read standard input.
+//
+//   -format string
+//        output format (html or markdown) (default "html")
+//   -no-colors
+//        disable colors in output
+//   -src-url string
+//        URL prefix of source file links (e.g. "https://example.org/src/")
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/doc"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+func usage() {
+	const use = `
+Usage: udoc [OPTION]... FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// format specifies the output format.
+		format string
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// srcURL specifies the URL prefix of source file links.
+		srcURL string
+	)
+	flag.StringVar(&format, "format", "html", "output format (html or markdown)")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.StringVar(&srcURL, "src-url", "", `URL prefix of source file links (e.g. "https://example.org/src/")`)
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var write func(w io.Writer, files []*doc.File, srcURL string) error
+	switch format {
+	case "html":
+		write = doc.WriteHTML
+	case "markdown":
+		write = doc.WriteMarkdown
+	default:
+		log.Fatalf("invalid output format %q; expected html or markdown", format)
+	}
+
+	// Document input. The files share a file set, so that the positions of
+	// different files are distinct.
+	fset := token.NewFileSet()
+	var files []*doc.File
+	for _, path := range flag.Args() {
+		f, err := docFile(fset, path)
+		if err != nil {
+			if _, ok := err.(semerrors.List); ok {
+				elog.Fatal(err)
+			}
+			log.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	if err := write(os.Stdout, files, srcURL); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// docFile returns the documentation of the given file, and adds the file to the
+// file set.
+func docFile(fset *token.FileSet, path string) (*doc.File, error) {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	if path == "-" {
+		path = "<stdin>"
+	}
+
+	// Parse input.
+	src := semerrors.AddSource(fset, path, string(buf))
+	file, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	if err != nil {
+		if _, ok := err.(semerrors.List); ok {
+			return nil, err
+		}
+		return nil, errutil.Err(err)
+	}
+	// Resolve the type names of signatures.
+	if _, err := sem.Check(file); err != nil {
+		if errs, ok := err.(semerrors.List); ok {
+			errs.SetSource(src)
+			return nil, errs
+		}
+		return nil, errutil.Err(err)
+	}
+	return doc.New(src, file), nil
+}
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
@@ -0,0 +1,174 @@
+// ufmt formats µC source code, using canonical indentation, spacing and brace
+// style.
+//
+// Usage: ufmt [OPTION]... [FILE]...
+//
+// If no FILE is given, or FILE is -, read standard input. By default, the
+// formatted source code is written to standard output.
+//
+//   -d
+//        display diffs instead of rewriting files
+//   -no-colors
+//        disable colors in output
+//   -w
+//        write result to (source) file instead of standard output
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/printer"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func usage() {
+	const use = `
+Usage: ufmt [OPTION]... [FILE]...
+
+If no FILE is given, or FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// diff specifies whether to display diffs instead of rewriting files.
+		diff bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// write specifies whether to write the result to the source file instead
+		// of standard output.
+		write bool
+	)
+	flag.BoolVar(&diff, "d", false, "display diffs instead of rewriting files")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.BoolVar(&write, "w", false, "write result to (source) file instead of standard output")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	// Format input.
+	status := 0
+	for _, path := range paths {
+		err := formatFile(path, write, diff)
+		if err != nil {
+			if _, ok := err.(semerrors.List); ok {
+				elog.Print(err)
+			} else {
+				log.Print(err)
+			}
+			status = 1
+		}
+	}
+	os.Exit(status)
+}
+
+// formatFile formats the given file. The formatted source code is written to
+// standard output, unless write or diff is set, in which case the source file
+// is rewritten or the differences are written to standard output,
+// respectively. Standard input is never rewritten.
+func formatFile(path string, write, diff bool) error {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	name := path
+	if path == "-" {
+		name = "<stdin>"
+		write = false
+	}
+
+	// Parse input.
+	src := semerrors.NewSource(name, string(buf))
+	f, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	if err != nil {
+		// Never format files containing syntax errors, as declarations and
+		// statements containing syntax errors are omitted from partial files.
+		if _, ok := err.(semerrors.List); ok {
+			return err
+		}
+		return errutil.Err(err)
+	}
+	res := printer.Source(src, f)
+
+	switch {
+	case diff:
+		if bytes.Equal(buf, res) {
+			return nil
+		}
+		d, err := diffSource(name, buf, res)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		os.Stdout.Write(d)
+	case write:
+		if bytes.Equal(buf, res) {
+			return nil
+		}
+		if err := ioutil.WriteFile(path, res, 0644); err != nil {
+			return errutil.Err(err)
+		}
+	default:
+		os.Stdout.Write(res)
+	}
+	return nil
+}
+
+// diffSource returns the differences between the original and the formatted
+// source code of the named file, in unified diff format. The differences are
+// computed using the diff tool.
+func diffSource(name string, orig, res []byte) ([]byte, error) {
+	f1, err := writeTempFile("ufmt", orig)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	defer os.Remove(f1)
+	f2, err := writeTempFile("ufmt", res)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	defer os.Remove(f2)
+	cmd := exec.Command("diff", "-u", "--label", name+".orig", "--label", name, f1, f2)
+	d, err := cmd.Output()
+	if len(d) > 0 {
+		// diff exits with a non-zero status if the files differ.
+		return d, nil
+	}
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return d, nil
+}
+
+// writeTempFile writes data to a new temporary file, and returns its path.
+func writeTempFile(prefix string, data []byte) (string, error) {
+	f, err := ioutil.TempFile("", prefix)
+	if err != nil {
+		return "", errutil.Err(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		os.Remove(f.Name())
+		return "", errutil.Err(err)
+	}
+	return f.Name(), nil
+}
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
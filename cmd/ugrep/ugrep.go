@@ -0,0 +1,172 @@
+// ugrep searches µC source code for expressions and statements structurally
+// matching a pattern, and prints the matches to standard output.
+//
+// Usage: ugrep [OPTION]... PATTERN FILE...
+//
+// If FILE is -, read standard input.
+//
+// The pattern is a µC expression or statement, which may contain metavariables
+// (e.g. $x) matching any expression, optionally constrained by type (e.g.
+// $x:int). Patterns ending with a semicolon or a right brace are statements.
+// Each match is printed as file:line: followed by the first source line of
+// the match. The exit status is 1 if no match was found.
+//
+// Examples.
+//
+//    ugrep 'putint($x / $y)' foo.c
+//    ugrep -within 'while ($c) $s;' 'g = $x' foo.c
+//
+//   -no-colors
+//        disable colors in output
+//   -within string
+//        only report matches nested within matches of the given pattern
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/search"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+func usage() {
+	const use = `
+Usage: ugrep [OPTION]... PATTERN FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// within specifies a pattern enclosing the reported matches; or empty if
+		// unrestricted.
+		within string
+	)
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.StringVar(&within, "within", "", "only report matches nested within matches of the given pattern")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if flag.NArg() < 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	pattern, err := search.Compile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var outer *search.Pattern
+	if len(within) > 0 {
+		if outer, err = search.Compile(within); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Search input. The files share a file set, so that the positions of
+	// different files are distinct.
+	fset := token.NewFileSet()
+	found := false
+	for _, path := range flag.Args()[1:] {
+		ok, err := grepFile(fset, path, pattern, outer)
+		if err != nil {
+			switch err.(type) {
+			case semerrors.List:
+				elog.Print(err)
+			default:
+				log.Print(err)
+			}
+		}
+		found = found || ok
+	}
+	if !found {
+		os.Exit(1)
+	}
+}
+
+// grepFile prints the matches of the given pattern within the given file,
+// optionally restricted to matches nested within matches of the outer pattern,
+// and reports whether any match was found. The file is added to the file set.
+//
+// The semantic analysis errors of the file are ignored; expressions without
+// type information never match metavariables with type constraints.
+func grepFile(fset *token.FileSet, path string, pattern, outer *search.Pattern) (bool, error) {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return false, errutil.Err(err)
+	}
+	if path == "-" {
+		path = "<stdin>"
+	}
+
+	// Parse input.
+	src := semerrors.AddSource(fset, path, string(buf))
+	file, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	if err != nil {
+		if _, ok := err.(semerrors.List); ok {
+			return false, err
+		}
+		return false, errutil.Err(err)
+	}
+	info, err := sem.Check(file)
+	if err != nil {
+		if _, ok := err.(semerrors.List); !ok {
+			return false, errutil.Err(err)
+		}
+	}
+
+	// Search parse tree.
+	matches := pattern.Find(file, info)
+	if outer != nil {
+		matches = nested(matches, outer.Find(file, info))
+	}
+	for _, m := range matches {
+		line, _ := src.Position(m.Node.Start())
+		end := len(src.Input)
+		if line < len(src.Lines) {
+			end = src.Lines[line]
+		}
+		text := strings.TrimRight(src.Input[src.Lines[line-1]:end], "\r\n")
+		fmt.Printf("%s:%d: %s\n", path, line, text)
+	}
+	return len(matches) > 0, nil
+}
+
+// nested returns the given matches nested within any of the given outer
+// matches.
+func nested(matches, outers []*search.Match) []*search.Match {
+	var ms []*search.Match
+	for _, m := range matches {
+		for _, outer := range outers {
+			if contains(outer.Node, m.Node) {
+				ms = append(ms, m)
+				break
+			}
+		}
+	}
+	return ms
+}
+
+// contains reports whether the node n is nested within the outer node.
+func contains(outer, n ast.Node) bool {
+	return outer != n && outer.Start() <= n.Start() && n.End() <= outer.End()
+}
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
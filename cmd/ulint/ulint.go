@@ -0,0 +1,169 @@
+// ulint is a linter for the µC language which reports valid but suspicious code
+// to standard error.
+//
+// Usage: ulint [OPTION]... FILE...
+//
+// If FILE is -, read standard input. The exit status is 1 if any diagnostics
+// were reported. The warnings of semantic analysis (e.g. narrowing) are only
+// reported when no checks are selected with -checks.
+//
+//   -W value
+//        enable (name), disable (no-name) or promote to error (error=name) the
+//        named warning; or promote all warnings to errors (error)
+//   -checks string
+//        comma-separated list of checks to run (default all)
+//   -list
+//        list available checks
+//   -no-colors
+//        disable colors in output
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/lint"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func usage() {
+	const use = `
+Usage: ulint [OPTION]... FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// checks specifies a comma-separated list of checks to run; or all
+		// checks if empty.
+		checks string
+		// list specifies whether to list available checks.
+		list bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// warnings specifies the state of warnings.
+		warnings = lint.Warnings()
+	)
+	flag.StringVar(&checks, "checks", "", "comma-separated list of checks to run (default all)")
+	flag.BoolVar(&list, "list", false, "list available checks")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.Var(warnings, "W", "enable (name), disable (no-name) or promote to error (error=name) the named warning; or promote all warnings to errors (error)")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if list {
+		for _, a := range lint.Analyzers() {
+			fmt.Printf("%-20s %s\n", a.Name, a.Doc)
+		}
+		return
+	}
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	analyzers, err := parseChecks(checks)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Lint input.
+	semWarnings := len(checks) == 0
+	status := 0
+	for _, path := range flag.Args() {
+		if err := lintFile(path, analyzers, semWarnings, warnings); err != nil {
+			switch err.(type) {
+			case semerrors.List:
+				elog.Print(err)
+			default:
+				log.Print(err)
+			}
+			status = 1
+		}
+	}
+	os.Exit(status)
+}
+
+// parseChecks returns the analyzers of the given comma-separated list of check
+// names; or all registered analyzers if empty.
+func parseChecks(checks string) ([]*lint.Analyzer, error) {
+	if len(checks) == 0 {
+		return lint.Analyzers(), nil
+	}
+	var analyzers []*lint.Analyzer
+	for _, name := range strings.Split(checks, ",") {
+		a := lint.Lookup(strings.TrimSpace(name))
+		if a == nil {
+			var names []string
+			for _, a := range lint.Analyzers() {
+				names = append(names, a.Name)
+			}
+			return nil, errutil.Newf("unknown check %q; valid checks: %s", name, strings.Join(names, ", "))
+		}
+		analyzers = append(analyzers, a)
+	}
+	return analyzers, nil
+}
+
+// lintFile runs the given analyzers on the given file. The diagnostics of the
+// analyzers, and optionally the semantic analysis warnings, are returned as an
+// semerrors.List error, if any.
+func lintFile(path string, analyzers []*lint.Analyzer, semWarnings bool, warnings semerrors.WarningConfig) error {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	if path == "-" {
+		path = "<stdin>"
+	}
+
+	// Parse input.
+	src := semerrors.NewSource(path, string(buf))
+	file, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	if err != nil {
+		if _, ok := err.(semerrors.List); ok {
+			return err
+		}
+		return errutil.Err(err)
+	}
+	// Never lint files containing semantic errors, as the identifier resolution
+	// of such files is unreliable.
+	info, err := sem.CheckWarnings(file, warnings)
+	if err != nil {
+		if errs, ok := err.(semerrors.List); ok {
+			errs.SetSource(src)
+			return errs
+		}
+		return errutil.Err(err)
+	}
+
+	// Run checks.
+	diags, err := lint.Run(file, info, analyzers, warnings)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	if semWarnings {
+		diags = append(diags, info.Diagnostics...)
+	}
+	if len(diags) == 0 {
+		return nil
+	}
+	diags.Sort()
+	diags.SetSource(src)
+	return diags
+}
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
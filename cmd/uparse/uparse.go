@@ -5,8 +5,16 @@
 //
 // If FILE is -, read standard input.
 //
+//   -dot
+//        output abstract syntax trees in Graphviz DOT format
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -hand-parser
+//        use hand-written parser
+//   -json
+//        output abstract syntax trees in JSON format
+//   -no-colors
+//        disable colors in output
 package main
 
 import (
@@ -20,10 +28,14 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewkiz/pkg/ioutilx"
 	"github.com/mewmew/uc/ast"
-	"github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/ast/astdot"
+	"github.com/mewmew/uc/ast/astjson"
 	"github.com/mewmew/uc/gocc/parser"
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
+	handparser "github.com/mewmew/uc/hand/parser"
 	handscanner "github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
 )
 
 func usage() {
@@ -38,36 +50,67 @@ If FILE is -, read standard input.
 
 func main() {
 	var (
+		// dotOutput specifies whether to output abstract syntax trees in
+		// Graphviz DOT format.
+		dotOutput bool
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
 		// the hand-written lexer.
 		goccLexer bool
+		// handParser specifies whether to use the hand-written parser, instead
+		// of the Gocc generated parser.
+		handParser bool
+		// jsonOutput specifies whether to output abstract syntax trees in JSON
+		// format.
+		jsonOutput bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
 	)
+	flag.BoolVar(&dotOutput, "dot", false, "output abstract syntax trees in Graphviz DOT format")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.BoolVar(&handParser, "hand-parser", false, "use hand-written parser")
+	flag.BoolVar(&jsonOutput, "json", false, "output abstract syntax trees in JSON format")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
 	flag.Usage = usage
 	flag.Parse()
+	semerrors.UseColor = !noColors
 	if flag.NArg() == 0 {
 		flag.Usage()
 		os.Exit(1)
 	}
+	if goccLexer && handParser {
+		// The hand-written parser operates on the tokens of the hand-written
+		// lexer.
+		log.Fatal("invalid use of -gocc-lexer with -hand-parser; the hand-written parser requires the hand-written lexer")
+	}
 
 	// Parse input.
 	for _, path := range flag.Args() {
-		err := parseFile(path, goccLexer)
+		err := parseFile(path, goccLexer, handParser, jsonOutput, dotOutput)
 		if err != nil {
-			log.Print(err)
+			if _, ok := err.(semerrors.List); ok {
+				elog.Print(err)
+			} else {
+				log.Print(err)
+			}
 		}
 	}
 }
 
 // parseFile parses the given file and pretty-prints its abstract syntax tree to
-// standard output, optionally using the Gocc generated lexer.
-func parseFile(path string, goccLexer bool) error {
+// standard output, optionally using the Gocc generated lexer or the
+// hand-written parser. If jsonOutput is set, the abstract syntax tree is
+// written in JSON format instead, with links from identifiers to their resolved
+// declarations. If dotOutput is set, the abstract syntax tree is written in
+// Graphviz DOT format instead, with edges from identifiers to their resolved
+// declarations.
+func parseFile(path string, goccLexer, handParser, jsonOutput, dotOutput bool) error {
 	// Create lexer for the input.
 	buf, err := ioutilx.ReadFile(path)
 	if err != nil {
 		return errutil.Err(err)
 	}
 	if path == "-" {
+		path = "<stdin>"
 		fmt.Fprintln(os.Stderr, "Parsing from standard input")
 	} else {
 		fmt.Fprintf(os.Stderr, "Parsing %q\n", path)
@@ -80,16 +123,44 @@ func parseFile(path string, goccLexer bool) error {
 	}
 
 	// Parse input.
-	p := parser.NewParser()
-	file, err := p.Parse(s)
-	if err != nil {
-		if err, ok := err.(*errors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+	src := semerrors.NewSource(path, string(buf))
+	var f *ast.File
+	if handParser {
+		f, err = handparser.ParseString(string(buf), src)
+	} else {
+		p := parser.NewParser()
+		f, err = p.ParseFile(s, src)
+	}
+	if f == nil {
+		if _, ok := err.(semerrors.List); ok {
+			return err
 		}
 		return errutil.Err(err)
 	}
-	f := file.(*ast.File)
+	// Print the partial file in case of syntax errors.
+	if jsonOutput || dotOutput {
+		// Resolve identifiers of syntactically valid files, to output the
+		// declarations referred to by identifiers. Semantic errors are reported
+		// but not fatal; undeclared identifiers are output without declaration
+		// links.
+		if err == nil {
+			if err := resolve(f, src); err != nil {
+				return errutil.Err(err)
+			}
+		}
+	}
+	if jsonOutput {
+		if err := astjson.Encode(os.Stdout, f); err != nil {
+			return errutil.Err(err)
+		}
+		return err
+	}
+	if dotOutput {
+		if err := astdot.Fprint(os.Stdout, f); err != nil {
+			return errutil.Err(err)
+		}
+		return err
+	}
 	for _, decl := range f.Decls {
 		fmt.Println("=== [ Top-level declaration ] ===")
 		fmt.Println()
@@ -104,5 +175,24 @@ func parseFile(path string, goccLexer bool) error {
 		fmt.Println()
 	}
 
+	// Report syntax errors.
+	return err
+}
+
+// resolve resolves the identifiers of the given file to their declarations.
+// Semantic errors are reported to standard error.
+func resolve(f *ast.File, src *semerrors.Source) error {
+	if _, err := sem.Check(f); err != nil {
+		errs, ok := err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		errs.SetSource(src)
+		elog.Print(errs)
+	}
 	return nil
 }
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
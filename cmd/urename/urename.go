@@ -0,0 +1,143 @@
+// urename renames identifiers of µC source code, and prints the rewritten
+// source code to standard output.
+//
+// Usage: urename -pos FILE:OFFSET -to NAME
+//
+// The identifier at the given byte offset of FILE is renamed, together with
+// all identifiers referring to the same declaration. The rename is refused if
+// it would cause a conflict or change the declaration referred to by an
+// identifier in any scope.
+//
+//   -no-colors
+//        disable colors in output
+//   -pos string
+//        position of identifier to rename (file:offset)
+//   -to string
+//        new name of identifier
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/refactor"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func usage() {
+	const use = `
+Usage: urename -pos FILE:OFFSET -to NAME
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// pos specifies the position of the identifier to rename, as file:offset.
+		pos string
+		// to specifies the new name of the identifier.
+		to string
+	)
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.StringVar(&pos, "pos", "", "position of identifier to rename (file:offset)")
+	flag.StringVar(&to, "to", "", "new name of identifier")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if len(pos) == 0 || len(to) == 0 || flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// Rename identifier.
+	path, offset, err := parsePos(pos)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := renameFile(path, offset, to); err != nil {
+		if _, ok := err.(semerrors.List); ok {
+			elog.Fatal(err)
+		}
+		log.Fatal(err)
+	}
+}
+
+// parsePos parses the given file:offset position.
+func parsePos(pos string) (path string, offset int, err error) {
+	i := strings.LastIndex(pos, ":")
+	if i == -1 {
+		return "", 0, errutil.Newf("invalid position %q; expected file:offset", pos)
+	}
+	path = pos[:i]
+	offset, err = strconv.Atoi(pos[i+1:])
+	if err != nil || offset < 0 {
+		return "", 0, errutil.Newf("invalid offset of position %q; expected non-negative integer", pos)
+	}
+	return path, offset, nil
+}
+
+// renameFile renames the identifier at the given byte offset of the given
+// file, and prints the rewritten source code to standard output.
+func renameFile(path string, offset int, to string) error {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	name := path
+	if path == "-" {
+		name = "<stdin>"
+	}
+	if offset > len(buf) {
+		return errutil.Newf("invalid offset %d of %q; expected offset in range [0, %d]", offset, name, len(buf))
+	}
+
+	// Parse input.
+	src := semerrors.NewSource(name, string(buf))
+	file, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	if err != nil {
+		if _, ok := err.(semerrors.List); ok {
+			return err
+		}
+		return errutil.Err(err)
+	}
+	// Never rename identifiers of files containing semantic errors, as the
+	// identifier resolution of such files is unreliable.
+	info, err := sem.Check(file)
+	if err != nil {
+		if errs, ok := err.(semerrors.List); ok {
+			errs.SetSource(src)
+			return errs
+		}
+		return errutil.Err(err)
+	}
+
+	// Rename identifier.
+	res, err := refactor.Rename(src, file, info, src.File.Pos(offset), to)
+	if err != nil {
+		if errs, ok := err.(semerrors.List); ok {
+			errs.SetSource(src)
+			return errs
+		}
+		return errutil.Err(err)
+	}
+	if _, err := os.Stdout.Write(res); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
@@ -5,8 +5,13 @@
 //
 // If FILE is -, read standard input.
 //
+//   -W value
+//        enable (name), disable (no-name) or promote to error (error=name) the
+//        named warning; or promote all warnings to errors (error)
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -hand-parser
+//        use hand-written parser
 //   -no-colors
 //        disable colors in output
 //   -no-nested-functions
@@ -22,9 +27,9 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewkiz/pkg/ioutilx"
 	"github.com/mewmew/uc/ast"
-	goccerrors "github.com/mewmew/uc/gocc/errors"
 	"github.com/mewmew/uc/gocc/parser"
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
+	handparser "github.com/mewmew/uc/hand/parser"
 	handscanner "github.com/mewmew/uc/hand/scanner"
 	"github.com/mewmew/uc/sem"
 	semerrors "github.com/mewmew/uc/sem/errors"
@@ -46,12 +51,19 @@ func main() {
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
 		// the hand-written lexer.
 		goccLexer bool
+		// handParser specifies whether to use the hand-written parser, instead
+		// of the Gocc generated parser.
+		handParser bool
 		// noColors specifies whether to disable colors in output.
 		noColors bool
+		// warnings specifies the state of warnings.
+		warnings = semerrors.NewWarningConfig()
 	)
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.BoolVar(&handParser, "hand-parser", false, "use hand-written parser")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
 	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.Var(warnings, "W", "enable (name), disable (no-name) or promote to error (error=name) the named warning; or promote all warnings to errors (error)")
 	flag.Usage = usage
 	flag.Parse()
 	semerrors.UseColor = !noColors
@@ -59,14 +71,20 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
+	if goccLexer && handParser {
+		// The hand-written parser operates on the tokens of the hand-written
+		// lexer.
+		log.Fatal("invalid use of -gocc-lexer with -hand-parser; the hand-written parser requires the hand-written lexer")
+	}
 
 	// Parse input.
 	for _, path := range flag.Args() {
-		err := checkFile(path, goccLexer)
+		err := checkFile(path, goccLexer, handParser, warnings)
 		if err != nil {
-			if _, ok := err.(*semerrors.Error); ok {
+			switch err.(type) {
+			case semerrors.List:
 				elog.Print(err)
-			} else {
+			default:
 				log.Print(err)
 			}
 		}
@@ -74,7 +92,7 @@ func main() {
 }
 
 // checkFile performs a static semantic analysis check on the given file.
-func checkFile(path string, goccLexer bool) error {
+func checkFile(path string, goccLexer, handParser bool, warnings semerrors.WarningConfig) error {
 	// Lexical analysis
 	// Syntactic analysis (skip function bodies)
 	// Top-level declarations; used for forward-declarations.
@@ -104,29 +122,36 @@ func checkFile(path string, goccLexer bool) error {
 	}
 
 	// Parse input.
-	p := parser.NewParser()
-	f, err := p.Parse(s)
+	input := string(buf)
+	src := semerrors.NewSource(path, input)
+	var file *ast.File
+	if handParser {
+		file, err = handparser.ParseString(input, src)
+	} else {
+		p := parser.NewParser()
+		file, err = p.ParseFile(s, src)
+	}
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(semerrors.List); ok {
+			// Report all syntax errors.
+			return errs
 		}
 		return errutil.Err(err)
 	}
-	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
-	if _, err := sem.Check(file); err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
+	info, err := sem.CheckWarnings(file, warnings)
+	if err != nil {
+		if errs, ok := err.(semerrors.List); ok {
+			// Add input source information to semantic analysis errors.
+			errs.SetSource(src)
+			return errs
 		}
 		return errutil.Err(err)
 	}
+	if len(info.Diagnostics) > 0 {
+		// Report warnings.
+		info.Diagnostics.SetSource(src)
+		elog.Print(info.Diagnostics)
+	}
 
 	return nil
 }
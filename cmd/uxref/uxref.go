@@ -0,0 +1,128 @@
+// uxref generates cross-reference indices of µC source code, and prints them
+// to standard output as tags files or JSON.
+//
+// Usage: uxref [OPTION]... FILE...
+//
+// If FILE is -, read standard input.
+//
+// The ctags and etags formats list the declarations and definitions of all
+// global and local symbols, tagged by name and by qualified name (e.g. "f.x"
+// for the local variable x of function f). The JSON format additionally lists
+// the uses of each symbol.
+//
+//   -format string
+//        output format (ctags, etags or json) (default "ctags")
+//   -no-colors
+//        disable colors in output
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/xref"
+)
+
+func usage() {
+	const use = `
+Usage: uxref [OPTION]... FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// format specifies the output format.
+		format string
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+	)
+	flag.StringVar(&format, "format", "ctags", "output format (ctags, etags or json)")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var write func(w io.Writer, files []*xref.File) error
+	switch format {
+	case "ctags":
+		write = xref.WriteCtags
+	case "etags":
+		write = xref.WriteEtags
+	case "json":
+		write = xref.WriteJSON
+	default:
+		log.Fatalf("invalid output format %q; expected ctags, etags or json", format)
+	}
+
+	// Index input. The files share a file set, so that the positions of
+	// different files are distinct.
+	fset := token.NewFileSet()
+	var files []*xref.File
+	for _, path := range flag.Args() {
+		f, err := indexFile(fset, path)
+		if err != nil {
+			if _, ok := err.(semerrors.List); ok {
+				elog.Fatal(err)
+			}
+			log.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	if err := write(os.Stdout, files); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// indexFile returns the cross-reference index of the given file, and adds the
+// file to the file set.
+func indexFile(fset *token.FileSet, path string) (*xref.File, error) {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	if path == "-" {
+		path = "<stdin>"
+	}
+
+	// Parse input.
+	src := semerrors.AddSource(fset, path, string(buf))
+	file, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	if err != nil {
+		if _, ok := err.(semerrors.List); ok {
+			return nil, err
+		}
+		return nil, errutil.Err(err)
+	}
+	// Never index files containing semantic errors, as the identifier
+	// resolution of such files is unreliable.
+	info, err := sem.Check(file)
+	if err != nil {
+		if errs, ok := err.(semerrors.List); ok {
+			errs.SetSource(src)
+			return nil, errs
+		}
+		return nil, errutil.Err(err)
+	}
+	return xref.Index(src, file, info), nil
+}
+
+// elog represents a logger with no prefix or flags, which logs errors to
+// standard error.
+var elog = log.New(os.Stderr, "", 0)
@@ -0,0 +1,279 @@
+// Package doc extracts documentation from the doc comments of the top-level
+// declarations of µC source files, and renders it as HTML or Markdown.
+//
+// The functions, global variables and type definitions of each file are
+// documented in source order, each with its signature, its doc comment and a
+// link to its source line. Declarations with internal linkage (i.e. static
+// functions and global variables) are omitted, as they are not part of the API
+// of the file.
+package doc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/types"
+)
+
+// A File holds the documentation of a source file.
+type File struct {
+	// Input source path.
+	Path string
+	// Functions of the file, in source order.
+	Funcs []*Entry
+	// Global variables of the file, in source order.
+	Vars []*Entry
+	// Type definitions of the file, in source order.
+	TypeDefs []*Entry
+}
+
+// An Entry holds the documentation of a declared identifier.
+type Entry struct {
+	// Name of the declared identifier.
+	Name string
+	// Signature of the declaration; e.g.
+	//
+	//    int puts(char s[])
+	Signature string
+	// Doc comment text of the declaration; or empty if undocumented.
+	Doc string
+	// Line number of the declaration, starting at 1. For identifiers declared
+	// more than once, the line of the definition (if any) is used.
+	Line int
+}
+
+// New returns the documentation of the given resolved parse tree of a source
+// file. For identifiers declared more than once (e.g. a function prototype and
+// its definition), the first doc comment is used.
+func New(src *errors.Source, file *ast.File) *File {
+	f := &File{Path: src.Path}
+	entries := make(map[string]*Entry)
+	for _, decl := range file.Decls {
+		name := decl.Name()
+		if name == nil || isStatic(decl) {
+			continue
+		}
+		line, _ := src.Position(name.Start())
+		entry, ok := entries[name.Name]
+		if !ok {
+			entry = &Entry{Name: name.Name, Signature: signature(decl), Line: line}
+			entries[name.Name] = entry
+			switch decl.(type) {
+			case *ast.FuncDecl:
+				f.Funcs = append(f.Funcs, entry)
+			case *ast.VarDecl:
+				f.Vars = append(f.Vars, entry)
+			case *ast.TypeDef:
+				f.TypeDefs = append(f.TypeDefs, entry)
+			}
+		} else if isDef(decl) {
+			// Prefer the signature and line of the definition.
+			entry.Signature = signature(decl)
+			entry.Line = line
+		}
+		if len(entry.Doc) == 0 {
+			entry.Doc = docText(docOf(decl))
+		}
+	}
+	return f
+}
+
+// signature returns the signature of the given declaration.
+func signature(decl ast.Decl) string {
+	name := decl.Name().Name
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return types.Decl(name, decl.Type())
+	case *ast.VarDecl:
+		if decl.Storage == ast.Extern {
+			return "extern " + types.Decl(name, decl.Type())
+		}
+		return types.Decl(name, decl.Type())
+	case *ast.TypeDef:
+		return "typedef " + types.Decl(name, decl.Type())
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", decl))
+	}
+}
+
+// docOf returns the doc comment of the given declaration; or nil if none.
+func docOf(decl ast.Decl) *ast.CommentGroup {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return decl.Doc
+	case *ast.VarDecl:
+		return decl.Doc
+	case *ast.TypeDef:
+		return decl.Doc
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", decl))
+	}
+}
+
+// docText returns the text of the given doc comment, as returned by Text, with
+// the decorations of multi-line block comments removed; i.e. the leading
+// asterisks of lines (e.g. " * "), or otherwise the indentation of lines up to
+// the column of the text of the first line.
+func docText(g *ast.CommentGroup) string {
+	if g == nil {
+		return ""
+	}
+	var lines []string
+	for _, c := range g.List {
+		text := (&ast.CommentGroup{List: []*ast.Comment{c}}).Text()
+		ls := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+		if strings.HasPrefix(c.Text, "/*") && len(ls) > 1 {
+			ls = undecorate(ls)
+		}
+		lines = append(lines, ls...)
+	}
+	// Remove leading and trailing blank lines.
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// undecorate removes the decorations of the given lines of a multi-line block
+// comment, with comment markers removed. If every line after the first starts
+// with an asterisk, the leading asterisks and a single space following them are
+// removed. Otherwise, the lines after the first are unindented up to the column
+// of the text of the first line (i.e. following the "/*" comment marker).
+func undecorate(lines []string) []string {
+	star := true
+	for _, line := range lines[1:] {
+		if t := strings.TrimLeft(line, " \t"); len(t) > 0 && !strings.HasPrefix(t, "*") {
+			star = false
+			break
+		}
+	}
+	ls := make([]string, len(lines))
+	if star {
+		for i, line := range lines {
+			t := strings.TrimLeft(line, " \t")
+			if i == 0 && !strings.HasPrefix(t, "*") {
+				ls[i] = t
+				continue
+			}
+			ls[i] = strings.TrimPrefix(strings.TrimPrefix(t, "*"), " ")
+		}
+		return ls
+	}
+	n := len("/*") + indent(lines[0])
+	for _, line := range lines[1:] {
+		if len(strings.TrimSpace(line)) > 0 && indent(line) < n {
+			n = indent(line)
+		}
+	}
+	ls[0] = strings.TrimLeft(lines[0], " \t")
+	for i, line := range lines[1:] {
+		if len(line) < n {
+			// Blank line.
+			continue
+		}
+		ls[1+i] = line[n:]
+	}
+	return ls
+}
+
+// indent returns the length of the leading whitespace of the given line.
+func indent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+// isStatic reports whether the given declaration has the storage-class
+// specifier static.
+func isStatic(decl ast.Decl) bool {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return decl.Storage == ast.Static
+	case *ast.VarDecl:
+		return decl.Storage == ast.Static
+	default:
+		return false
+	}
+}
+
+// isDef reports whether the given declaration is a function or variable
+// definition.
+func isDef(decl ast.Decl) bool {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return decl.Body != nil
+	case *ast.VarDecl:
+		return decl.Storage != ast.Extern
+	default:
+		return false
+	}
+}
+
+// A block is a paragraph or preformatted block of doc comment text.
+type block struct {
+	// Preformatted block; i.e. consecutive indented lines.
+	Pre bool
+	// Text of the block. The lines of preformatted blocks are unindented by
+	// their common indentation.
+	Text string
+}
+
+// blocks splits the given doc comment text into paragraphs, separated by blank
+// lines, and preformatted blocks of indented lines.
+func blocks(text string) []block {
+	var bs []block
+	var lines []string
+	pre := false
+	flush := func() {
+		if len(lines) == 0 {
+			return
+		}
+		if pre {
+			bs = append(bs, block{Pre: true, Text: strings.Join(unindent(lines), "\n")})
+		} else {
+			bs = append(bs, block{Text: strings.Join(lines, "\n")})
+		}
+		lines = nil
+	}
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		indented := line[0] == ' ' || line[0] == '\t'
+		if indented != pre {
+			flush()
+			pre = indented
+		}
+		lines = append(lines, line)
+	}
+	flush()
+	return bs
+}
+
+// unindent removes the common leading whitespace of the given lines.
+func unindent(lines []string) []string {
+	prefix := lines[0][:len(lines[0])-len(strings.TrimLeft(lines[0], " \t"))]
+	for _, line := range lines[1:] {
+		for !strings.HasPrefix(line, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	var ls []string
+	for _, line := range lines {
+		ls = append(ls, line[len(prefix):])
+	}
+	return ls
+}
+
+// link returns the URL of the given source line of the file, relative to the
+// given source URL prefix.
+func (f *File) link(srcURL string, line int) string {
+	return fmt.Sprintf("%s%s#L%d", srcURL, f.Path, line)
+}
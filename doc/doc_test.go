@@ -0,0 +1,214 @@
+package doc_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/doc"
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+const input = `// Size of buffers.
+typedef int size;
+
+// buf holds the output.
+char buf[128];
+
+static int hidden;
+
+// puts prints the string s.
+//
+// For instance,
+//
+//    puts("foo");
+int puts(char s[]);
+
+int puts(char s[]) {
+	return 0;
+}
+
+int getint(void) { return 0; }
+`
+
+// newFile returns the documentation of the input.
+func newFile(t *testing.T) *doc.File {
+	src := semerrors.NewSource("lib.c", input)
+	file, err := parser.ParseString(input, src)
+	if err != nil {
+		t.Fatalf("unable to parse input; %v", err)
+	}
+	if _, err := sem.Check(file); err != nil {
+		t.Fatalf("unable to check input; %v", err)
+	}
+	return doc.New(src, file)
+}
+
+func TestNew(t *testing.T) {
+	f := newFile(t)
+	golden := []struct {
+		entries []*doc.Entry
+		want    []doc.Entry
+	}{
+		{
+			entries: f.TypeDefs,
+			want: []doc.Entry{
+				{Name: "size", Signature: "typedef int size", Doc: "Size of buffers.\n", Line: 2},
+			},
+		},
+		{
+			entries: f.Vars,
+			want: []doc.Entry{
+				{Name: "buf", Signature: "char buf[128]", Doc: "buf holds the output.\n", Line: 5},
+			},
+		},
+		{
+			entries: f.Funcs,
+			want: []doc.Entry{
+				{Name: "puts", Signature: "int puts(char s[])", Doc: "puts prints the string s.\n\nFor instance,\n\n   puts(\"foo\");\n", Line: 16},
+				{Name: "getint", Signature: "int getint(void)", Line: 20},
+			},
+		},
+	}
+	for _, g := range golden {
+		if len(g.entries) != len(g.want) {
+			t.Errorf("entries mismatch; expected %d entries, got %d", len(g.want), len(g.entries))
+			continue
+		}
+		for i, entry := range g.entries {
+			if *entry != g.want[i] {
+				t.Errorf("entry mismatch; expected %#v, got %#v", g.want[i], *entry)
+			}
+		}
+	}
+}
+
+func TestNewBlockComment(t *testing.T) {
+	golden := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "/* The global counter.\n *\n *    counter += 1\n */\nint counter;\n",
+			want:  "The global counter.\n\n   counter += 1\n",
+		},
+		{
+			input: "/**\n * The global counter.\n */\nint counter;\n",
+			want:  "The global counter.\n",
+		},
+		{
+			input: "/* Some strange but legal expressions and statements.\n   For more examples, see noisy/simple.\n*/\nint x;\n",
+			want:  "Some strange but legal expressions and statements.\nFor more examples, see noisy/simple.\n",
+		},
+		{
+			input: "/* The global counter; e.g.\n\n         counter += 1\n*/\nint counter;\n",
+			want:  "The global counter; e.g.\n\n      counter += 1\n",
+		},
+	}
+	for _, g := range golden {
+		src := semerrors.NewSource("lib.c", g.input)
+		file, err := parser.ParseString(g.input, src)
+		if err != nil {
+			t.Errorf("%q: unable to parse input; %v", g.input, err)
+			continue
+		}
+		if _, err := sem.Check(file); err != nil {
+			t.Errorf("%q: unable to check input; %v", g.input, err)
+			continue
+		}
+		f := doc.New(src, file)
+		if len(f.Vars) != 1 {
+			t.Errorf("%q: entries mismatch; expected 1 entry, got %d", g.input, len(f.Vars))
+			continue
+		}
+		if got := f.Vars[0].Doc; got != g.want {
+			t.Errorf("%q: doc mismatch; expected %q, got %q", g.input, g.want, got)
+		}
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	want := strings.Replace(`# lib.c
+
+## Type definitions
+
+### size
+
+~~~c
+typedef int size
+~~~
+
+Size of buffers.
+
+[lib.c:2](src/lib.c#L2)
+
+## Global variables
+
+### buf
+
+~~~c
+char buf[128]
+~~~
+
+buf holds the output.
+
+[lib.c:5](src/lib.c#L5)
+
+## Functions
+
+### puts
+
+~~~c
+int puts(char s[])
+~~~
+
+puts prints the string s.
+
+For instance,
+
+~~~
+puts("foo");
+~~~
+
+[lib.c:16](src/lib.c#L16)
+
+### getint
+
+~~~c
+int getint(void)
+~~~
+
+[lib.c:20](src/lib.c#L20)
+`, "~~~", "```", -1)
+	buf := &bytes.Buffer{}
+	if err := doc.WriteMarkdown(buf, []*doc.File{newFile(t)}, "src/"); err != nil {
+		t.Fatalf("unable to write documentation; %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("documentation mismatch; expected %q, got %q", want, got)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := doc.WriteHTML(buf, []*doc.File{newFile(t)}, ""); err != nil {
+		t.Fatalf("unable to write documentation; %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		`<h3 id="puts">puts</h3>`,
+		`<pre>int puts(char s[])</pre>`,
+		`<p>puts prints the string s.</p>`,
+		`<pre>puts(&#34;foo&#34;);</pre>`,
+		`<p><a href="lib.c#L16">lib.c:16</a></p>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("documentation mismatch; expected %q within %q", want, got)
+		}
+	}
+	if strings.Contains(got, "hidden") {
+		t.Errorf("documentation mismatch; static declaration %q documented", "hidden")
+	}
+}
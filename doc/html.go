@@ -0,0 +1,121 @@
+package doc
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// htmlTmpl is the template of HTML documentation.
+var htmlTmpl = template.Must(template.New("doc").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+{{- range .Files}}
+<h1 id="{{.Path}}">{{.Path}}</h1>
+{{- range .Sections}}
+<h2>{{.Title}}</h2>
+{{- range .Entries}}
+<h3 id="{{.Name}}">{{.Name}}</h3>
+<pre>{{.Signature}}</pre>
+{{- range .Blocks}}
+{{- if .Pre}}
+<pre>{{.Text}}</pre>
+{{- else}}
+<p>{{.Text}}</p>
+{{- end}}
+{{- end}}
+<p><a href="{{.Link}}">{{.Pos}}</a></p>
+{{- end}}
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// WriteHTML writes the HTML documentation of the given files to w. The source
+// line of each entry is linked relative to the given source URL prefix (e.g.
+// "https://example.org/src/"), which may be empty.
+func WriteHTML(w io.Writer, files []*File, srcURL string) error {
+	if err := htmlTmpl.Execute(w, newPage(files, srcURL)); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// A page is the view of the documentation of files, as used by templates.
+type page struct {
+	// Title of the page.
+	Title string
+	// Files of the page.
+	Files []*fileView
+}
+
+// A fileView is the view of the documentation of a file.
+type fileView struct {
+	// Input source path.
+	Path string
+	// Non-empty sections of the file.
+	Sections []*section
+}
+
+// A section is a titled list of entries.
+type section struct {
+	// Title of the section (e.g. "Functions").
+	Title string
+	// Entries of the section.
+	Entries []*entryView
+}
+
+// An entryView is the view of the documentation of an entry.
+type entryView struct {
+	*Entry
+	// Paragraphs and preformatted blocks of the doc comment.
+	Blocks []block
+	// Source position of the entry (e.g. "foo.c:42").
+	Pos string
+	// Link to the source line of the entry.
+	Link string
+}
+
+// newPage returns the view of the documentation of the given files.
+func newPage(files []*File, srcURL string) *page {
+	p := &page{Title: "Documentation"}
+	if len(files) == 1 {
+		p.Title = files[0].Path
+	}
+	for _, f := range files {
+		fv := &fileView{Path: f.Path}
+		sections := []struct {
+			title   string
+			entries []*Entry
+		}{
+			{title: "Type definitions", entries: f.TypeDefs},
+			{title: "Global variables", entries: f.Vars},
+			{title: "Functions", entries: f.Funcs},
+		}
+		for _, s := range sections {
+			if len(s.entries) == 0 {
+				continue
+			}
+			sec := &section{Title: s.title}
+			for _, entry := range s.entries {
+				ev := &entryView{
+					Entry:  entry,
+					Blocks: blocks(entry.Doc),
+					Pos:    fmt.Sprintf("%s:%d", f.Path, entry.Line),
+					Link:   f.link(srcURL, entry.Line),
+				}
+				sec.Entries = append(sec.Entries, ev)
+			}
+			fv.Sections = append(fv.Sections, sec)
+		}
+		p.Files = append(p.Files, fv)
+	}
+	return p
+}
@@ -0,0 +1,43 @@
+package doc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// WriteMarkdown writes the Markdown documentation of the given files to w. The
+// source line of each entry is linked relative to the given source URL prefix
+// (e.g. "https://example.org/src/"), which may be empty.
+//
+// Signatures and preformatted blocks of doc comments are written as fenced
+// code blocks, and the paragraphs of doc comments are written verbatim.
+func WriteMarkdown(w io.Writer, files []*File, srcURL string) error {
+	buf := &bytes.Buffer{}
+	for i, f := range newPage(files, srcURL).Files {
+		if i != 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(buf, "# %s\n", f.Path)
+		for _, sec := range f.Sections {
+			fmt.Fprintf(buf, "\n## %s\n", sec.Title)
+			for _, entry := range sec.Entries {
+				fmt.Fprintf(buf, "\n### %s\n\n```c\n%s\n```\n", entry.Name, entry.Signature)
+				for _, b := range entry.Blocks {
+					if b.Pre {
+						fmt.Fprintf(buf, "\n```\n%s\n```\n", b.Text)
+					} else {
+						fmt.Fprintf(buf, "\n%s\n", b.Text)
+					}
+				}
+				fmt.Fprintf(buf, "\n[%s](%s)\n", entry.Pos, entry.Link)
+			}
+		}
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
@@ -1,226 +1,239 @@
-// generated by gocc; DO NOT EDIT.
+// Code generated by gocc; DO NOT EDIT.
 
 package lexer
 
 import (
-	// "fmt"
-	"io/ioutil"
+	"os"
 	"unicode/utf8"
 
-	// "github.com/mewmew/uc/gocc/util"
 	"github.com/mewmew/uc/gocc/token"
 )
 
 const (
 	NoState    = -1
-	NumStates  = 64
-	NumSymbols = 82
+	NumStates  = 94
+	NumSymbols = 107
 )
 
 type Lexer struct {
-	src    []byte
-	pos    int
-	line   int
-	column int
+	src     []byte
+	pos     int
+	line    int
+	column  int
+	Context token.Context
 }
 
 func NewLexer(src []byte) *Lexer {
 	lexer := &Lexer{
-		src:    src,
-		pos:    0,
-		line:   1,
-		column: 1,
+		src:     src,
+		pos:     0,
+		line:    1,
+		column:  1,
+		Context: nil,
 	}
 	return lexer
 }
 
+// SourceContext is a simple instance of a token.Context which
+// contains the name of the source file.
+type SourceContext struct {
+	Filepath string
+}
+
+func (s *SourceContext) Source() string {
+	return s.Filepath
+}
+
 func NewLexerFile(fpath string) (*Lexer, error) {
-	src, err := ioutil.ReadFile(fpath)
+	src, err := os.ReadFile(fpath)
 	if err != nil {
 		return nil, err
 	}
-	return NewLexer(src), nil
+	lexer := NewLexer(src)
+	lexer.Context = &SourceContext{Filepath: fpath}
+	return lexer, nil
 }
 
-func (this *Lexer) Scan() (tok *token.Token) {
-
-	// fmt.Printf("Lexer.Scan() pos=%d\n", this.pos)
-
-	tok = new(token.Token)
-	if this.pos >= len(this.src) {
+func (l *Lexer) Scan() (tok *token.Token) {
+	tok = &token.Token{}
+	if l.pos >= len(l.src) {
 		tok.Type = token.EOF
-		tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = this.pos, this.line, this.column
+		tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = l.pos, l.line, l.column
+		tok.Pos.Context = l.Context
 		return
 	}
-	start, startLine, startColumn, end := this.pos, this.line, this.column, 0
+	start, startLine, startColumn, end := l.pos, l.line, l.column, 0
 	tok.Type = token.INVALID
 	state, rune1, size := 0, rune(-1), 0
 	for state != -1 {
-
-		// fmt.Printf("\tpos=%d, line=%d, col=%d, state=%d\n", this.pos, this.line, this.column, state)
-
-		if this.pos >= len(this.src) {
+		if l.pos >= len(l.src) {
 			rune1 = -1
 		} else {
-			rune1, size = utf8.DecodeRune(this.src[this.pos:])
-			this.pos += size
+			rune1, size = utf8.DecodeRune(l.src[l.pos:])
+			l.pos += size
 		}
 
-		// Production start
+		nextState := -1
 		if rune1 != -1 {
-			state = TransTab[state](rune1)
-		} else {
-			state = -1
+			nextState = TransTab[state](rune1)
 		}
-		// Production end
-
-		// Debug start
-		// nextState := -1
-		// if rune1 != -1 {
-		// 	nextState = TransTab[state](rune1)
-		// }
-		// fmt.Printf("\tS%d, : tok=%s, rune == %s(%x), next state == %d\n", state, token.TokMap.Id(tok.Type), util.RuneToString(rune1), rune1, nextState)
-		// fmt.Printf("\t\tpos=%d, size=%d, start=%d, end=%d\n", this.pos, size, start, end)
-		// if nextState != -1 {
-		// 	fmt.Printf("\t\taction:%s\n", ActTab[nextState].String())
-		// }
-		// state = nextState
-		// Debug end
+		state = nextState
 
 		if state != -1 {
 
 			switch rune1 {
 			case '\n':
-				this.line++
-				this.column = 1
+				l.line++
+				l.column = 1
 			case '\r':
-				this.column = 1
+				l.column = 1
 			case '\t':
-				this.column += 4
+				l.column += 4
 			default:
-				this.column++
+				l.column++
 			}
 
 			switch {
 			case ActTab[state].Accept != -1:
 				tok.Type = ActTab[state].Accept
-				// fmt.Printf("\t Accept(%s), %s(%d)\n", string(act), token.TokMap.Id(tok), tok)
-				end = this.pos
+				end = l.pos
 			case ActTab[state].Ignore != "":
-				// fmt.Printf("\t Ignore(%s)\n", string(act))
-				start, startLine, startColumn = this.pos, this.line, this.column
+				start, startLine, startColumn = l.pos, l.line, l.column
 				state = 0
-				if start >= len(this.src) {
+				if start >= len(l.src) {
 					tok.Type = token.EOF
 				}
 
 			}
 		} else {
 			if tok.Type == token.INVALID {
-				end = this.pos
+				end = l.pos
 			}
 		}
 	}
 	if end > start {
-		this.pos = end
-		tok.Lit = this.src[start:end]
+		l.pos = end
+		tok.Lit = l.src[start:end]
 	} else {
 		tok.Lit = []byte{}
 	}
 	tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = start, startLine, startColumn
-
-	// fmt.Printf("Token at %s: %s \"%s\"\n", tok.String(), token.TokMap.Id(tok.Type), tok.Lit)
+	tok.Pos.Context = l.Context
 
 	return
 }
 
-func (this *Lexer) Reset() {
-	this.pos = 0
+func (l *Lexer) Reset() {
+	l.pos = 0
 }
 
 /*
 Lexer symbols:
-0: '''
-1: '"'
+0: '.'
+1: '.'
 2: '''
-3: ';'
-4: '('
-5: ')'
-6: '['
-7: ']'
-8: 't'
-9: 'y'
-10: 'p'
-11: 'e'
-12: 'd'
-13: 'e'
-14: 'f'
-15: ','
-16: 'r'
-17: 'e'
-18: 't'
-19: 'u'
-20: 'r'
-21: 'n'
-22: '{'
-23: '}'
-24: 'i'
-25: 'f'
-26: 'e'
-27: 'l'
-28: 's'
+3: '"'
+4: '''
+5: '}'
+6: ';'
+7: 'e'
+8: 'x'
+9: 't'
+10: 'e'
+11: 'r'
+12: 'n'
+13: 's'
+14: 't'
+15: 'a'
+16: 't'
+17: 'i'
+18: 'c'
+19: '('
+20: ')'
+21: ','
+22: '['
+23: ']'
+24: 't'
+25: 'y'
+26: 'p'
+27: 'e'
+28: 'd'
 29: 'e'
-30: 'w'
-31: 'h'
-32: 'i'
-33: 'l'
-34: 'e'
-35: '='
-36: '&'
-37: '&'
-38: '='
-39: '='
-40: '!'
-41: '='
-42: '<'
-43: '>'
-44: '<'
-45: '='
-46: '>'
-47: '='
-48: '+'
-49: '-'
-50: '*'
-51: '/'
-52: '!'
-53: '_'
-54: '/'
-55: '/'
-56: '\n'
-57: '#'
-58: '\n'
-59: '/'
-60: '*'
-61: '*'
-62: '*'
-63: '/'
-64: '\'
-65: 'n'
-66: ' '
-67: '\t'
-68: '\v'
-69: '\f'
-70: '\r'
-71: '\n'
-72: \u0001-'\t'
-73: '\v'-'\f'
-74: \u000e-'!'
-75: '#'-'&'
-76: '('-'['
-77: ']'-\u007f
-78: 'a'-'z'
-79: 'A'-'Z'
-80: '0'-'9'
-81: .
-
+30: 'f'
+31: 'c'
+32: 'o'
+33: 'n'
+34: 's'
+35: 't'
+36: 'r'
+37: 'e'
+38: 't'
+39: 'u'
+40: 'r'
+41: 'n'
+42: '{'
+43: 'i'
+44: 'f'
+45: 'e'
+46: 'l'
+47: 's'
+48: 'e'
+49: 'w'
+50: 'h'
+51: 'i'
+52: 'l'
+53: 'e'
+54: '='
+55: '&'
+56: '&'
+57: '='
+58: '='
+59: '!'
+60: '='
+61: '<'
+62: '>'
+63: '<'
+64: '='
+65: '>'
+66: '='
+67: '+'
+68: '-'
+69: '*'
+70: '/'
+71: '!'
+72: '_'
+73: '/'
+74: '/'
+75: '\n'
+76: '#'
+77: '\n'
+78: '/'
+79: '*'
+80: '*'
+81: '*'
+82: '/'
+83: 'e'
+84: 'E'
+85: '+'
+86: '-'
+87: 'f'
+88: 'F'
+89: '\'
+90: 'n'
+91: ' '
+92: '\t'
+93: '\v'
+94: '\f'
+95: '\r'
+96: '\n'
+97: \u0001-'\t'
+98: '\v'-'\f'
+99: \u000e-'!'
+100: '#'-'&'
+101: '('-'['
+102: ']'-\u007f
+103: 'a'-'z'
+104: 'A'-'Z'
+105: '0'-'9'
+106: .
 */
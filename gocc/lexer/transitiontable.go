@@ -1,4 +1,4 @@
-// generated by gocc; DO NOT EDIT.
+// Code generated by gocc; DO NOT EDIT.
 
 package lexer
 
@@ -10,7 +10,6 @@ transitionTable[s](r) returns the next state.
 type TransitionTable [NumStates]func(rune) int
 
 var TransTab = TransitionTable{
-
 	// S0
 	func(r rune) int {
 		switch {
@@ -46,907 +45,1224 @@ var TransTab = TransitionTable{
 			return 10
 		case r == 45: // ['-','-']
 			return 11
-		case r == 47: // ['/','/']
+		case r == 46: // ['.','.']
 			return 12
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 47: // ['/','/']
 			return 13
-		case r == 59: // [';',';']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 14
-		case r == 60: // ['<','<']
+		case r == 59: // [';',';']
 			return 15
-		case r == 61: // ['=','=']
+		case r == 60: // ['<','<']
 			return 16
-		case r == 62: // ['>','>']
+		case r == 61: // ['=','=']
 			return 17
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 62: // ['>','>']
 			return 18
-		case r == 91: // ['[','[']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 19
-		case r == 93: // [']',']']
+		case r == 91: // ['[','[']
 			return 20
-		case r == 95: // ['_','_']
+		case r == 93: // [']',']']
 			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
+		case r == 95: // ['_','_']
 			return 22
+		case 97 <= r && r <= 98: // ['a','b']
+			return 19
+		case r == 99: // ['c','c']
+			return 23
+		case r == 100: // ['d','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 24
 		case 102 <= r && r <= 104: // ['f','h']
-			return 18
+			return 19
 		case r == 105: // ['i','i']
-			return 23
+			return 25
 		case 106 <= r && r <= 113: // ['j','q']
-			return 18
+			return 19
 		case r == 114: // ['r','r']
-			return 24
+			return 26
 		case r == 115: // ['s','s']
-			return 18
+			return 27
 		case r == 116: // ['t','t']
-			return 25
+			return 28
 		case 117 <= r && r <= 118: // ['u','v']
-			return 18
+			return 19
 		case r == 119: // ['w','w']
-			return 26
+			return 29
 		case 120 <= r && r <= 122: // ['x','z']
-			return 18
+			return 19
 		case r == 123: // ['{','{']
-			return 27
+			return 30
 		case r == 125: // ['}','}']
-			return 28
-
+			return 31
 		}
 		return NoState
 	},
-
 	// S1
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S2
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 29
-
+			return 32
 		}
 		return NoState
 	},
-
 	// S3
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 30
-
+			return 33
 		default:
 			return 3
 		}
-
 	},
-
 	// S4
 	func(r rune) int {
 		switch {
 		case r == 38: // ['&','&']
-			return 31
-
+			return 34
 		}
 		return NoState
 	},
-
 	// S5
 	func(r rune) int {
 		switch {
 		case 1 <= r && r <= 9: // [\u0001,'\t']
-			return 32
+			return 35
 		case 11 <= r && r <= 12: // ['\v','\f']
-			return 32
+			return 35
 		case 14 <= r && r <= 33: // [\u000e,'!']
-			return 32
+			return 35
 		case r == 34: // ['"','"']
-			return 33
+			return 36
 		case 35 <= r && r <= 38: // ['#','&']
-			return 32
+			return 35
 		case 40 <= r && r <= 91: // ['(','[']
-			return 32
+			return 35
 		case r == 92: // ['\','\']
-			return 34
+			return 37
 		case 93 <= r && r <= 127: // [']',\u007f]
-			return 32
-
+			return 35
 		}
 		return NoState
 	},
-
 	// S6
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S7
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S8
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S9
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S10
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S11
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S12
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 35
-		case r == 47: // ['/','/']
-			return 36
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 38
 		}
 		return NoState
 	},
-
 	// S13
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 13
-
+		case r == 42: // ['*','*']
+			return 39
+		case r == 47: // ['/','/']
+			return 40
 		}
 		return NoState
 	},
-
 	// S14
 	func(r rune) int {
 		switch {
-
+		case r == 46: // ['.','.']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 14
+		case r == 69: // ['E','E']
+			return 42
+		case r == 101: // ['e','e']
+			return 42
 		}
 		return NoState
 	},
-
 	// S15
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 37
-
 		}
 		return NoState
 	},
-
 	// S16
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 38
-
+			return 43
 		}
 		return NoState
 	},
-
 	// S17
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 39
-
+			return 44
 		}
 		return NoState
 	},
-
 	// S18
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
+		case r == 61: // ['=','=']
+			return 45
 		}
 		return NoState
 	},
-
 	// S19
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S20
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S21
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
 		}
 		return NoState
 	},
-
 	// S22
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 107: // ['a','k']
-			return 18
-		case r == 108: // ['l','l']
-			return 41
-		case 109 <= r && r <= 122: // ['m','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S23
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 101: // ['a','e']
-			return 18
-		case r == 102: // ['f','f']
-			return 42
-		case 103 <= r && r <= 122: // ['g','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 110: // ['a','n']
+			return 19
+		case r == 111: // ['o','o']
+			return 47
+		case 112 <= r && r <= 122: // ['p','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S24
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
-			return 43
-		case 102 <= r && r <= 122: // ['f','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 19
+		case r == 108: // ['l','l']
+			return 48
+		case 109 <= r && r <= 119: // ['m','w']
+			return 19
+		case r == 120: // ['x','x']
+			return 49
+		case 121 <= r && r <= 122: // ['y','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S25
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 120: // ['a','x']
-			return 18
-		case r == 121: // ['y','y']
-			return 44
-		case r == 122: // ['z','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 101: // ['a','e']
+			return 19
+		case r == 102: // ['f','f']
+			return 50
+		case 103 <= r && r <= 122: // ['g','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S26
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 103: // ['a','g']
-			return 18
-		case r == 104: // ['h','h']
-			return 45
-		case 105 <= r && r <= 122: // ['i','z']
-			return 18
-
-		}
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 51
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
 		return NoState
 	},
-
 	// S27
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 52
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S28
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 120: // ['a','x']
+			return 19
+		case r == 121: // ['y','y']
+			return 53
+		case r == 122: // ['z','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S29
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 103: // ['a','g']
+			return 19
+		case r == 104: // ['h','h']
+			return 54
+		case 105 <= r && r <= 122: // ['i','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S30
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S31
 	func(r rune) int {
 		switch {
-
 		}
 		return NoState
 	},
-
 	// S32
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
-			return 46
-
 		}
 		return NoState
 	},
-
 	// S33
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
-			return 46
-
 		}
 		return NoState
 	},
-
 	// S34
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 47
-
 		}
 		return NoState
 	},
-
 	// S35
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 48
-
-		default:
-			return 35
+		case r == 39: // [''',''']
+			return 55
 		}
-
+		return NoState
 	},
-
 	// S36
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
-			return 30
-
-		default:
-			return 36
+		case r == 39: // [''',''']
+			return 55
 		}
-
+		return NoState
 	},
-
 	// S37
 	func(r rune) int {
 		switch {
-
+		case r == 110: // ['n','n']
+			return 56
 		}
 		return NoState
 	},
-
 	// S38
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 38
+		case r == 69: // ['E','E']
+			return 57
+		case r == 70: // ['F','F']
+			return 58
+		case r == 101: // ['e','e']
+			return 57
+		case r == 102: // ['f','f']
+			return 58
 		}
 		return NoState
 	},
-
 	// S39
 	func(r rune) int {
 		switch {
-
+		case r == 42: // ['*','*']
+			return 59
+		default:
+			return 39
 		}
-		return NoState
 	},
-
 	// S40
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 10: // ['\n','\n']
+			return 33
+		default:
 			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
 		}
-		return NoState
 	},
-
 	// S41
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 114: // ['a','r']
-			return 18
-		case r == 115: // ['s','s']
-			return 49
-		case 116 <= r && r <= 122: // ['t','z']
-			return 18
-
+			return 60
+		case r == 69: // ['E','E']
+			return 61
+		case r == 70: // ['F','F']
+			return 58
+		case r == 101: // ['e','e']
+			return 61
+		case r == 102: // ['f','f']
+			return 58
 		}
 		return NoState
 	},
-
 	// S42
 	func(r rune) int {
 		switch {
+		case r == 43: // ['+','+']
+			return 62
+		case r == 45: // ['-','-']
+			return 62
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
+			return 63
 		}
 		return NoState
 	},
-
 	// S43
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 115: // ['a','s']
-			return 18
-		case r == 116: // ['t','t']
-			return 50
-		case 117 <= r && r <= 122: // ['u','z']
-			return 18
-
 		}
 		return NoState
 	},
-
 	// S44
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 111: // ['a','o']
-			return 18
-		case r == 112: // ['p','p']
-			return 51
-		case 113 <= r && r <= 122: // ['q','z']
-			return 18
-
 		}
 		return NoState
 	},
-
 	// S45
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 104: // ['a','h']
-			return 18
-		case r == 105: // ['i','i']
-			return 52
-		case 106 <= r && r <= 122: // ['j','z']
-			return 18
-
 		}
 		return NoState
 	},
-
 	// S46
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S47
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 46
-
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 64
+		case 111 <= r && r <= 122: // ['o','z']
+			return 19
 		}
 		return NoState
 	},
-
 	// S48
-	func(r rune) int {
-		switch {
-		case r == 42: // ['*','*']
-			return 48
-		case r == 47: // ['/','/']
-			return 53
-
-		default:
-			return 35
-		}
-
-	},
-
-	// S49
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
-			return 54
-		case 102 <= r && r <= 122: // ['f','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 19
+		case r == 115: // ['s','s']
+			return 65
+		case 116 <= r && r <= 122: // ['t','z']
+			return 19
 		}
 		return NoState
 	},
-
-	// S50
+	// S49
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 66
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 67
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 68
+		case 98 <= r && r <= 122: // ['b','z']
+			return 19
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 111: // ['a','o']
+			return 19
+		case r == 112: // ['p','p']
+			return 69
+		case 113 <= r && r <= 122: // ['q','z']
+			return 19
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 19
+		case r == 105: // ['i','i']
+			return 70
+		case 106 <= r && r <= 122: // ['j','z']
+			return 19
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 55
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 71
+		case r == 45: // ['-','-']
+			return 71
+		case 48 <= r && r <= 57: // ['0','9']
+			return 72
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 59
+		case r == 47: // ['/','/']
+			return 73
+		default:
+			return 39
+		}
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 60
+		case r == 69: // ['E','E']
+			return 61
+		case r == 70: // ['F','F']
+			return 58
+		case r == 101: // ['e','e']
+			return 61
+		case r == 102: // ['f','f']
+			return 58
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 74
+		case r == 45: // ['-','-']
+			return 74
+		case 48 <= r && r <= 57: // ['0','9']
+			return 75
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 63
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 63
+		case r == 70: // ['F','F']
+			return 58
+		case r == 102: // ['f','f']
+			return 58
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 19
+		case r == 115: // ['s','s']
+			return 76
+		case 116 <= r && r <= 122: // ['t','z']
+			return 19
+		}
+		return NoState
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 77
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 78
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
 		case 97 <= r && r <= 116: // ['a','t']
-			return 18
+			return 19
 		case r == 117: // ['u','u']
-			return 55
+			return 79
 		case 118 <= r && r <= 122: // ['v','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S51
+	// S68
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 80
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 81
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 19
+		case r == 108: // ['l','l']
+			return 82
+		case 109 <= r && r <= 122: // ['m','z']
+			return 19
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 72
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 72
+		case r == 70: // ['F','F']
+			return 58
+		case r == 102: // ['f','f']
+			return 58
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 75
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 75
+		case r == 70: // ['F','F']
+			return 58
+		case r == 102: // ['f','f']
+			return 58
+		}
+		return NoState
+	},
+	// S76
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
-			return 56
-		case 102 <= r && r <= 122: // ['f','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 83
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
 		}
 		return NoState
 	},
-
-	// S52
+	// S77
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 107: // ['a','k']
-			return 18
-		case r == 108: // ['l','l']
-			return 57
-		case 109 <= r && r <= 122: // ['m','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
-
-	// S53
+	// S78
 	func(r rune) int {
 		switch {
-
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 84
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
 		}
 		return NoState
 	},
-
-	// S54
+	// S79
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 85
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
 		}
 		return NoState
 	},
-
-	// S55
+	// S80
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 113: // ['a','q']
-			return 18
-		case r == 114: // ['r','r']
-			return 58
-		case 115 <= r && r <= 122: // ['s','z']
-			return 18
-
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 19
+		case r == 105: // ['i','i']
+			return 86
+		case 106 <= r && r <= 122: // ['j','z']
+			return 19
 		}
 		return NoState
 	},
-
-	// S56
+	// S81
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
 		case 97 <= r && r <= 99: // ['a','c']
-			return 18
+			return 19
 		case r == 100: // ['d','d']
-			return 59
+			return 87
 		case 101 <= r && r <= 122: // ['e','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S57
+	// S82
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 19
 		case r == 101: // ['e','e']
-			return 60
+			return 88
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S58
+	// S83
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
 		case 97 <= r && r <= 109: // ['a','m']
-			return 18
+			return 19
 		case r == 110: // ['n','n']
-			return 61
+			return 89
 		case 111 <= r && r <= 122: // ['o','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S59
+	// S85
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 90
+		case 111 <= r && r <= 122: // ['o','z']
+			return 19
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 98: // ['a','b']
+			return 19
+		case r == 99: // ['c','c']
+			return 91
+		case 100 <= r && r <= 122: // ['d','z']
+			return 19
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 19
 		case r == 101: // ['e','e']
-			return 62
+			return 92
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S60
+	// S88
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S61
+	// S89
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S62
+	// S90
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 46
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 22
 		case 97 <= r && r <= 101: // ['a','e']
-			return 18
+			return 19
 		case r == 102: // ['f','f']
-			return 63
+			return 93
 		case 103 <= r && r <= 122: // ['g','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
-
-	// S63
+	// S93
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 46
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 19
 		case r == 95: // ['_','_']
-			return 21
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
-
+			return 19
 		}
 		return NoState
 	},
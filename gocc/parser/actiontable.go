@@ -1,4 +1,4 @@
-// generated by gocc; DO NOT EDIT.
+// Code generated by gocc; DO NOT EDIT.
 
 package parser
 
@@ -12,12087 +12,15784 @@ type (
 
 var actionTab = actionTable{
 	actionRow{ // S0
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(2), /* $, reduce: Decls */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(11), /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			shift(14), /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: Decls
+			nil,       // empty
+			shift(5),  // error
+			nil,       // }
+			nil,       // ;
+			shift(11), // extern
+			shift(12), // static
+			shift(15), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			shift(19), // typedef
+			shift(20), // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S1
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,          /* INVALID */
-			accept(true), /* $ */
-			nil,          /* empty */
-			nil,          /* ; */
-			nil,          /* ident */
-			nil,          /* ( */
-			nil,          /* ) */
-			nil,          /* [ */
-			nil,          /* ] */
-			nil,          /* int_lit */
-			nil,          /* char_lit */
-			nil,          /* typedef */
-			nil,          /* , */
-			nil,          /* return */
-			nil,          /* { */
-			nil,          /* } */
-			nil,          /* if */
-			nil,          /* else */
-			nil,          /* while */
-			nil,          /* = */
-			nil,          /* && */
-			nil,          /* == */
-			nil,          /* != */
-			nil,          /* < */
-			nil,          /* > */
-			nil,          /* <= */
-			nil,          /* >= */
-			nil,          /* + */
-			nil,          /* - */
-			nil,          /* * */
-			nil,          /* / */
-			nil,          /* ! */
-
+			nil,          // INVALID
+			accept(true), // ␚
+			nil,          // empty
+			nil,          // error
+			nil,          // }
+			nil,          // ;
+			nil,          // extern
+			nil,          // static
+			nil,          // ident
+			nil,          // (
+			nil,          // )
+			nil,          // ,
+			nil,          // [
+			nil,          // ]
+			nil,          // int_lit
+			nil,          // char_lit
+			nil,          // typedef
+			nil,          // const
+			nil,          // return
+			nil,          // {
+			nil,          // if
+			nil,          // else
+			nil,          // while
+			nil,          // =
+			nil,          // &&
+			nil,          // ==
+			nil,          // !=
+			nil,          // <
+			nil,          // >
+			nil,          // <=
+			nil,          // >=
+			nil,          // +
+			nil,          // -
+			nil,          // *
+			nil,          // /
+			nil,          // !
+			nil,          // float_lit
+			nil,          // comment
 		},
 	},
 	actionRow{ // S2
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(1), /* $, reduce: File */
-			nil,       /* empty */
-			nil,       /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			reduce(1), // ␚, reduce: File
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S3
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(3), /* $, reduce: Decls */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(11), /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			shift(14), /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(3), // ␚, reduce: Decls
+			nil,       // empty
+			shift(22), // error
+			nil,       // }
+			nil,       // ;
+			shift(11), // extern
+			shift(12), // static
+			shift(15), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			shift(19), // typedef
+			shift(20), // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S4
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(4), /* $, reduce: DeclList */
-			nil,       /* empty */
-			nil,       /* ; */
-			reduce(4), /* ident, reduce: DeclList */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			reduce(4), /* typedef, reduce: DeclList */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			reduce(4), // ␚, reduce: DeclList
+			nil,       // empty
+			reduce(4), // error, reduce: DeclList
+			nil,       // }
+			nil,       // ;
+			reduce(4), // extern, reduce: DeclList
+			reduce(4), // static, reduce: DeclList
+			reduce(4), // ident, reduce: DeclList
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			reduce(4), // typedef, reduce: DeclList
+			reduce(4), // const, reduce: DeclList
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S5
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(16), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			shift(23), // }
+			shift(24), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S6
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(17), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(25), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			shift(26), // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S7
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(8), /* $, reduce: Decl */
-			nil,       /* empty */
-			nil,       /* ; */
-			reduce(8), /* ident, reduce: Decl */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			reduce(8), /* typedef, reduce: Decl */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(15), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			shift(20), // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S8
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(18), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(30), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S9
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(10), /* ;, reduce: FuncDecl */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			shift(20),  /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			reduce(12), // ␚, reduce: Decl
+			nil,        // empty
+			reduce(12), // error, reduce: Decl
+			nil,        // }
+			nil,        // ;
+			reduce(12), // extern, reduce: Decl
+			reduce(12), // static, reduce: Decl
+			reduce(12), // ident, reduce: Decl
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(12), // typedef, reduce: Decl
+			reduce(12), // const, reduce: Decl
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S10
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(21), /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(31), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S11
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			reduce(21), /* ident, reduce: BasicType */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(16), // ident, reduce: StorageClass
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			reduce(16), // const, reduce: StorageClass
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S12
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(13), /* ;, reduce: VarDecl */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(17), // ident, reduce: StorageClass
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			reduce(17), // const, reduce: StorageClass
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S13
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(14), /* ;, reduce: VarDecl */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(18), // ;, reduce: FuncDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			shift(33),  // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S14
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(11), /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(34), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S15
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(5), /* $, reduce: DeclList */
-			nil,       /* empty */
-			nil,       /* ; */
-			reduce(5), /* ident, reduce: DeclList */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			reduce(5), /* typedef, reduce: DeclList */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(33), // ident, reduce: BasicType
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S16
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(6), /* $, reduce: Decl */
-			nil,       /* empty */
-			nil,       /* ; */
-			reduce(6), /* ident, reduce: Decl */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			reduce(6), /* typedef, reduce: Decl */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(21), // ;, reduce: VarDecls
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(21), // ,, reduce: VarDecls
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S17
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(7), /* $, reduce: Decl */
-			nil,       /* empty */
-			nil,       /* ; */
-			reduce(7), /* ident, reduce: Decl */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			reduce(7), /* typedef, reduce: Decl */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(25), // ;, reduce: VarDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(25), // ,, reduce: VarDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S18
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			reduce(9), /* $, reduce: Decl */
-			nil,       /* empty */
-			nil,       /* ; */
-			reduce(9), /* ident, reduce: Decl */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			reduce(9), /* typedef, reduce: Decl */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(26), // ;, reduce: VarDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(26), // ,, reduce: VarDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S19
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			reduce(12), /* $, reduce: FuncDef */
-			nil,        /* empty */
-			nil,        /* ; */
-			reduce(12), /* ident, reduce: FuncDef */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			reduce(12), /* typedef, reduce: FuncDef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(15), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			shift(20), // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S20
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(26),  /* ; */
-			shift(31),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			shift(14),  /* typedef */
-			nil,        /* , */
-			shift(41),  /* return */
-			shift(42),  /* { */
-			reduce(44), /* }, reduce: BlockItems */
-			shift(44),  /* if */
-			nil,        /* else */
-			shift(45),  /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(37), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S21
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(15), /* ;, reduce: ScalarDecl */
-			nil,        /* ident */
-			shift(60),  /* ( */
-			nil,        /* ) */
-			shift(61),  /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,       // INVALID
+			reduce(5), // ␚, reduce: DeclList
+			nil,       // empty
+			reduce(5), // error, reduce: DeclList
+			nil,       // }
+			nil,       // ;
+			reduce(5), // extern, reduce: DeclList
+			reduce(5), // static, reduce: DeclList
+			reduce(5), // ident, reduce: DeclList
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			reduce(5), // typedef, reduce: DeclList
+			reduce(5), // const, reduce: DeclList
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S22
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			reduce(28), /* ident, reduce: Type */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			shift(38), // }
+			shift(24), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S23
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(62), /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(6), // ␚, reduce: DeclList
+			nil,       // empty
+			reduce(6), // error, reduce: DeclList
+			nil,       // }
+			nil,       // ;
+			reduce(6), // extern, reduce: DeclList
+			reduce(6), // static, reduce: DeclList
+			reduce(6), // ident, reduce: DeclList
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			reduce(6), // typedef, reduce: DeclList
+			reduce(6), // const, reduce: DeclList
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S24
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(48), /* ;, reduce: BlockItem */
-			reduce(48), /* ident, reduce: BlockItem */
-			reduce(48), /* (, reduce: BlockItem */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(48), /* int_lit, reduce: BlockItem */
-			reduce(48), /* char_lit, reduce: BlockItem */
-			reduce(48), /* typedef, reduce: BlockItem */
-			nil,        /* , */
-			reduce(48), /* return, reduce: BlockItem */
-			reduce(48), /* {, reduce: BlockItem */
-			reduce(48), /* }, reduce: BlockItem */
-			reduce(48), /* if, reduce: BlockItem */
-			nil,        /* else */
-			reduce(48), /* while, reduce: BlockItem */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(48), /* -, reduce: BlockItem */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(48), /* !, reduce: BlockItem */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(15), // ␚, reduce: Decl
+			nil,        // empty
+			reduce(15), // error, reduce: Decl
+			nil,        // }
+			nil,        // ;
+			reduce(15), // extern, reduce: Decl
+			reduce(15), // static, reduce: Decl
+			reduce(15), // ident, reduce: Decl
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(15), // typedef, reduce: Decl
+			reduce(15), // const, reduce: Decl
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S25
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(63), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			reduce(8), // ␚, reduce: Decl
+			nil,       // empty
+			reduce(8), // error, reduce: Decl
+			nil,       // }
+			nil,       // ;
+			reduce(8), // extern, reduce: Decl
+			reduce(8), // static, reduce: Decl
+			reduce(8), // ident, reduce: Decl
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			reduce(8), // typedef, reduce: Decl
+			reduce(8), // const, reduce: Decl
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S26
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(35), /* ;, reduce: OtherStmt */
-			reduce(35), /* ident, reduce: OtherStmt */
-			reduce(35), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(35), /* int_lit, reduce: OtherStmt */
-			reduce(35), /* char_lit, reduce: OtherStmt */
-			reduce(35), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(35), /* return, reduce: OtherStmt */
-			reduce(35), /* {, reduce: OtherStmt */
-			reduce(35), /* }, reduce: OtherStmt */
-			reduce(35), /* if, reduce: OtherStmt */
-			nil,        /* else */
-			reduce(35), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(35), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(35), /* !, reduce: OtherStmt */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(39), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S27
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(64), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(40), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			shift(26), // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S28
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			reduce(8), /* ;, reduce: Decl */
-			reduce(8), /* ident, reduce: Decl */
-			reduce(8), /* (, reduce: Decl */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			reduce(8), /* int_lit, reduce: Decl */
-			reduce(8), /* char_lit, reduce: Decl */
-			reduce(8), /* typedef, reduce: Decl */
-			nil,       /* , */
-			reduce(8), /* return, reduce: Decl */
-			reduce(8), /* {, reduce: Decl */
-			reduce(8), /* }, reduce: Decl */
-			reduce(8), /* if, reduce: Decl */
-			nil,       /* else */
-			reduce(8), /* while, reduce: Decl */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			reduce(8), /* -, reduce: Decl */
-			nil,       /* * */
-			nil,       /* / */
-			reduce(8), /* !, reduce: Decl */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(41), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S29
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(65), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			reduce(13), // ␚, reduce: Decl
+			nil,        // empty
+			reduce(13), // error, reduce: Decl
+			nil,        // }
+			nil,        // ;
+			reduce(13), // extern, reduce: Decl
+			reduce(13), // static, reduce: Decl
+			reduce(13), // ident, reduce: Decl
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(13), // typedef, reduce: Decl
+			reduce(13), // const, reduce: Decl
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S30
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(10), /* ;, reduce: FuncDecl */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			shift(42),  /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			reduce(10), // ␚, reduce: Decl
+			nil,        // empty
+			reduce(10), // error, reduce: Decl
+			nil,        // }
+			nil,        // ;
+			reduce(10), // extern, reduce: Decl
+			reduce(10), // static, reduce: Decl
+			reduce(10), // ident, reduce: Decl
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(10), // typedef, reduce: Decl
+			reduce(10), // const, reduce: Decl
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S31
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(77), /* ;, reduce: PrimaryExpr */
-			reduce(21), /* ident, reduce: BasicType */
-			shift(67),  /* ( */
-			nil,        /* ) */
-			shift(68),  /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(77), /* =, reduce: PrimaryExpr */
-			reduce(77), /* &&, reduce: PrimaryExpr */
-			reduce(77), /* ==, reduce: PrimaryExpr */
-			reduce(77), /* !=, reduce: PrimaryExpr */
-			reduce(77), /* <, reduce: PrimaryExpr */
-			reduce(77), /* >, reduce: PrimaryExpr */
-			reduce(77), /* <=, reduce: PrimaryExpr */
-			reduce(77), /* >=, reduce: PrimaryExpr */
-			reduce(77), /* +, reduce: PrimaryExpr */
-			reduce(77), /* -, reduce: PrimaryExpr */
-			reduce(77), /* *, reduce: PrimaryExpr */
-			reduce(77), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			reduce(14), // ␚, reduce: Decl
+			nil,        // empty
+			reduce(14), // error, reduce: Decl
+			nil,        // }
+			nil,        // ;
+			reduce(14), // extern, reduce: Decl
+			reduce(14), // static, reduce: Decl
+			reduce(14), // ident, reduce: Decl
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(14), // typedef, reduce: Decl
+			reduce(14), // const, reduce: Decl
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S32
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			reduce(20), // ␚, reduce: FuncDef
+			nil,        // empty
+			reduce(20), // error, reduce: FuncDef
+			nil,        // }
+			nil,        // ;
+			reduce(20), // extern, reduce: FuncDef
+			reduce(20), // static, reduce: FuncDef
+			reduce(20), // ident, reduce: FuncDef
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(20), // typedef, reduce: FuncDef
+			reduce(20), // const, reduce: FuncDef
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S33
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(34), /* ;, reduce: OtherStmt */
-			reduce(34), /* ident, reduce: OtherStmt */
-			reduce(34), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(34), /* int_lit, reduce: OtherStmt */
-			reduce(34), /* char_lit, reduce: OtherStmt */
-			reduce(34), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(34), /* return, reduce: OtherStmt */
-			reduce(34), /* {, reduce: OtherStmt */
-			reduce(34), /* }, reduce: OtherStmt */
-			reduce(34), /* if, reduce: OtherStmt */
-			nil,        /* else */
-			reduce(34), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(34), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(34), /* !, reduce: OtherStmt */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(43),  // error
+			reduce(59), // }, reduce: BlockItems
+			shift(45),  // ;
+			shift(11),  // extern
+			shift(12),  // static
+			shift(51),  // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			shift(19),  // typedef
+			shift(20),  // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S34
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(75), /* ;, reduce: PrimaryExpr */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(75), /* =, reduce: PrimaryExpr */
-			reduce(75), /* &&, reduce: PrimaryExpr */
-			reduce(75), /* ==, reduce: PrimaryExpr */
-			reduce(75), /* !=, reduce: PrimaryExpr */
-			reduce(75), /* <, reduce: PrimaryExpr */
-			reduce(75), /* >, reduce: PrimaryExpr */
-			reduce(75), /* <=, reduce: PrimaryExpr */
-			reduce(75), /* >=, reduce: PrimaryExpr */
-			reduce(75), /* +, reduce: PrimaryExpr */
-			reduce(75), /* -, reduce: PrimaryExpr */
-			reduce(75), /* *, reduce: PrimaryExpr */
-			reduce(75), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(27), // ;, reduce: ScalarDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(81),  // (
+			nil,        // )
+			reduce(27), // ,, reduce: ScalarDecl
+			shift(82),  // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S35
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(76), /* ;, reduce: PrimaryExpr */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(76), /* =, reduce: PrimaryExpr */
-			reduce(76), /* &&, reduce: PrimaryExpr */
-			reduce(76), /* ==, reduce: PrimaryExpr */
-			reduce(76), /* !=, reduce: PrimaryExpr */
-			reduce(76), /* <, reduce: PrimaryExpr */
-			reduce(76), /* >, reduce: PrimaryExpr */
-			reduce(76), /* <=, reduce: PrimaryExpr */
-			reduce(76), /* >=, reduce: PrimaryExpr */
-			reduce(76), /* +, reduce: PrimaryExpr */
-			reduce(76), /* -, reduce: PrimaryExpr */
-			reduce(76), /* *, reduce: PrimaryExpr */
-			reduce(76), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(41), // ident, reduce: Type
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S36
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(49), /* ;, reduce: BlockItem */
-			reduce(49), /* ident, reduce: BlockItem */
-			reduce(49), /* (, reduce: BlockItem */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(49), /* int_lit, reduce: BlockItem */
-			reduce(49), /* char_lit, reduce: BlockItem */
-			reduce(49), /* typedef, reduce: BlockItem */
-			nil,        /* , */
-			reduce(49), /* return, reduce: BlockItem */
-			reduce(49), /* {, reduce: BlockItem */
-			reduce(49), /* }, reduce: BlockItem */
-			reduce(49), /* if, reduce: BlockItem */
-			nil,        /* else */
-			reduce(49), /* while, reduce: BlockItem */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(49), /* -, reduce: BlockItem */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(49), /* !, reduce: BlockItem */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(83), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S37
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(29), /* ;, reduce: Stmt */
-			reduce(29), /* ident, reduce: Stmt */
-			reduce(29), /* (, reduce: Stmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(29), /* int_lit, reduce: Stmt */
-			reduce(29), /* char_lit, reduce: Stmt */
-			reduce(29), /* typedef, reduce: Stmt */
-			nil,        /* , */
-			reduce(29), /* return, reduce: Stmt */
-			reduce(29), /* {, reduce: Stmt */
-			reduce(29), /* }, reduce: Stmt */
-			reduce(29), /* if, reduce: Stmt */
-			nil,        /* else */
-			reduce(29), /* while, reduce: Stmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(29), /* -, reduce: Stmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(29), /* !, reduce: Stmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(34), // ident, reduce: BasicType
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S38
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(30), /* ;, reduce: Stmt */
-			reduce(30), /* ident, reduce: Stmt */
-			reduce(30), /* (, reduce: Stmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(30), /* int_lit, reduce: Stmt */
-			reduce(30), /* char_lit, reduce: Stmt */
-			reduce(30), /* typedef, reduce: Stmt */
-			nil,        /* , */
-			reduce(30), /* return, reduce: Stmt */
-			reduce(30), /* {, reduce: Stmt */
-			reduce(30), /* }, reduce: Stmt */
-			reduce(30), /* if, reduce: Stmt */
-			nil,        /* else */
-			reduce(30), /* while, reduce: Stmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(30), /* -, reduce: Stmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(30), /* !, reduce: Stmt */
-
+			nil,       // INVALID
+			reduce(7), // ␚, reduce: DeclList
+			nil,       // empty
+			reduce(7), // error, reduce: DeclList
+			nil,       // }
+			nil,       // ;
+			reduce(7), // extern, reduce: DeclList
+			reduce(7), // static, reduce: DeclList
+			reduce(7), // ident, reduce: DeclList
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			reduce(7), // typedef, reduce: DeclList
+			reduce(7), // const, reduce: DeclList
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S39
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(39), /* ;, reduce: MatchedStmt */
-			reduce(39), /* ident, reduce: MatchedStmt */
-			reduce(39), /* (, reduce: MatchedStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(39), /* int_lit, reduce: MatchedStmt */
-			reduce(39), /* char_lit, reduce: MatchedStmt */
-			reduce(39), /* typedef, reduce: MatchedStmt */
-			nil,        /* , */
-			reduce(39), /* return, reduce: MatchedStmt */
-			reduce(39), /* {, reduce: MatchedStmt */
-			reduce(39), /* }, reduce: MatchedStmt */
-			reduce(39), /* if, reduce: MatchedStmt */
-			nil,        /* else */
-			reduce(39), /* while, reduce: MatchedStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(39), /* -, reduce: MatchedStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(39), /* !, reduce: MatchedStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(22), // ;, reduce: VarDecls
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(22), // ,, reduce: VarDecls
+			shift(84),  // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S40
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(86), /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			reduce(9), // ␚, reduce: Decl
+			nil,       // empty
+			reduce(9), // error, reduce: Decl
+			nil,       // }
+			nil,       // ;
+			reduce(9), // extern, reduce: Decl
+			reduce(9), // static, reduce: Decl
+			reduce(9), // ident, reduce: Decl
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			reduce(9), // typedef, reduce: Decl
+			reduce(9), // const, reduce: Decl
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S41
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(87), /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			reduce(11), // ␚, reduce: Decl
+			nil,        // empty
+			reduce(11), // error, reduce: Decl
+			nil,        // }
+			nil,        // ;
+			reduce(11), // extern, reduce: Decl
+			reduce(11), // static, reduce: Decl
+			reduce(11), // ident, reduce: Decl
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(11), // typedef, reduce: Decl
+			reduce(11), // const, reduce: Decl
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S42
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(26),  /* ; */
-			shift(31),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			shift(14),  /* typedef */
-			nil,        /* , */
-			shift(41),  /* return */
-			shift(42),  /* { */
-			reduce(44), /* }, reduce: BlockItems */
-			shift(44),  /* if */
-			nil,        /* else */
-			shift(45),  /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(63), // error, reduce: BlockItem
+			reduce(63), // }, reduce: BlockItem
+			reduce(63), // ;, reduce: BlockItem
+			reduce(63), // extern, reduce: BlockItem
+			reduce(63), // static, reduce: BlockItem
+			reduce(63), // ident, reduce: BlockItem
+			reduce(63), // (, reduce: BlockItem
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(63), // int_lit, reduce: BlockItem
+			reduce(63), // char_lit, reduce: BlockItem
+			reduce(63), // typedef, reduce: BlockItem
+			reduce(63), // const, reduce: BlockItem
+			reduce(63), // return, reduce: BlockItem
+			reduce(63), // {, reduce: BlockItem
+			reduce(63), // if, reduce: BlockItem
+			nil,        // else
+			reduce(63), // while, reduce: BlockItem
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(63), // -, reduce: BlockItem
+			nil,        // *
+			nil,        // /
+			reduce(63), // !, reduce: BlockItem
+			reduce(63), // float_lit, reduce: BlockItem
+			nil,        // comment
 		},
 	},
 	actionRow{ // S43
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			nil,       /* ident */
-			nil,       /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			shift(91), /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			shift(85), // }
+			shift(86), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S44
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			nil,       /* ident */
-			shift(92), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(87), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			shift(26), // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S45
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			nil,       /* ident */
-			shift(92), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // error, reduce: OtherStmt
+			reduce(48), // }, reduce: OtherStmt
+			reduce(48), // ;, reduce: OtherStmt
+			reduce(48), // extern, reduce: OtherStmt
+			reduce(48), // static, reduce: OtherStmt
+			reduce(48), // ident, reduce: OtherStmt
+			reduce(48), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(48), // int_lit, reduce: OtherStmt
+			reduce(48), // char_lit, reduce: OtherStmt
+			reduce(48), // typedef, reduce: OtherStmt
+			reduce(48), // const, reduce: OtherStmt
+			reduce(48), // return, reduce: OtherStmt
+			reduce(48), // {, reduce: OtherStmt
+			reduce(48), // if, reduce: OtherStmt
+			nil,        // else
+			reduce(48), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(48), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(48), // !, reduce: OtherStmt
+			reduce(48), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S46
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(26),  /* ; */
-			shift(31),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			shift(14),  /* typedef */
-			nil,        /* , */
-			shift(41),  /* return */
-			shift(42),  /* { */
-			reduce(45), /* }, reduce: BlockItems */
-			shift(44),  /* if */
-			nil,        /* else */
-			shift(45),  /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			nil,       // ;
+			nil,       // extern
+			nil,       // static
+			shift(15), // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			shift(20), // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S47
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(46), /* ;, reduce: BlockItemList */
-			reduce(46), /* ident, reduce: BlockItemList */
-			reduce(46), /* (, reduce: BlockItemList */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(46), /* int_lit, reduce: BlockItemList */
-			reduce(46), /* char_lit, reduce: BlockItemList */
-			reduce(46), /* typedef, reduce: BlockItemList */
-			nil,        /* , */
-			reduce(46), /* return, reduce: BlockItemList */
-			reduce(46), /* {, reduce: BlockItemList */
-			reduce(46), /* }, reduce: BlockItemList */
-			reduce(46), /* if, reduce: BlockItemList */
-			nil,        /* else */
-			reduce(46), /* while, reduce: BlockItemList */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(46), /* -, reduce: BlockItemList */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(46), /* !, reduce: BlockItemList */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(91), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S48
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(50), /* ;, reduce: Expr */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(12), // error, reduce: Decl
+			reduce(12), // }, reduce: Decl
+			reduce(12), // ;, reduce: Decl
+			reduce(12), // extern, reduce: Decl
+			reduce(12), // static, reduce: Decl
+			reduce(12), // ident, reduce: Decl
+			reduce(12), // (, reduce: Decl
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(12), // int_lit, reduce: Decl
+			reduce(12), // char_lit, reduce: Decl
+			reduce(12), // typedef, reduce: Decl
+			reduce(12), // const, reduce: Decl
+			reduce(12), // return, reduce: Decl
+			reduce(12), // {, reduce: Decl
+			reduce(12), // if, reduce: Decl
+			nil,        // else
+			reduce(12), // while, reduce: Decl
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(12), // -, reduce: Decl
+			nil,        // *
+			nil,        // /
+			reduce(12), // !, reduce: Decl
+			reduce(12), // float_lit, reduce: Decl
+			nil,        // comment
 		},
 	},
 	actionRow{ // S49
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(51), /* ;, reduce: Expr2R */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			shift(96),  /* = */
-			shift(97),  /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // error
+			nil,       // }
+			shift(92), // ;
+			nil,       // extern
+			nil,       // static
+			nil,       // ident
+			nil,       // (
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			nil,       // int_lit
+			nil,       // char_lit
+			nil,       // typedef
+			nil,       // const
+			nil,       // return
+			nil,       // {
+			nil,       // if
+			nil,       // else
+			nil,       // while
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			nil,       // -
+			nil,       // *
+			nil,       // /
+			nil,       // !
+			nil,       // float_lit
+			nil,       // comment
 		},
 	},
 	actionRow{ // S50
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(53), /* ;, reduce: Expr5L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(53), /* =, reduce: Expr5L */
-			reduce(53), /* &&, reduce: Expr5L */
-			shift(98),  /* == */
-			shift(99),  /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(18), // ;, reduce: FuncDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			shift(62),  // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S51
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(55), /* ;, reduce: Expr9L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(55), /* =, reduce: Expr9L */
-			reduce(55), /* &&, reduce: Expr9L */
-			reduce(55), /* ==, reduce: Expr9L */
-			reduce(55), /* !=, reduce: Expr9L */
-			shift(100), /* < */
-			shift(101), /* > */
-			shift(102), /* <= */
-			shift(103), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(93), // ;, reduce: PrimaryExpr
+			nil,        // extern
+			nil,        // static
+			reduce(33), // ident, reduce: BasicType
+			shift(94),  // (
+			nil,        // )
+			nil,        // ,
+			shift(95),  // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(93), // =, reduce: PrimaryExpr
+			reduce(93), // &&, reduce: PrimaryExpr
+			reduce(93), // ==, reduce: PrimaryExpr
+			reduce(93), // !=, reduce: PrimaryExpr
+			reduce(93), // <, reduce: PrimaryExpr
+			reduce(93), // >, reduce: PrimaryExpr
+			reduce(93), // <=, reduce: PrimaryExpr
+			reduce(93), // >=, reduce: PrimaryExpr
+			reduce(93), // +, reduce: PrimaryExpr
+			reduce(93), // -, reduce: PrimaryExpr
+			reduce(93), // *, reduce: PrimaryExpr
+			reduce(93), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S52
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(58), /* ;, reduce: Expr10L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(58), /* =, reduce: Expr10L */
-			reduce(58), /* &&, reduce: Expr10L */
-			reduce(58), /* ==, reduce: Expr10L */
-			reduce(58), /* !=, reduce: Expr10L */
-			reduce(58), /* <, reduce: Expr10L */
-			reduce(58), /* >, reduce: Expr10L */
-			reduce(58), /* <=, reduce: Expr10L */
-			reduce(58), /* >=, reduce: Expr10L */
-			shift(104), /* + */
-			shift(105), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S53
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(63), /* ;, reduce: Expr12L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(63), /* =, reduce: Expr12L */
-			reduce(63), /* &&, reduce: Expr12L */
-			reduce(63), /* ==, reduce: Expr12L */
-			reduce(63), /* !=, reduce: Expr12L */
-			reduce(63), /* <, reduce: Expr12L */
-			reduce(63), /* >, reduce: Expr12L */
-			reduce(63), /* <=, reduce: Expr12L */
-			reduce(63), /* >=, reduce: Expr12L */
-			reduce(63), /* +, reduce: Expr12L */
-			reduce(63), /* -, reduce: Expr12L */
-			shift(106), /* * */
-			shift(107), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(47), // error, reduce: OtherStmt
+			reduce(47), // }, reduce: OtherStmt
+			reduce(47), // ;, reduce: OtherStmt
+			reduce(47), // extern, reduce: OtherStmt
+			reduce(47), // static, reduce: OtherStmt
+			reduce(47), // ident, reduce: OtherStmt
+			reduce(47), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(47), // int_lit, reduce: OtherStmt
+			reduce(47), // char_lit, reduce: OtherStmt
+			reduce(47), // typedef, reduce: OtherStmt
+			reduce(47), // const, reduce: OtherStmt
+			reduce(47), // return, reduce: OtherStmt
+			reduce(47), // {, reduce: OtherStmt
+			reduce(47), // if, reduce: OtherStmt
+			nil,        // else
+			reduce(47), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(47), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(47), // !, reduce: OtherStmt
+			reduce(47), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S54
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(90), // ;, reduce: PrimaryExpr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(90), // =, reduce: PrimaryExpr
+			reduce(90), // &&, reduce: PrimaryExpr
+			reduce(90), // ==, reduce: PrimaryExpr
+			reduce(90), // !=, reduce: PrimaryExpr
+			reduce(90), // <, reduce: PrimaryExpr
+			reduce(90), // >, reduce: PrimaryExpr
+			reduce(90), // <=, reduce: PrimaryExpr
+			reduce(90), // >=, reduce: PrimaryExpr
+			reduce(90), // +, reduce: PrimaryExpr
+			reduce(90), // -, reduce: PrimaryExpr
+			reduce(90), // *, reduce: PrimaryExpr
+			reduce(90), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S55
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(66), /* ;, reduce: Expr13L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(66), /* =, reduce: Expr13L */
-			reduce(66), /* &&, reduce: Expr13L */
-			reduce(66), /* ==, reduce: Expr13L */
-			reduce(66), /* !=, reduce: Expr13L */
-			reduce(66), /* <, reduce: Expr13L */
-			reduce(66), /* >, reduce: Expr13L */
-			reduce(66), /* <=, reduce: Expr13L */
-			reduce(66), /* >=, reduce: Expr13L */
-			reduce(66), /* +, reduce: Expr13L */
-			reduce(66), /* -, reduce: Expr13L */
-			reduce(66), /* *, reduce: Expr13L */
-			reduce(66), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(92), // ;, reduce: PrimaryExpr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(92), // =, reduce: PrimaryExpr
+			reduce(92), // &&, reduce: PrimaryExpr
+			reduce(92), // ==, reduce: PrimaryExpr
+			reduce(92), // !=, reduce: PrimaryExpr
+			reduce(92), // <, reduce: PrimaryExpr
+			reduce(92), // >, reduce: PrimaryExpr
+			reduce(92), // <=, reduce: PrimaryExpr
+			reduce(92), // >=, reduce: PrimaryExpr
+			reduce(92), // +, reduce: PrimaryExpr
+			reduce(92), // -, reduce: PrimaryExpr
+			reduce(92), // *, reduce: PrimaryExpr
+			reduce(92), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S56
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(69), /* ;, reduce: Expr14 */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(69), /* =, reduce: Expr14 */
-			reduce(69), /* &&, reduce: Expr14 */
-			reduce(69), /* ==, reduce: Expr14 */
-			reduce(69), /* !=, reduce: Expr14 */
-			reduce(69), /* <, reduce: Expr14 */
-			reduce(69), /* >, reduce: Expr14 */
-			reduce(69), /* <=, reduce: Expr14 */
-			reduce(69), /* >=, reduce: Expr14 */
-			reduce(69), /* +, reduce: Expr14 */
-			reduce(69), /* -, reduce: Expr14 */
-			reduce(69), /* *, reduce: Expr14 */
-			reduce(69), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(64), // error, reduce: BlockItem
+			reduce(64), // }, reduce: BlockItem
+			reduce(64), // ;, reduce: BlockItem
+			reduce(64), // extern, reduce: BlockItem
+			reduce(64), // static, reduce: BlockItem
+			reduce(64), // ident, reduce: BlockItem
+			reduce(64), // (, reduce: BlockItem
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(64), // int_lit, reduce: BlockItem
+			reduce(64), // char_lit, reduce: BlockItem
+			reduce(64), // typedef, reduce: BlockItem
+			reduce(64), // const, reduce: BlockItem
+			reduce(64), // return, reduce: BlockItem
+			reduce(64), // {, reduce: BlockItem
+			reduce(64), // if, reduce: BlockItem
+			nil,        // else
+			reduce(64), // while, reduce: BlockItem
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(64), // -, reduce: BlockItem
+			nil,        // *
+			nil,        // /
+			reduce(64), // !, reduce: BlockItem
+			reduce(64), // float_lit, reduce: BlockItem
+			nil,        // comment
 		},
 	},
 	actionRow{ // S57
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // error, reduce: Stmt
+			reduce(42), // }, reduce: Stmt
+			reduce(42), // ;, reduce: Stmt
+			reduce(42), // extern, reduce: Stmt
+			reduce(42), // static, reduce: Stmt
+			reduce(42), // ident, reduce: Stmt
+			reduce(42), // (, reduce: Stmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(42), // int_lit, reduce: Stmt
+			reduce(42), // char_lit, reduce: Stmt
+			reduce(42), // typedef, reduce: Stmt
+			reduce(42), // const, reduce: Stmt
+			reduce(42), // return, reduce: Stmt
+			reduce(42), // {, reduce: Stmt
+			reduce(42), // if, reduce: Stmt
+			nil,        // else
+			reduce(42), // while, reduce: Stmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(42), // -, reduce: Stmt
+			nil,        // *
+			nil,        // /
+			reduce(42), // !, reduce: Stmt
+			reduce(42), // float_lit, reduce: Stmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S58
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(72), /* ;, reduce: Expr15 */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(72), /* =, reduce: Expr15 */
-			reduce(72), /* &&, reduce: Expr15 */
-			reduce(72), /* ==, reduce: Expr15 */
-			reduce(72), /* !=, reduce: Expr15 */
-			reduce(72), /* <, reduce: Expr15 */
-			reduce(72), /* >, reduce: Expr15 */
-			reduce(72), /* <=, reduce: Expr15 */
-			reduce(72), /* >=, reduce: Expr15 */
-			reduce(72), /* +, reduce: Expr15 */
-			reduce(72), /* -, reduce: Expr15 */
-			reduce(72), /* *, reduce: Expr15 */
-			reduce(72), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // error, reduce: Stmt
+			reduce(43), // }, reduce: Stmt
+			reduce(43), // ;, reduce: Stmt
+			reduce(43), // extern, reduce: Stmt
+			reduce(43), // static, reduce: Stmt
+			reduce(43), // ident, reduce: Stmt
+			reduce(43), // (, reduce: Stmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(43), // int_lit, reduce: Stmt
+			reduce(43), // char_lit, reduce: Stmt
+			reduce(43), // typedef, reduce: Stmt
+			reduce(43), // const, reduce: Stmt
+			reduce(43), // return, reduce: Stmt
+			reduce(43), // {, reduce: Stmt
+			reduce(43), // if, reduce: Stmt
+			nil,        // else
+			reduce(43), // while, reduce: Stmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(43), // -, reduce: Stmt
+			nil,        // *
+			nil,        // /
+			reduce(43), // !, reduce: Stmt
+			reduce(43), // float_lit, reduce: Stmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S59
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(78), /* ;, reduce: PrimaryExpr */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(78), /* =, reduce: PrimaryExpr */
-			reduce(78), /* &&, reduce: PrimaryExpr */
-			reduce(78), /* ==, reduce: PrimaryExpr */
-			reduce(78), /* !=, reduce: PrimaryExpr */
-			reduce(78), /* <, reduce: PrimaryExpr */
-			reduce(78), /* >, reduce: PrimaryExpr */
-			reduce(78), /* <=, reduce: PrimaryExpr */
-			reduce(78), /* >=, reduce: PrimaryExpr */
-			reduce(78), /* +, reduce: PrimaryExpr */
-			reduce(78), /* -, reduce: PrimaryExpr */
-			reduce(78), /* *, reduce: PrimaryExpr */
-			reduce(78), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(54), // error, reduce: MatchedStmt
+			reduce(54), // }, reduce: MatchedStmt
+			reduce(54), // ;, reduce: MatchedStmt
+			reduce(54), // extern, reduce: MatchedStmt
+			reduce(54), // static, reduce: MatchedStmt
+			reduce(54), // ident, reduce: MatchedStmt
+			reduce(54), // (, reduce: MatchedStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(54), // int_lit, reduce: MatchedStmt
+			reduce(54), // char_lit, reduce: MatchedStmt
+			reduce(54), // typedef, reduce: MatchedStmt
+			reduce(54), // const, reduce: MatchedStmt
+			reduce(54), // return, reduce: MatchedStmt
+			reduce(54), // {, reduce: MatchedStmt
+			reduce(54), // if, reduce: MatchedStmt
+			nil,        // else
+			reduce(54), // while, reduce: MatchedStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(54), // -, reduce: MatchedStmt
+			nil,        // *
+			nil,        // /
+			reduce(54), // !, reduce: MatchedStmt
+			reduce(54), // float_lit, reduce: MatchedStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(112), /* ident */
-			nil,        /* ( */
-			reduce(22), /* ), reduce: Params */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(114), // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(120), /* ] */
-			shift(121), /* int_lit */
-			shift(122), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(115), // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S62
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(20), /* ;, reduce: TypeDef */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(118), // error
+			reduce(59), // }, reduce: BlockItems
+			shift(45),  // ;
+			shift(11),  // extern
+			shift(12),  // static
+			shift(51),  // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			shift(19),  // typedef
+			shift(20),  // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S63
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			reduce(6), /* ;, reduce: Decl */
-			reduce(6), /* ident, reduce: Decl */
-			reduce(6), /* (, reduce: Decl */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			reduce(6), /* int_lit, reduce: Decl */
-			reduce(6), /* char_lit, reduce: Decl */
-			reduce(6), /* typedef, reduce: Decl */
-			nil,       /* , */
-			reduce(6), /* return, reduce: Decl */
-			reduce(6), /* {, reduce: Decl */
-			reduce(6), /* }, reduce: Decl */
-			reduce(6), /* if, reduce: Decl */
-			nil,       /* else */
-			reduce(6), /* while, reduce: Decl */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			reduce(6), /* -, reduce: Decl */
-			nil,       /* * */
-			nil,       /* / */
-			reduce(6), /* !, reduce: Decl */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(121), // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S64
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			reduce(7), /* ;, reduce: Decl */
-			reduce(7), /* ident, reduce: Decl */
-			reduce(7), /* (, reduce: Decl */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			reduce(7), /* int_lit, reduce: Decl */
-			reduce(7), /* char_lit, reduce: Decl */
-			reduce(7), /* typedef, reduce: Decl */
-			nil,       /* , */
-			reduce(7), /* return, reduce: Decl */
-			reduce(7), /* {, reduce: Decl */
-			reduce(7), /* }, reduce: Decl */
-			reduce(7), /* if, reduce: Decl */
-			nil,       /* else */
-			reduce(7), /* while, reduce: Decl */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			reduce(7), /* -, reduce: Decl */
-			nil,       /* * */
-			nil,       /* / */
-			reduce(7), /* !, reduce: Decl */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(122), // error
+			reduce(60), // }, reduce: BlockItems
+			shift(45),  // ;
+			shift(11),  // extern
+			shift(12),  // static
+			shift(51),  // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			shift(19),  // typedef
+			shift(20),  // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S65
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			reduce(9), /* ;, reduce: Decl */
-			reduce(9), /* ident, reduce: Decl */
-			reduce(9), /* (, reduce: Decl */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			reduce(9), /* int_lit, reduce: Decl */
-			reduce(9), /* char_lit, reduce: Decl */
-			reduce(9), /* typedef, reduce: Decl */
-			nil,       /* , */
-			reduce(9), /* return, reduce: Decl */
-			reduce(9), /* {, reduce: Decl */
-			reduce(9), /* }, reduce: Decl */
-			reduce(9), /* if, reduce: Decl */
-			nil,       /* else */
-			reduce(9), /* while, reduce: Decl */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			reduce(9), /* -, reduce: Decl */
-			nil,       /* * */
-			nil,       /* / */
-			reduce(9), /* !, reduce: Decl */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(124), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S66
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(12), /* ;, reduce: FuncDef */
-			reduce(12), /* ident, reduce: FuncDef */
-			reduce(12), /* (, reduce: FuncDef */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(12), /* int_lit, reduce: FuncDef */
-			reduce(12), /* char_lit, reduce: FuncDef */
-			reduce(12), /* typedef, reduce: FuncDef */
-			nil,        /* , */
-			reduce(12), /* return, reduce: FuncDef */
-			reduce(12), /* {, reduce: FuncDef */
-			reduce(12), /* }, reduce: FuncDef */
-			reduce(12), /* if, reduce: FuncDef */
-			nil,        /* else */
-			reduce(12), /* while, reduce: FuncDef */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(12), /* -, reduce: FuncDef */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(12), /* !, reduce: FuncDef */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(124), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S67
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			reduce(80), /* ), reduce: Args */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(61), // error, reduce: BlockItemList
+			reduce(61), // }, reduce: BlockItemList
+			reduce(61), // ;, reduce: BlockItemList
+			reduce(61), // extern, reduce: BlockItemList
+			reduce(61), // static, reduce: BlockItemList
+			reduce(61), // ident, reduce: BlockItemList
+			reduce(61), // (, reduce: BlockItemList
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(61), // int_lit, reduce: BlockItemList
+			reduce(61), // char_lit, reduce: BlockItemList
+			reduce(61), // typedef, reduce: BlockItemList
+			reduce(61), // const, reduce: BlockItemList
+			reduce(61), // return, reduce: BlockItemList
+			reduce(61), // {, reduce: BlockItemList
+			reduce(61), // if, reduce: BlockItemList
+			nil,        // else
+			reduce(61), // while, reduce: BlockItemList
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(61), // -, reduce: BlockItemList
+			nil,        // *
+			nil,        // /
+			reduce(61), // !, reduce: BlockItemList
+			reduce(61), // float_lit, reduce: BlockItemList
+			nil,        // comment
 		},
 	},
 	actionRow{ // S68
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(65), // ;, reduce: Expr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S69
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			shift(159), /* ( */
-			reduce(77), /* ), reduce: PrimaryExpr */
-			shift(160), /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(77), /* =, reduce: PrimaryExpr */
-			reduce(77), /* &&, reduce: PrimaryExpr */
-			reduce(77), /* ==, reduce: PrimaryExpr */
-			reduce(77), /* !=, reduce: PrimaryExpr */
-			reduce(77), /* <, reduce: PrimaryExpr */
-			reduce(77), /* >, reduce: PrimaryExpr */
-			reduce(77), /* <=, reduce: PrimaryExpr */
-			reduce(77), /* >=, reduce: PrimaryExpr */
-			reduce(77), /* +, reduce: PrimaryExpr */
-			reduce(77), /* -, reduce: PrimaryExpr */
-			reduce(77), /* *, reduce: PrimaryExpr */
-			reduce(77), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(66), // ;, reduce: Expr2R
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			shift(127), // =
+			shift(128), // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S70
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(68), // ;, reduce: Expr5L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(68), // =, reduce: Expr5L
+			reduce(68), // &&, reduce: Expr5L
+			shift(129), // ==
+			shift(130), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S71
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(75), /* ), reduce: PrimaryExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(75), /* =, reduce: PrimaryExpr */
-			reduce(75), /* &&, reduce: PrimaryExpr */
-			reduce(75), /* ==, reduce: PrimaryExpr */
-			reduce(75), /* !=, reduce: PrimaryExpr */
-			reduce(75), /* <, reduce: PrimaryExpr */
-			reduce(75), /* >, reduce: PrimaryExpr */
-			reduce(75), /* <=, reduce: PrimaryExpr */
-			reduce(75), /* >=, reduce: PrimaryExpr */
-			reduce(75), /* +, reduce: PrimaryExpr */
-			reduce(75), /* -, reduce: PrimaryExpr */
-			reduce(75), /* *, reduce: PrimaryExpr */
-			reduce(75), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(70), // ;, reduce: Expr9L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(70), // =, reduce: Expr9L
+			reduce(70), // &&, reduce: Expr9L
+			reduce(70), // ==, reduce: Expr9L
+			reduce(70), // !=, reduce: Expr9L
+			shift(131), // <
+			shift(132), // >
+			shift(133), // <=
+			shift(134), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S72
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(76), /* ), reduce: PrimaryExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(76), /* =, reduce: PrimaryExpr */
-			reduce(76), /* &&, reduce: PrimaryExpr */
-			reduce(76), /* ==, reduce: PrimaryExpr */
-			reduce(76), /* !=, reduce: PrimaryExpr */
-			reduce(76), /* <, reduce: PrimaryExpr */
-			reduce(76), /* >, reduce: PrimaryExpr */
-			reduce(76), /* <=, reduce: PrimaryExpr */
-			reduce(76), /* >=, reduce: PrimaryExpr */
-			reduce(76), /* +, reduce: PrimaryExpr */
-			reduce(76), /* -, reduce: PrimaryExpr */
-			reduce(76), /* *, reduce: PrimaryExpr */
-			reduce(76), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(73), // ;, reduce: Expr10L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(73), // =, reduce: Expr10L
+			reduce(73), // &&, reduce: Expr10L
+			reduce(73), // ==, reduce: Expr10L
+			reduce(73), // !=, reduce: Expr10L
+			reduce(73), // <, reduce: Expr10L
+			reduce(73), // >, reduce: Expr10L
+			reduce(73), // <=, reduce: Expr10L
+			reduce(73), // >=, reduce: Expr10L
+			shift(135), // +
+			shift(136), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S73
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(162), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(78), // ;, reduce: Expr12L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(78), // =, reduce: Expr12L
+			reduce(78), // &&, reduce: Expr12L
+			reduce(78), // ==, reduce: Expr12L
+			reduce(78), // !=, reduce: Expr12L
+			reduce(78), // <, reduce: Expr12L
+			reduce(78), // >, reduce: Expr12L
+			reduce(78), // <=, reduce: Expr12L
+			reduce(78), // >=, reduce: Expr12L
+			reduce(78), // +, reduce: Expr12L
+			reduce(78), // -, reduce: Expr12L
+			shift(137), // *
+			shift(138), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S74
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(50), /* ), reduce: Expr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S75
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(51), /* ), reduce: Expr2R */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			shift(163), /* = */
-			shift(164), /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(81), // ;, reduce: Expr13L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(81), // =, reduce: Expr13L
+			reduce(81), // &&, reduce: Expr13L
+			reduce(81), // ==, reduce: Expr13L
+			reduce(81), // !=, reduce: Expr13L
+			reduce(81), // <, reduce: Expr13L
+			reduce(81), // >, reduce: Expr13L
+			reduce(81), // <=, reduce: Expr13L
+			reduce(81), // >=, reduce: Expr13L
+			reduce(81), // +, reduce: Expr13L
+			reduce(81), // -, reduce: Expr13L
+			reduce(81), // *, reduce: Expr13L
+			reduce(81), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S76
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(53), /* ), reduce: Expr5L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(53), /* =, reduce: Expr5L */
-			reduce(53), /* &&, reduce: Expr5L */
-			shift(165), /* == */
-			shift(166), /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(84), // ;, reduce: Expr14
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(84), // =, reduce: Expr14
+			reduce(84), // &&, reduce: Expr14
+			reduce(84), // ==, reduce: Expr14
+			reduce(84), // !=, reduce: Expr14
+			reduce(84), // <, reduce: Expr14
+			reduce(84), // >, reduce: Expr14
+			reduce(84), // <=, reduce: Expr14
+			reduce(84), // >=, reduce: Expr14
+			reduce(84), // +, reduce: Expr14
+			reduce(84), // -, reduce: Expr14
+			reduce(84), // *, reduce: Expr14
+			reduce(84), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S77
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(55), /* ), reduce: Expr9L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(55), /* =, reduce: Expr9L */
-			reduce(55), /* &&, reduce: Expr9L */
-			reduce(55), /* ==, reduce: Expr9L */
-			reduce(55), /* !=, reduce: Expr9L */
-			shift(167), /* < */
-			shift(168), /* > */
-			shift(169), /* <= */
-			shift(170), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S78
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(58), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(58), /* =, reduce: Expr10L */
-			reduce(58), /* &&, reduce: Expr10L */
-			reduce(58), /* ==, reduce: Expr10L */
-			reduce(58), /* !=, reduce: Expr10L */
-			reduce(58), /* <, reduce: Expr10L */
-			reduce(58), /* >, reduce: Expr10L */
-			reduce(58), /* <=, reduce: Expr10L */
-			reduce(58), /* >=, reduce: Expr10L */
-			shift(171), /* + */
-			shift(172), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(87), // ;, reduce: Expr15
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(87), // =, reduce: Expr15
+			reduce(87), // &&, reduce: Expr15
+			reduce(87), // ==, reduce: Expr15
+			reduce(87), // !=, reduce: Expr15
+			reduce(87), // <, reduce: Expr15
+			reduce(87), // >, reduce: Expr15
+			reduce(87), // <=, reduce: Expr15
+			reduce(87), // >=, reduce: Expr15
+			reduce(87), // +, reduce: Expr15
+			reduce(87), // -, reduce: Expr15
+			reduce(87), // *, reduce: Expr15
+			reduce(87), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S79
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(63), /* ), reduce: Expr12L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(63), /* =, reduce: Expr12L */
-			reduce(63), /* &&, reduce: Expr12L */
-			reduce(63), /* ==, reduce: Expr12L */
-			reduce(63), /* !=, reduce: Expr12L */
-			reduce(63), /* <, reduce: Expr12L */
-			reduce(63), /* >, reduce: Expr12L */
-			reduce(63), /* <=, reduce: Expr12L */
-			reduce(63), /* >=, reduce: Expr12L */
-			reduce(63), /* +, reduce: Expr12L */
-			reduce(63), /* -, reduce: Expr12L */
-			shift(173), /* * */
-			shift(174), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(91), // ;, reduce: PrimaryExpr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(91), // =, reduce: PrimaryExpr
+			reduce(91), // &&, reduce: PrimaryExpr
+			reduce(91), // ==, reduce: PrimaryExpr
+			reduce(91), // !=, reduce: PrimaryExpr
+			reduce(91), // <, reduce: PrimaryExpr
+			reduce(91), // >, reduce: PrimaryExpr
+			reduce(91), // <=, reduce: PrimaryExpr
+			reduce(91), // >=, reduce: PrimaryExpr
+			reduce(91), // +, reduce: PrimaryExpr
+			reduce(91), // -, reduce: PrimaryExpr
+			reduce(91), // *, reduce: PrimaryExpr
+			reduce(91), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S80
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(94), // ;, reduce: PrimaryExpr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(94), // =, reduce: PrimaryExpr
+			reduce(94), // &&, reduce: PrimaryExpr
+			reduce(94), // ==, reduce: PrimaryExpr
+			reduce(94), // !=, reduce: PrimaryExpr
+			reduce(94), // <, reduce: PrimaryExpr
+			reduce(94), // >, reduce: PrimaryExpr
+			reduce(94), // <=, reduce: PrimaryExpr
+			reduce(94), // >=, reduce: PrimaryExpr
+			reduce(94), // +, reduce: PrimaryExpr
+			reduce(94), // -, reduce: PrimaryExpr
+			reduce(94), // *, reduce: PrimaryExpr
+			reduce(94), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S81
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(66), /* ), reduce: Expr13L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(66), /* =, reduce: Expr13L */
-			reduce(66), /* &&, reduce: Expr13L */
-			reduce(66), /* ==, reduce: Expr13L */
-			reduce(66), /* !=, reduce: Expr13L */
-			reduce(66), /* <, reduce: Expr13L */
-			reduce(66), /* >, reduce: Expr13L */
-			reduce(66), /* <=, reduce: Expr13L */
-			reduce(66), /* >=, reduce: Expr13L */
-			reduce(66), /* +, reduce: Expr13L */
-			reduce(66), /* -, reduce: Expr13L */
-			reduce(66), /* *, reduce: Expr13L */
-			reduce(66), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(142), // ident
+			nil,        // (
+			reduce(35), // ), reduce: Params
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			shift(148), // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S82
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(69), /* ), reduce: Expr14 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(69), /* =, reduce: Expr14 */
-			reduce(69), /* &&, reduce: Expr14 */
-			reduce(69), /* ==, reduce: Expr14 */
-			reduce(69), /* !=, reduce: Expr14 */
-			reduce(69), /* <, reduce: Expr14 */
-			reduce(69), /* >, reduce: Expr14 */
-			reduce(69), /* <=, reduce: Expr14 */
-			reduce(69), /* >=, reduce: Expr14 */
-			reduce(69), /* +, reduce: Expr14 */
-			reduce(69), /* -, reduce: Expr14 */
-			reduce(69), /* *, reduce: Expr14 */
-			reduce(69), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(152), // ]
+			shift(153), // int_lit
+			shift(154), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S83
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(32), // ;, reduce: TypeDef
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S84
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(72), /* ), reduce: Expr15 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(72), /* =, reduce: Expr15 */
-			reduce(72), /* &&, reduce: Expr15 */
-			reduce(72), /* ==, reduce: Expr15 */
-			reduce(72), /* !=, reduce: Expr15 */
-			reduce(72), /* <, reduce: Expr15 */
-			reduce(72), /* >, reduce: Expr15 */
-			reduce(72), /* <=, reduce: Expr15 */
-			reduce(72), /* >=, reduce: Expr15 */
-			reduce(72), /* +, reduce: Expr15 */
-			reduce(72), /* -, reduce: Expr15 */
-			reduce(72), /* *, reduce: Expr15 */
-			reduce(72), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(156), // ]
+			shift(153), // int_lit
+			shift(154), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S85
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(78), /* ), reduce: PrimaryExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(78), /* =, reduce: PrimaryExpr */
-			reduce(78), /* &&, reduce: PrimaryExpr */
-			reduce(78), /* ==, reduce: PrimaryExpr */
-			reduce(78), /* !=, reduce: PrimaryExpr */
-			reduce(78), /* <, reduce: PrimaryExpr */
-			reduce(78), /* >, reduce: PrimaryExpr */
-			reduce(78), /* <=, reduce: PrimaryExpr */
-			reduce(78), /* >=, reduce: PrimaryExpr */
-			reduce(78), /* +, reduce: PrimaryExpr */
-			reduce(78), /* -, reduce: PrimaryExpr */
-			reduce(78), /* *, reduce: PrimaryExpr */
-			reduce(78), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			reduce(50), // ␚, reduce: BlockStmt
+			nil,        // empty
+			reduce(50), // error, reduce: BlockStmt
+			nil,        // }
+			nil,        // ;
+			reduce(50), // extern, reduce: BlockStmt
+			reduce(50), // static, reduce: BlockStmt
+			reduce(50), // ident, reduce: BlockStmt
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(50), // typedef, reduce: BlockStmt
+			reduce(50), // const, reduce: BlockStmt
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S86
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(31), /* ;, reduce: OtherStmt */
-			reduce(31), /* ident, reduce: OtherStmt */
-			reduce(31), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(31), /* int_lit, reduce: OtherStmt */
-			reduce(31), /* char_lit, reduce: OtherStmt */
-			reduce(31), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(31), /* return, reduce: OtherStmt */
-			reduce(31), /* {, reduce: OtherStmt */
-			reduce(31), /* }, reduce: OtherStmt */
-			reduce(31), /* if, reduce: OtherStmt */
-			nil,        /* else */
-			reduce(31), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(31), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(31), /* !, reduce: OtherStmt */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(15), // error, reduce: Decl
+			reduce(15), // }, reduce: Decl
+			reduce(15), // ;, reduce: Decl
+			reduce(15), // extern, reduce: Decl
+			reduce(15), // static, reduce: Decl
+			reduce(15), // ident, reduce: Decl
+			reduce(15), // (, reduce: Decl
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(15), // int_lit, reduce: Decl
+			reduce(15), // char_lit, reduce: Decl
+			reduce(15), // typedef, reduce: Decl
+			reduce(15), // const, reduce: Decl
+			reduce(15), // return, reduce: Decl
+			reduce(15), // {, reduce: Decl
+			reduce(15), // if, reduce: Decl
+			nil,        // else
+			reduce(15), // while, reduce: Decl
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(15), // -, reduce: Decl
+			nil,        // *
+			nil,        // /
+			reduce(15), // !, reduce: Decl
+			reduce(15), // float_lit, reduce: Decl
+			nil,        // comment
 		},
 	},
 	actionRow{ // S87
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(33), /* ;, reduce: OtherStmt */
-			reduce(33), /* ident, reduce: OtherStmt */
-			reduce(33), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(33), /* int_lit, reduce: OtherStmt */
-			reduce(33), /* char_lit, reduce: OtherStmt */
-			reduce(33), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(33), /* return, reduce: OtherStmt */
-			reduce(33), /* {, reduce: OtherStmt */
-			reduce(33), /* }, reduce: OtherStmt */
-			reduce(33), /* if, reduce: OtherStmt */
-			nil,        /* else */
-			reduce(33), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(33), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(33), /* !, reduce: OtherStmt */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(8), // error, reduce: Decl
+			reduce(8), // }, reduce: Decl
+			reduce(8), // ;, reduce: Decl
+			reduce(8), // extern, reduce: Decl
+			reduce(8), // static, reduce: Decl
+			reduce(8), // ident, reduce: Decl
+			reduce(8), // (, reduce: Decl
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			reduce(8), // int_lit, reduce: Decl
+			reduce(8), // char_lit, reduce: Decl
+			reduce(8), // typedef, reduce: Decl
+			reduce(8), // const, reduce: Decl
+			reduce(8), // return, reduce: Decl
+			reduce(8), // {, reduce: Decl
+			reduce(8), // if, reduce: Decl
+			nil,       // else
+			reduce(8), // while, reduce: Decl
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			reduce(8), // -, reduce: Decl
+			nil,       // *
+			nil,       // /
+			reduce(8), // !, reduce: Decl
+			reduce(8), // float_lit, reduce: Decl
+			nil,       // comment
 		},
 	},
 	actionRow{ // S88
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(77), /* ;, reduce: PrimaryExpr */
-			nil,        /* ident */
-			shift(67),  /* ( */
-			nil,        /* ) */
-			shift(68),  /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(77), /* =, reduce: PrimaryExpr */
-			reduce(77), /* &&, reduce: PrimaryExpr */
-			reduce(77), /* ==, reduce: PrimaryExpr */
-			reduce(77), /* !=, reduce: PrimaryExpr */
-			reduce(77), /* <, reduce: PrimaryExpr */
-			reduce(77), /* >, reduce: PrimaryExpr */
-			reduce(77), /* <=, reduce: PrimaryExpr */
-			reduce(77), /* >=, reduce: PrimaryExpr */
-			reduce(77), /* +, reduce: PrimaryExpr */
-			reduce(77), /* -, reduce: PrimaryExpr */
-			reduce(77), /* *, reduce: PrimaryExpr */
-			reduce(77), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(157), // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			shift(26),  // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S89
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(177), /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(158), // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S90
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			shift(178), /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(13), // error, reduce: Decl
+			reduce(13), // }, reduce: Decl
+			reduce(13), // ;, reduce: Decl
+			reduce(13), // extern, reduce: Decl
+			reduce(13), // static, reduce: Decl
+			reduce(13), // ident, reduce: Decl
+			reduce(13), // (, reduce: Decl
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(13), // int_lit, reduce: Decl
+			reduce(13), // char_lit, reduce: Decl
+			reduce(13), // typedef, reduce: Decl
+			reduce(13), // const, reduce: Decl
+			reduce(13), // return, reduce: Decl
+			reduce(13), // {, reduce: Decl
+			reduce(13), // if, reduce: Decl
+			nil,        // else
+			reduce(13), // while, reduce: Decl
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(13), // -, reduce: Decl
+			nil,        // *
+			nil,        // /
+			reduce(13), // !, reduce: Decl
+			reduce(13), // float_lit, reduce: Decl
+			nil,        // comment
 		},
 	},
 	actionRow{ // S91
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			reduce(36), /* $, reduce: BlockStmt */
-			nil,        /* empty */
-			nil,        /* ; */
-			reduce(36), /* ident, reduce: BlockStmt */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			reduce(36), /* typedef, reduce: BlockStmt */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(10), // error, reduce: Decl
+			reduce(10), // }, reduce: Decl
+			reduce(10), // ;, reduce: Decl
+			reduce(10), // extern, reduce: Decl
+			reduce(10), // static, reduce: Decl
+			reduce(10), // ident, reduce: Decl
+			reduce(10), // (, reduce: Decl
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(10), // int_lit, reduce: Decl
+			reduce(10), // char_lit, reduce: Decl
+			reduce(10), // typedef, reduce: Decl
+			reduce(10), // const, reduce: Decl
+			reduce(10), // return, reduce: Decl
+			reduce(10), // {, reduce: Decl
+			reduce(10), // if, reduce: Decl
+			nil,        // else
+			reduce(10), // while, reduce: Decl
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(10), // -, reduce: Decl
+			nil,        // *
+			nil,        // /
+			reduce(10), // !, reduce: Decl
+			reduce(10), // float_lit, reduce: Decl
+			nil,        // comment
 		},
 	},
 	actionRow{ // S92
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(14), // error, reduce: Decl
+			reduce(14), // }, reduce: Decl
+			reduce(14), // ;, reduce: Decl
+			reduce(14), // extern, reduce: Decl
+			reduce(14), // static, reduce: Decl
+			reduce(14), // ident, reduce: Decl
+			reduce(14), // (, reduce: Decl
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(14), // int_lit, reduce: Decl
+			reduce(14), // char_lit, reduce: Decl
+			reduce(14), // typedef, reduce: Decl
+			reduce(14), // const, reduce: Decl
+			reduce(14), // return, reduce: Decl
+			reduce(14), // {, reduce: Decl
+			reduce(14), // if, reduce: Decl
+			nil,        // else
+			reduce(14), // while, reduce: Decl
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(14), // -, reduce: Decl
+			nil,        // *
+			nil,        // /
+			reduce(14), // !, reduce: Decl
+			reduce(14), // float_lit, reduce: Decl
+			nil,        // comment
 		},
 	},
 	actionRow{ // S93
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(180), /* ; */
-			shift(88),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			shift(186), /* return */
-			shift(187), /* { */
-			nil,        /* } */
-			shift(188), /* if */
-			nil,        /* else */
-			shift(189), /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // error, reduce: FuncDef
+			reduce(20), // }, reduce: FuncDef
+			reduce(20), // ;, reduce: FuncDef
+			reduce(20), // extern, reduce: FuncDef
+			reduce(20), // static, reduce: FuncDef
+			reduce(20), // ident, reduce: FuncDef
+			reduce(20), // (, reduce: FuncDef
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(20), // int_lit, reduce: FuncDef
+			reduce(20), // char_lit, reduce: FuncDef
+			reduce(20), // typedef, reduce: FuncDef
+			reduce(20), // const, reduce: FuncDef
+			reduce(20), // return, reduce: FuncDef
+			reduce(20), // {, reduce: FuncDef
+			reduce(20), // if, reduce: FuncDef
+			nil,        // else
+			reduce(20), // while, reduce: FuncDef
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(20), // -, reduce: FuncDef
+			nil,        // *
+			nil,        // /
+			reduce(20), // !, reduce: FuncDef
+			reduce(20), // float_lit, reduce: FuncDef
+			nil,        // comment
 		},
 	},
 	actionRow{ // S94
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(26), /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			shift(41), /* return */
-			shift(42), /* { */
-			nil,       /* } */
-			shift(44), /* if */
-			nil,       /* else */
-			shift(45), /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			reduce(96), // ), reduce: Args
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S95
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(47), /* ;, reduce: BlockItemList */
-			reduce(47), /* ident, reduce: BlockItemList */
-			reduce(47), /* (, reduce: BlockItemList */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(47), /* int_lit, reduce: BlockItemList */
-			reduce(47), /* char_lit, reduce: BlockItemList */
-			reduce(47), /* typedef, reduce: BlockItemList */
-			nil,        /* , */
-			reduce(47), /* return, reduce: BlockItemList */
-			reduce(47), /* {, reduce: BlockItemList */
-			reduce(47), /* }, reduce: BlockItemList */
-			reduce(47), /* if, reduce: BlockItemList */
-			nil,        /* else */
-			reduce(47), /* while, reduce: BlockItemList */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(47), /* -, reduce: BlockItemList */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(47), /* !, reduce: BlockItemList */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S96
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(197), // (
+			reduce(93), // ), reduce: PrimaryExpr
+			nil,        // ,
+			shift(198), // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(93), // =, reduce: PrimaryExpr
+			reduce(93), // &&, reduce: PrimaryExpr
+			reduce(93), // ==, reduce: PrimaryExpr
+			reduce(93), // !=, reduce: PrimaryExpr
+			reduce(93), // <, reduce: PrimaryExpr
+			reduce(93), // >, reduce: PrimaryExpr
+			reduce(93), // <=, reduce: PrimaryExpr
+			reduce(93), // >=, reduce: PrimaryExpr
+			reduce(93), // +, reduce: PrimaryExpr
+			reduce(93), // -, reduce: PrimaryExpr
+			reduce(93), // *, reduce: PrimaryExpr
+			reduce(93), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S97
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S98
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(90), // ), reduce: PrimaryExpr
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(90), // =, reduce: PrimaryExpr
+			reduce(90), // &&, reduce: PrimaryExpr
+			reduce(90), // ==, reduce: PrimaryExpr
+			reduce(90), // !=, reduce: PrimaryExpr
+			reduce(90), // <, reduce: PrimaryExpr
+			reduce(90), // >, reduce: PrimaryExpr
+			reduce(90), // <=, reduce: PrimaryExpr
+			reduce(90), // >=, reduce: PrimaryExpr
+			reduce(90), // +, reduce: PrimaryExpr
+			reduce(90), // -, reduce: PrimaryExpr
+			reduce(90), // *, reduce: PrimaryExpr
+			reduce(90), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S99
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(92), // ), reduce: PrimaryExpr
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(92), // =, reduce: PrimaryExpr
+			reduce(92), // &&, reduce: PrimaryExpr
+			reduce(92), // ==, reduce: PrimaryExpr
+			reduce(92), // !=, reduce: PrimaryExpr
+			reduce(92), // <, reduce: PrimaryExpr
+			reduce(92), // >, reduce: PrimaryExpr
+			reduce(92), // <=, reduce: PrimaryExpr
+			reduce(92), // >=, reduce: PrimaryExpr
+			reduce(92), // +, reduce: PrimaryExpr
+			reduce(92), // -, reduce: PrimaryExpr
+			reduce(92), // *, reduce: PrimaryExpr
+			reduce(92), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S100
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(200), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S101
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(65), // ), reduce: Expr
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S102
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(66), // ), reduce: Expr2R
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			shift(201), // =
+			shift(202), // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S103
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(68), // ), reduce: Expr5L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(68), // =, reduce: Expr5L
+			reduce(68), // &&, reduce: Expr5L
+			shift(203), // ==
+			shift(204), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S104
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(70), // ), reduce: Expr9L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(70), // =, reduce: Expr9L
+			reduce(70), // &&, reduce: Expr9L
+			reduce(70), // ==, reduce: Expr9L
+			reduce(70), // !=, reduce: Expr9L
+			shift(205), // <
+			shift(206), // >
+			shift(207), // <=
+			shift(208), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S105
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(73), // ), reduce: Expr10L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(73), // =, reduce: Expr10L
+			reduce(73), // &&, reduce: Expr10L
+			reduce(73), // ==, reduce: Expr10L
+			reduce(73), // !=, reduce: Expr10L
+			reduce(73), // <, reduce: Expr10L
+			reduce(73), // >, reduce: Expr10L
+			reduce(73), // <=, reduce: Expr10L
+			reduce(73), // >=, reduce: Expr10L
+			shift(209), // +
+			shift(210), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S106
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(78), // ), reduce: Expr12L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(78), // =, reduce: Expr12L
+			reduce(78), // &&, reduce: Expr12L
+			reduce(78), // ==, reduce: Expr12L
+			reduce(78), // !=, reduce: Expr12L
+			reduce(78), // <, reduce: Expr12L
+			reduce(78), // >, reduce: Expr12L
+			reduce(78), // <=, reduce: Expr12L
+			reduce(78), // >=, reduce: Expr12L
+			reduce(78), // +, reduce: Expr12L
+			reduce(78), // -, reduce: Expr12L
+			shift(211), // *
+			shift(212), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S107
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S108
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(70), /* ;, reduce: Expr14 */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(70), /* =, reduce: Expr14 */
-			reduce(70), /* &&, reduce: Expr14 */
-			reduce(70), /* ==, reduce: Expr14 */
-			reduce(70), /* !=, reduce: Expr14 */
-			reduce(70), /* <, reduce: Expr14 */
-			reduce(70), /* >, reduce: Expr14 */
-			reduce(70), /* <=, reduce: Expr14 */
-			reduce(70), /* >=, reduce: Expr14 */
-			reduce(70), /* +, reduce: Expr14 */
-			reduce(70), /* -, reduce: Expr14 */
-			reduce(70), /* *, reduce: Expr14 */
-			reduce(70), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(81), // ), reduce: Expr13L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(81), // =, reduce: Expr13L
+			reduce(81), // &&, reduce: Expr13L
+			reduce(81), // ==, reduce: Expr13L
+			reduce(81), // !=, reduce: Expr13L
+			reduce(81), // <, reduce: Expr13L
+			reduce(81), // >, reduce: Expr13L
+			reduce(81), // <=, reduce: Expr13L
+			reduce(81), // >=, reduce: Expr13L
+			reduce(81), // +, reduce: Expr13L
+			reduce(81), // -, reduce: Expr13L
+			reduce(81), // *, reduce: Expr13L
+			reduce(81), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S109
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(71), /* ;, reduce: Expr14 */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(71), /* =, reduce: Expr14 */
-			reduce(71), /* &&, reduce: Expr14 */
-			reduce(71), /* ==, reduce: Expr14 */
-			reduce(71), /* !=, reduce: Expr14 */
-			reduce(71), /* <, reduce: Expr14 */
-			reduce(71), /* >, reduce: Expr14 */
-			reduce(71), /* <=, reduce: Expr14 */
-			reduce(71), /* >=, reduce: Expr14 */
-			reduce(71), /* +, reduce: Expr14 */
-			reduce(71), /* -, reduce: Expr14 */
-			reduce(71), /* *, reduce: Expr14 */
-			reduce(71), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(84), // ), reduce: Expr14
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(84), // =, reduce: Expr14
+			reduce(84), // &&, reduce: Expr14
+			reduce(84), // ==, reduce: Expr14
+			reduce(84), // !=, reduce: Expr14
+			reduce(84), // <, reduce: Expr14
+			reduce(84), // >, reduce: Expr14
+			reduce(84), // <=, reduce: Expr14
+			reduce(84), // >=, reduce: Expr14
+			reduce(84), // +, reduce: Expr14
+			reduce(84), // -, reduce: Expr14
+			reduce(84), // *, reduce: Expr14
+			reduce(84), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S110
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(27), /* ), reduce: Param */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(27), /* ,, reduce: Param */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S111
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(204), /* ident */
-			nil,        /* ( */
-			reduce(28), /* ), reduce: Type */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(28), /* ,, reduce: Type */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(87), // ), reduce: Expr15
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(87), // =, reduce: Expr15
+			reduce(87), // &&, reduce: Expr15
+			reduce(87), // ==, reduce: Expr15
+			reduce(87), // !=, reduce: Expr15
+			reduce(87), // <, reduce: Expr15
+			reduce(87), // >, reduce: Expr15
+			reduce(87), // <=, reduce: Expr15
+			reduce(87), // >=, reduce: Expr15
+			reduce(87), // +, reduce: Expr15
+			reduce(87), // -, reduce: Expr15
+			reduce(87), // *, reduce: Expr15
+			reduce(87), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S112
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			reduce(21), /* ident, reduce: BasicType */
-			nil,        /* ( */
-			reduce(21), /* ), reduce: BasicType */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(21), /* ,, reduce: BasicType */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(91), // ), reduce: PrimaryExpr
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(91), // =, reduce: PrimaryExpr
+			reduce(91), // &&, reduce: PrimaryExpr
+			reduce(91), // ==, reduce: PrimaryExpr
+			reduce(91), // !=, reduce: PrimaryExpr
+			reduce(91), // <, reduce: PrimaryExpr
+			reduce(91), // >, reduce: PrimaryExpr
+			reduce(91), // <=, reduce: PrimaryExpr
+			reduce(91), // >=, reduce: PrimaryExpr
+			reduce(91), // +, reduce: PrimaryExpr
+			reduce(91), // -, reduce: PrimaryExpr
+			reduce(91), // *, reduce: PrimaryExpr
+			reduce(91), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S113
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(205), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(94), // ), reduce: PrimaryExpr
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(94), // =, reduce: PrimaryExpr
+			reduce(94), // &&, reduce: PrimaryExpr
+			reduce(94), // ==, reduce: PrimaryExpr
+			reduce(94), // !=, reduce: PrimaryExpr
+			reduce(94), // <, reduce: PrimaryExpr
+			reduce(94), // >, reduce: PrimaryExpr
+			reduce(94), // <=, reduce: PrimaryExpr
+			reduce(94), // >=, reduce: PrimaryExpr
+			reduce(94), // +, reduce: PrimaryExpr
+			reduce(94), // -, reduce: PrimaryExpr
+			reduce(94), // *, reduce: PrimaryExpr
+			reduce(94), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S114
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(13), /* ), reduce: VarDecl */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(13), /* ,, reduce: VarDecl */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // error, reduce: OtherStmt
+			reduce(44), // }, reduce: OtherStmt
+			reduce(44), // ;, reduce: OtherStmt
+			reduce(44), // extern, reduce: OtherStmt
+			reduce(44), // static, reduce: OtherStmt
+			reduce(44), // ident, reduce: OtherStmt
+			reduce(44), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(44), // int_lit, reduce: OtherStmt
+			reduce(44), // char_lit, reduce: OtherStmt
+			reduce(44), // typedef, reduce: OtherStmt
+			reduce(44), // const, reduce: OtherStmt
+			reduce(44), // return, reduce: OtherStmt
+			reduce(44), // {, reduce: OtherStmt
+			reduce(44), // if, reduce: OtherStmt
+			nil,        // else
+			reduce(44), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(44), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(44), // !, reduce: OtherStmt
+			reduce(44), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S115
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(14), /* ), reduce: VarDecl */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(14), /* ,, reduce: VarDecl */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // error, reduce: OtherStmt
+			reduce(46), // }, reduce: OtherStmt
+			reduce(46), // ;, reduce: OtherStmt
+			reduce(46), // extern, reduce: OtherStmt
+			reduce(46), // static, reduce: OtherStmt
+			reduce(46), // ident, reduce: OtherStmt
+			reduce(46), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(46), // int_lit, reduce: OtherStmt
+			reduce(46), // char_lit, reduce: OtherStmt
+			reduce(46), // typedef, reduce: OtherStmt
+			reduce(46), // const, reduce: OtherStmt
+			reduce(46), // return, reduce: OtherStmt
+			reduce(46), // {, reduce: OtherStmt
+			reduce(46), // if, reduce: OtherStmt
+			nil,        // else
+			reduce(46), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(46), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(46), // !, reduce: OtherStmt
+			reduce(46), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S116
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(26), /* ), reduce: Param */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(26), /* ,, reduce: Param */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(93), // ;, reduce: PrimaryExpr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(94),  // (
+			nil,        // )
+			nil,        // ,
+			shift(95),  // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(93), // =, reduce: PrimaryExpr
+			reduce(93), // &&, reduce: PrimaryExpr
+			reduce(93), // ==, reduce: PrimaryExpr
+			reduce(93), // !=, reduce: PrimaryExpr
+			reduce(93), // <, reduce: PrimaryExpr
+			reduce(93), // >, reduce: PrimaryExpr
+			reduce(93), // <=, reduce: PrimaryExpr
+			reduce(93), // >=, reduce: PrimaryExpr
+			reduce(93), // +, reduce: PrimaryExpr
+			reduce(93), // -, reduce: PrimaryExpr
+			reduce(93), // *, reduce: PrimaryExpr
+			reduce(93), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S117
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(23), /* ), reduce: Params */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			shift(206), /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(215), // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S118
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(24), /* ), reduce: ParamList */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(24), /* ,, reduce: ParamList */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(216), // }
+			shift(86),  // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S119
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(207), /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(217), // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S120
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(17), /* ;, reduce: ArrayDecl */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(218), // error
+			reduce(60), // }, reduce: BlockItems
+			shift(45),  // ;
+			shift(11),  // extern
+			shift(12),  // static
+			shift(51),  // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			shift(19),  // typedef
+			shift(20),  // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S121
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(18), /* ], reduce: IntLit */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			reduce(49), // ␚, reduce: BlockStmt
+			nil,        // empty
+			reduce(49), // error, reduce: BlockStmt
+			nil,        // }
+			nil,        // ;
+			reduce(49), // extern, reduce: BlockStmt
+			reduce(49), // static, reduce: BlockStmt
+			reduce(49), // ident, reduce: BlockStmt
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(49), // typedef, reduce: BlockStmt
+			reduce(49), // const, reduce: BlockStmt
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S122
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(19), /* ], reduce: IntLit */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(219), // }
+			shift(86),  // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S123
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			shift(208), /* ( */
-			reduce(77), /* ), reduce: PrimaryExpr */
-			shift(209), /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(77), /* ,, reduce: PrimaryExpr */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(77), /* =, reduce: PrimaryExpr */
-			reduce(77), /* &&, reduce: PrimaryExpr */
-			reduce(77), /* ==, reduce: PrimaryExpr */
-			reduce(77), /* !=, reduce: PrimaryExpr */
-			reduce(77), /* <, reduce: PrimaryExpr */
-			reduce(77), /* >, reduce: PrimaryExpr */
-			reduce(77), /* <=, reduce: PrimaryExpr */
-			reduce(77), /* >=, reduce: PrimaryExpr */
-			reduce(77), /* +, reduce: PrimaryExpr */
-			reduce(77), /* -, reduce: PrimaryExpr */
-			reduce(77), /* *, reduce: PrimaryExpr */
-			reduce(77), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(62), // error, reduce: BlockItemList
+			reduce(62), // }, reduce: BlockItemList
+			reduce(62), // ;, reduce: BlockItemList
+			reduce(62), // extern, reduce: BlockItemList
+			reduce(62), // static, reduce: BlockItemList
+			reduce(62), // ident, reduce: BlockItemList
+			reduce(62), // (, reduce: BlockItemList
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(62), // int_lit, reduce: BlockItemList
+			reduce(62), // char_lit, reduce: BlockItemList
+			reduce(62), // typedef, reduce: BlockItemList
+			reduce(62), // const, reduce: BlockItemList
+			reduce(62), // return, reduce: BlockItemList
+			reduce(62), // {, reduce: BlockItemList
+			reduce(62), // if, reduce: BlockItemList
+			nil,        // else
+			reduce(62), // while, reduce: BlockItemList
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(62), // -, reduce: BlockItemList
+			nil,        // *
+			nil,        // /
+			reduce(62), // !, reduce: BlockItemList
+			reduce(62), // float_lit, reduce: BlockItemList
+			nil,        // comment
 		},
 	},
 	actionRow{ // S124
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S125
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(75), /* ), reduce: PrimaryExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(75), /* ,, reduce: PrimaryExpr */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(75), /* =, reduce: PrimaryExpr */
-			reduce(75), /* &&, reduce: PrimaryExpr */
-			reduce(75), /* ==, reduce: PrimaryExpr */
-			reduce(75), /* !=, reduce: PrimaryExpr */
-			reduce(75), /* <, reduce: PrimaryExpr */
-			reduce(75), /* >, reduce: PrimaryExpr */
-			reduce(75), /* <=, reduce: PrimaryExpr */
-			reduce(75), /* >=, reduce: PrimaryExpr */
-			reduce(75), /* +, reduce: PrimaryExpr */
-			reduce(75), /* -, reduce: PrimaryExpr */
-			reduce(75), /* *, reduce: PrimaryExpr */
-			reduce(75), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(221), // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			shift(227), // return
+			shift(228), // {
+			shift(229), // if
+			nil,        // else
+			shift(230), // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S126
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(76), /* ), reduce: PrimaryExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(76), /* ,, reduce: PrimaryExpr */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(76), /* =, reduce: PrimaryExpr */
-			reduce(76), /* &&, reduce: PrimaryExpr */
-			reduce(76), /* ==, reduce: PrimaryExpr */
-			reduce(76), /* !=, reduce: PrimaryExpr */
-			reduce(76), /* <, reduce: PrimaryExpr */
-			reduce(76), /* >, reduce: PrimaryExpr */
-			reduce(76), /* <=, reduce: PrimaryExpr */
-			reduce(76), /* >=, reduce: PrimaryExpr */
-			reduce(76), /* +, reduce: PrimaryExpr */
-			reduce(76), /* -, reduce: PrimaryExpr */
-			reduce(76), /* *, reduce: PrimaryExpr */
-			reduce(76), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(45),  // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S127
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(82), /* ), reduce: ExprList */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(82), /* ,, reduce: ExprList */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S128
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(50), /* ), reduce: Expr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(50), /* ,, reduce: Expr */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S129
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(51), /* ), reduce: Expr2R */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(51), /* ,, reduce: Expr2R */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			shift(211), /* = */
-			shift(212), /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S130
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(53), /* ), reduce: Expr5L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(53), /* ,, reduce: Expr5L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(53), /* =, reduce: Expr5L */
-			reduce(53), /* &&, reduce: Expr5L */
-			shift(213), /* == */
-			shift(214), /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S131
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(55), /* ), reduce: Expr9L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(55), /* ,, reduce: Expr9L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(55), /* =, reduce: Expr9L */
-			reduce(55), /* &&, reduce: Expr9L */
-			reduce(55), /* ==, reduce: Expr9L */
-			reduce(55), /* !=, reduce: Expr9L */
-			shift(215), /* < */
-			shift(216), /* > */
-			shift(217), /* <= */
-			shift(218), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S132
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(58), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(58), /* ,, reduce: Expr10L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(58), /* =, reduce: Expr10L */
-			reduce(58), /* &&, reduce: Expr10L */
-			reduce(58), /* ==, reduce: Expr10L */
-			reduce(58), /* !=, reduce: Expr10L */
-			reduce(58), /* <, reduce: Expr10L */
-			reduce(58), /* >, reduce: Expr10L */
-			reduce(58), /* <=, reduce: Expr10L */
-			reduce(58), /* >=, reduce: Expr10L */
-			shift(219), /* + */
-			shift(220), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S133
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(63), /* ), reduce: Expr12L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(63), /* ,, reduce: Expr12L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(63), /* =, reduce: Expr12L */
-			reduce(63), /* &&, reduce: Expr12L */
-			reduce(63), /* ==, reduce: Expr12L */
-			reduce(63), /* !=, reduce: Expr12L */
-			reduce(63), /* <, reduce: Expr12L */
-			reduce(63), /* >, reduce: Expr12L */
-			reduce(63), /* <=, reduce: Expr12L */
-			reduce(63), /* >=, reduce: Expr12L */
-			reduce(63), /* +, reduce: Expr12L */
-			reduce(63), /* -, reduce: Expr12L */
-			shift(221), /* * */
-			shift(222), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S134
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S135
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(66), /* ), reduce: Expr13L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(66), /* ,, reduce: Expr13L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(66), /* =, reduce: Expr13L */
-			reduce(66), /* &&, reduce: Expr13L */
-			reduce(66), /* ==, reduce: Expr13L */
-			reduce(66), /* !=, reduce: Expr13L */
-			reduce(66), /* <, reduce: Expr13L */
-			reduce(66), /* >, reduce: Expr13L */
-			reduce(66), /* <=, reduce: Expr13L */
-			reduce(66), /* >=, reduce: Expr13L */
-			reduce(66), /* +, reduce: Expr13L */
-			reduce(66), /* -, reduce: Expr13L */
-			reduce(66), /* *, reduce: Expr13L */
-			reduce(66), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S136
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(69), /* ), reduce: Expr14 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(69), /* ,, reduce: Expr14 */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(69), /* =, reduce: Expr14 */
-			reduce(69), /* &&, reduce: Expr14 */
-			reduce(69), /* ==, reduce: Expr14 */
-			reduce(69), /* !=, reduce: Expr14 */
-			reduce(69), /* <, reduce: Expr14 */
-			reduce(69), /* >, reduce: Expr14 */
-			reduce(69), /* <=, reduce: Expr14 */
-			reduce(69), /* >=, reduce: Expr14 */
-			reduce(69), /* +, reduce: Expr14 */
-			reduce(69), /* -, reduce: Expr14 */
-			reduce(69), /* *, reduce: Expr14 */
-			reduce(69), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S137
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S138
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(72), /* ), reduce: Expr15 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(72), /* ,, reduce: Expr15 */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(72), /* =, reduce: Expr15 */
-			reduce(72), /* &&, reduce: Expr15 */
-			reduce(72), /* ==, reduce: Expr15 */
-			reduce(72), /* !=, reduce: Expr15 */
-			reduce(72), /* <, reduce: Expr15 */
-			reduce(72), /* >, reduce: Expr15 */
-			reduce(72), /* <=, reduce: Expr15 */
-			reduce(72), /* >=, reduce: Expr15 */
-			reduce(72), /* +, reduce: Expr15 */
-			reduce(72), /* -, reduce: Expr15 */
-			reduce(72), /* *, reduce: Expr15 */
-			reduce(72), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S139
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(225), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(85), // ;, reduce: Expr14
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(85), // =, reduce: Expr14
+			reduce(85), // &&, reduce: Expr14
+			reduce(85), // ==, reduce: Expr14
+			reduce(85), // !=, reduce: Expr14
+			reduce(85), // <, reduce: Expr14
+			reduce(85), // >, reduce: Expr14
+			reduce(85), // <=, reduce: Expr14
+			reduce(85), // >=, reduce: Expr14
+			reduce(85), // +, reduce: Expr14
+			reduce(85), // -, reduce: Expr14
+			reduce(85), // *, reduce: Expr14
+			reduce(85), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S140
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(78), /* ), reduce: PrimaryExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(78), /* ,, reduce: PrimaryExpr */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(78), /* =, reduce: PrimaryExpr */
-			reduce(78), /* &&, reduce: PrimaryExpr */
-			reduce(78), /* ==, reduce: PrimaryExpr */
-			reduce(78), /* !=, reduce: PrimaryExpr */
-			reduce(78), /* <, reduce: PrimaryExpr */
-			reduce(78), /* >, reduce: PrimaryExpr */
-			reduce(78), /* <=, reduce: PrimaryExpr */
-			reduce(78), /* >=, reduce: PrimaryExpr */
-			reduce(78), /* +, reduce: PrimaryExpr */
-			reduce(78), /* -, reduce: PrimaryExpr */
-			reduce(78), /* *, reduce: PrimaryExpr */
-			reduce(78), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(86), // ;, reduce: Expr14
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(86), // =, reduce: Expr14
+			reduce(86), // &&, reduce: Expr14
+			reduce(86), // ==, reduce: Expr14
+			reduce(86), // !=, reduce: Expr14
+			reduce(86), // <, reduce: Expr14
+			reduce(86), // >, reduce: Expr14
+			reduce(86), // <=, reduce: Expr14
+			reduce(86), // >=, reduce: Expr14
+			reduce(86), // +, reduce: Expr14
+			reduce(86), // -, reduce: Expr14
+			reduce(86), // *, reduce: Expr14
+			reduce(86), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S141
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(81), /* ), reduce: Args */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			shift(226), /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(245), // ident
+			nil,        // (
+			reduce(41), // ), reduce: Type
+			reduce(41), // ,, reduce: Type
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S142
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			shift(227), /* ( */
-			nil,        /* ) */
-			shift(228), /* [ */
-			reduce(77), /* ], reduce: PrimaryExpr */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(77), /* =, reduce: PrimaryExpr */
-			reduce(77), /* &&, reduce: PrimaryExpr */
-			reduce(77), /* ==, reduce: PrimaryExpr */
-			reduce(77), /* !=, reduce: PrimaryExpr */
-			reduce(77), /* <, reduce: PrimaryExpr */
-			reduce(77), /* >, reduce: PrimaryExpr */
-			reduce(77), /* <=, reduce: PrimaryExpr */
-			reduce(77), /* >=, reduce: PrimaryExpr */
-			reduce(77), /* +, reduce: PrimaryExpr */
-			reduce(77), /* -, reduce: PrimaryExpr */
-			reduce(77), /* *, reduce: PrimaryExpr */
-			reduce(77), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(33), // ident, reduce: BasicType
+			nil,        // (
+			reduce(33), // ), reduce: BasicType
+			reduce(33), // ,, reduce: BasicType
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S143
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(246), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S144
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(75), /* ], reduce: PrimaryExpr */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(75), /* =, reduce: PrimaryExpr */
-			reduce(75), /* &&, reduce: PrimaryExpr */
-			reduce(75), /* ==, reduce: PrimaryExpr */
-			reduce(75), /* !=, reduce: PrimaryExpr */
-			reduce(75), /* <, reduce: PrimaryExpr */
-			reduce(75), /* >, reduce: PrimaryExpr */
-			reduce(75), /* <=, reduce: PrimaryExpr */
-			reduce(75), /* >=, reduce: PrimaryExpr */
-			reduce(75), /* +, reduce: PrimaryExpr */
-			reduce(75), /* -, reduce: PrimaryExpr */
-			reduce(75), /* *, reduce: PrimaryExpr */
-			reduce(75), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(40), // ), reduce: Param
+			reduce(40), // ,, reduce: Param
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S145
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(76), /* ], reduce: PrimaryExpr */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(76), /* =, reduce: PrimaryExpr */
-			reduce(76), /* &&, reduce: PrimaryExpr */
-			reduce(76), /* ==, reduce: PrimaryExpr */
-			reduce(76), /* !=, reduce: PrimaryExpr */
-			reduce(76), /* <, reduce: PrimaryExpr */
-			reduce(76), /* >, reduce: PrimaryExpr */
-			reduce(76), /* <=, reduce: PrimaryExpr */
-			reduce(76), /* >=, reduce: PrimaryExpr */
-			reduce(76), /* +, reduce: PrimaryExpr */
-			reduce(76), /* -, reduce: PrimaryExpr */
-			reduce(76), /* *, reduce: PrimaryExpr */
-			reduce(76), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(25), // ), reduce: VarDecl
+			reduce(25), // ,, reduce: VarDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S146
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(230), /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(26), // ), reduce: VarDecl
+			reduce(26), // ,, reduce: VarDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S147
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(50), /* ], reduce: Expr */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(39), // ), reduce: Param
+			reduce(39), // ,, reduce: Param
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S148
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(51), /* ], reduce: Expr2R */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			shift(231), /* = */
-			shift(232), /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(247), // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S149
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(53), /* ], reduce: Expr5L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(53), /* =, reduce: Expr5L */
-			reduce(53), /* &&, reduce: Expr5L */
-			shift(233), /* == */
-			shift(234), /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(36), // ), reduce: Params
+			shift(248), // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S150
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(55), /* ], reduce: Expr9L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(55), /* =, reduce: Expr9L */
-			reduce(55), /* &&, reduce: Expr9L */
-			reduce(55), /* ==, reduce: Expr9L */
-			reduce(55), /* !=, reduce: Expr9L */
-			shift(235), /* < */
-			shift(236), /* > */
-			shift(237), /* <= */
-			shift(238), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(37), // ), reduce: ParamList
+			reduce(37), // ,, reduce: ParamList
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S151
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(58), /* ], reduce: Expr10L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(58), /* =, reduce: Expr10L */
-			reduce(58), /* &&, reduce: Expr10L */
-			reduce(58), /* ==, reduce: Expr10L */
-			reduce(58), /* !=, reduce: Expr10L */
-			reduce(58), /* <, reduce: Expr10L */
-			reduce(58), /* >, reduce: Expr10L */
-			reduce(58), /* <=, reduce: Expr10L */
-			reduce(58), /* >=, reduce: Expr10L */
-			shift(239), /* + */
-			shift(240), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(249), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S152
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(63), /* ], reduce: Expr12L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(63), /* =, reduce: Expr12L */
-			reduce(63), /* &&, reduce: Expr12L */
-			reduce(63), /* ==, reduce: Expr12L */
-			reduce(63), /* !=, reduce: Expr12L */
-			reduce(63), /* <, reduce: Expr12L */
-			reduce(63), /* >, reduce: Expr12L */
-			reduce(63), /* <=, reduce: Expr12L */
-			reduce(63), /* >=, reduce: Expr12L */
-			reduce(63), /* +, reduce: Expr12L */
-			reduce(63), /* -, reduce: Expr12L */
-			shift(241), /* * */
-			shift(242), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(29), // ;, reduce: ArrayDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(29), // ,, reduce: ArrayDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S153
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(30), // ], reduce: IntLit
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S154
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(66), /* ], reduce: Expr13L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(66), /* =, reduce: Expr13L */
-			reduce(66), /* &&, reduce: Expr13L */
-			reduce(66), /* ==, reduce: Expr13L */
-			reduce(66), /* !=, reduce: Expr13L */
-			reduce(66), /* <, reduce: Expr13L */
-			reduce(66), /* >, reduce: Expr13L */
-			reduce(66), /* <=, reduce: Expr13L */
-			reduce(66), /* >=, reduce: Expr13L */
-			reduce(66), /* +, reduce: Expr13L */
-			reduce(66), /* -, reduce: Expr13L */
-			reduce(66), /* *, reduce: Expr13L */
-			reduce(66), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(31), // ], reduce: IntLit
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S155
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(69), /* ], reduce: Expr14 */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(69), /* =, reduce: Expr14 */
-			reduce(69), /* &&, reduce: Expr14 */
-			reduce(69), /* ==, reduce: Expr14 */
-			reduce(69), /* !=, reduce: Expr14 */
-			reduce(69), /* <, reduce: Expr14 */
-			reduce(69), /* >, reduce: Expr14 */
-			reduce(69), /* <=, reduce: Expr14 */
-			reduce(69), /* >=, reduce: Expr14 */
-			reduce(69), /* +, reduce: Expr14 */
-			reduce(69), /* -, reduce: Expr14 */
-			reduce(69), /* *, reduce: Expr14 */
-			reduce(69), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(250), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S156
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(24), // ;, reduce: VarDecls
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(24), // ,, reduce: VarDecls
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S157
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(72), /* ], reduce: Expr15 */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(72), /* =, reduce: Expr15 */
-			reduce(72), /* &&, reduce: Expr15 */
-			reduce(72), /* ==, reduce: Expr15 */
-			reduce(72), /* !=, reduce: Expr15 */
-			reduce(72), /* <, reduce: Expr15 */
-			reduce(72), /* >, reduce: Expr15 */
-			reduce(72), /* <=, reduce: Expr15 */
-			reduce(72), /* >=, reduce: Expr15 */
-			reduce(72), /* +, reduce: Expr15 */
-			reduce(72), /* -, reduce: Expr15 */
-			reduce(72), /* *, reduce: Expr15 */
-			reduce(72), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(9), // error, reduce: Decl
+			reduce(9), // }, reduce: Decl
+			reduce(9), // ;, reduce: Decl
+			reduce(9), // extern, reduce: Decl
+			reduce(9), // static, reduce: Decl
+			reduce(9), // ident, reduce: Decl
+			reduce(9), // (, reduce: Decl
+			nil,       // )
+			nil,       // ,
+			nil,       // [
+			nil,       // ]
+			reduce(9), // int_lit, reduce: Decl
+			reduce(9), // char_lit, reduce: Decl
+			reduce(9), // typedef, reduce: Decl
+			reduce(9), // const, reduce: Decl
+			reduce(9), // return, reduce: Decl
+			reduce(9), // {, reduce: Decl
+			reduce(9), // if, reduce: Decl
+			nil,       // else
+			reduce(9), // while, reduce: Decl
+			nil,       // =
+			nil,       // &&
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+			nil,       // +
+			reduce(9), // -, reduce: Decl
+			nil,       // *
+			nil,       // /
+			reduce(9), // !, reduce: Decl
+			reduce(9), // float_lit, reduce: Decl
+			nil,       // comment
 		},
 	},
 	actionRow{ // S158
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(78), /* ], reduce: PrimaryExpr */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(78), /* =, reduce: PrimaryExpr */
-			reduce(78), /* &&, reduce: PrimaryExpr */
-			reduce(78), /* ==, reduce: PrimaryExpr */
-			reduce(78), /* !=, reduce: PrimaryExpr */
-			reduce(78), /* <, reduce: PrimaryExpr */
-			reduce(78), /* >, reduce: PrimaryExpr */
-			reduce(78), /* <=, reduce: PrimaryExpr */
-			reduce(78), /* >=, reduce: PrimaryExpr */
-			reduce(78), /* +, reduce: PrimaryExpr */
-			reduce(78), /* -, reduce: PrimaryExpr */
-			reduce(78), /* *, reduce: PrimaryExpr */
-			reduce(78), /* /, reduce: PrimaryExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(11), // error, reduce: Decl
+			reduce(11), // }, reduce: Decl
+			reduce(11), // ;, reduce: Decl
+			reduce(11), // extern, reduce: Decl
+			reduce(11), // static, reduce: Decl
+			reduce(11), // ident, reduce: Decl
+			reduce(11), // (, reduce: Decl
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(11), // int_lit, reduce: Decl
+			reduce(11), // char_lit, reduce: Decl
+			reduce(11), // typedef, reduce: Decl
+			reduce(11), // const, reduce: Decl
+			reduce(11), // return, reduce: Decl
+			reduce(11), // {, reduce: Decl
+			reduce(11), // if, reduce: Decl
+			nil,        // else
+			reduce(11), // while, reduce: Decl
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(11), // -, reduce: Decl
+			nil,        // *
+			nil,        // /
+			reduce(11), // !, reduce: Decl
+			reduce(11), // float_lit, reduce: Decl
+			nil,        // comment
 		},
 	},
 	actionRow{ // S159
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			reduce(80), /* ), reduce: Args */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(251), // (
+			reduce(93), // ), reduce: PrimaryExpr
+			reduce(93), // ,, reduce: PrimaryExpr
+			shift(252), // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(93), // =, reduce: PrimaryExpr
+			reduce(93), // &&, reduce: PrimaryExpr
+			reduce(93), // ==, reduce: PrimaryExpr
+			reduce(93), // !=, reduce: PrimaryExpr
+			reduce(93), // <, reduce: PrimaryExpr
+			reduce(93), // >, reduce: PrimaryExpr
+			reduce(93), // <=, reduce: PrimaryExpr
+			reduce(93), // >=, reduce: PrimaryExpr
+			reduce(93), // +, reduce: PrimaryExpr
+			reduce(93), // -, reduce: PrimaryExpr
+			reduce(93), // *, reduce: PrimaryExpr
+			reduce(93), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S160
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S161
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(247), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(90), // ), reduce: PrimaryExpr
+			reduce(90), // ,, reduce: PrimaryExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(90), // =, reduce: PrimaryExpr
+			reduce(90), // &&, reduce: PrimaryExpr
+			reduce(90), // ==, reduce: PrimaryExpr
+			reduce(90), // !=, reduce: PrimaryExpr
+			reduce(90), // <, reduce: PrimaryExpr
+			reduce(90), // >, reduce: PrimaryExpr
+			reduce(90), // <=, reduce: PrimaryExpr
+			reduce(90), // >=, reduce: PrimaryExpr
+			reduce(90), // +, reduce: PrimaryExpr
+			reduce(90), // -, reduce: PrimaryExpr
+			reduce(90), // *, reduce: PrimaryExpr
+			reduce(90), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S162
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(79), /* ;, reduce: ParenExpr */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(79), /* =, reduce: ParenExpr */
-			reduce(79), /* &&, reduce: ParenExpr */
-			reduce(79), /* ==, reduce: ParenExpr */
-			reduce(79), /* !=, reduce: ParenExpr */
-			reduce(79), /* <, reduce: ParenExpr */
-			reduce(79), /* >, reduce: ParenExpr */
-			reduce(79), /* <=, reduce: ParenExpr */
-			reduce(79), /* >=, reduce: ParenExpr */
-			reduce(79), /* +, reduce: ParenExpr */
-			reduce(79), /* -, reduce: ParenExpr */
-			reduce(79), /* *, reduce: ParenExpr */
-			reduce(79), /* /, reduce: ParenExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(92), // ), reduce: PrimaryExpr
+			reduce(92), // ,, reduce: PrimaryExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(92), // =, reduce: PrimaryExpr
+			reduce(92), // &&, reduce: PrimaryExpr
+			reduce(92), // ==, reduce: PrimaryExpr
+			reduce(92), // !=, reduce: PrimaryExpr
+			reduce(92), // <, reduce: PrimaryExpr
+			reduce(92), // >, reduce: PrimaryExpr
+			reduce(92), // <=, reduce: PrimaryExpr
+			reduce(92), // >=, reduce: PrimaryExpr
+			reduce(92), // +, reduce: PrimaryExpr
+			reduce(92), // -, reduce: PrimaryExpr
+			reduce(92), // *, reduce: PrimaryExpr
+			reduce(92), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S163
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(98), // ), reduce: ExprList
+			reduce(98), // ,, reduce: ExprList
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S164
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(65), // ), reduce: Expr
+			reduce(65), // ,, reduce: Expr
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S165
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(66), // ), reduce: Expr2R
+			reduce(66), // ,, reduce: Expr2R
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			shift(254), // =
+			shift(255), // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S166
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(68), // ), reduce: Expr5L
+			reduce(68), // ,, reduce: Expr5L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(68), // =, reduce: Expr5L
+			reduce(68), // &&, reduce: Expr5L
+			shift(256), // ==
+			shift(257), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S167
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(70), // ), reduce: Expr9L
+			reduce(70), // ,, reduce: Expr9L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(70), // =, reduce: Expr9L
+			reduce(70), // &&, reduce: Expr9L
+			reduce(70), // ==, reduce: Expr9L
+			reduce(70), // !=, reduce: Expr9L
+			shift(258), // <
+			shift(259), // >
+			shift(260), // <=
+			shift(261), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S168
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(73), // ), reduce: Expr10L
+			reduce(73), // ,, reduce: Expr10L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(73), // =, reduce: Expr10L
+			reduce(73), // &&, reduce: Expr10L
+			reduce(73), // ==, reduce: Expr10L
+			reduce(73), // !=, reduce: Expr10L
+			reduce(73), // <, reduce: Expr10L
+			reduce(73), // >, reduce: Expr10L
+			reduce(73), // <=, reduce: Expr10L
+			reduce(73), // >=, reduce: Expr10L
+			shift(262), // +
+			shift(263), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S169
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(78), // ), reduce: Expr12L
+			reduce(78), // ,, reduce: Expr12L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(78), // =, reduce: Expr12L
+			reduce(78), // &&, reduce: Expr12L
+			reduce(78), // ==, reduce: Expr12L
+			reduce(78), // !=, reduce: Expr12L
+			reduce(78), // <, reduce: Expr12L
+			reduce(78), // >, reduce: Expr12L
+			reduce(78), // <=, reduce: Expr12L
+			reduce(78), // >=, reduce: Expr12L
+			reduce(78), // +, reduce: Expr12L
+			reduce(78), // -, reduce: Expr12L
+			shift(264), // *
+			shift(265), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S170
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S171
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(81), // ), reduce: Expr13L
+			reduce(81), // ,, reduce: Expr13L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(81), // =, reduce: Expr13L
+			reduce(81), // &&, reduce: Expr13L
+			reduce(81), // ==, reduce: Expr13L
+			reduce(81), // !=, reduce: Expr13L
+			reduce(81), // <, reduce: Expr13L
+			reduce(81), // >, reduce: Expr13L
+			reduce(81), // <=, reduce: Expr13L
+			reduce(81), // >=, reduce: Expr13L
+			reduce(81), // +, reduce: Expr13L
+			reduce(81), // -, reduce: Expr13L
+			reduce(81), // *, reduce: Expr13L
+			reduce(81), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S172
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(84), // ), reduce: Expr14
+			reduce(84), // ,, reduce: Expr14
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(84), // =, reduce: Expr14
+			reduce(84), // &&, reduce: Expr14
+			reduce(84), // ==, reduce: Expr14
+			reduce(84), // !=, reduce: Expr14
+			reduce(84), // <, reduce: Expr14
+			reduce(84), // >, reduce: Expr14
+			reduce(84), // <=, reduce: Expr14
+			reduce(84), // >=, reduce: Expr14
+			reduce(84), // +, reduce: Expr14
+			reduce(84), // -, reduce: Expr14
+			reduce(84), // *, reduce: Expr14
+			reduce(84), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S173
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S174
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			shift(69), /* ident */
-			shift(70), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(71), /* int_lit */
-			shift(72), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(80), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(83), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(87), // ), reduce: Expr15
+			reduce(87), // ,, reduce: Expr15
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(87), // =, reduce: Expr15
+			reduce(87), // &&, reduce: Expr15
+			reduce(87), // ==, reduce: Expr15
+			reduce(87), // !=, reduce: Expr15
+			reduce(87), // <, reduce: Expr15
+			reduce(87), // >, reduce: Expr15
+			reduce(87), // <=, reduce: Expr15
+			reduce(87), // >=, reduce: Expr15
+			reduce(87), // +, reduce: Expr15
+			reduce(87), // -, reduce: Expr15
+			reduce(87), // *, reduce: Expr15
+			reduce(87), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S175
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(70), /* ), reduce: Expr14 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(70), /* =, reduce: Expr14 */
-			reduce(70), /* &&, reduce: Expr14 */
-			reduce(70), /* ==, reduce: Expr14 */
-			reduce(70), /* !=, reduce: Expr14 */
-			reduce(70), /* <, reduce: Expr14 */
-			reduce(70), /* >, reduce: Expr14 */
-			reduce(70), /* <=, reduce: Expr14 */
-			reduce(70), /* >=, reduce: Expr14 */
-			reduce(70), /* +, reduce: Expr14 */
-			reduce(70), /* -, reduce: Expr14 */
-			reduce(70), /* *, reduce: Expr14 */
-			reduce(70), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(268), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S176
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(71), /* ), reduce: Expr14 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(71), /* =, reduce: Expr14 */
-			reduce(71), /* &&, reduce: Expr14 */
-			reduce(71), /* ==, reduce: Expr14 */
-			reduce(71), /* !=, reduce: Expr14 */
-			reduce(71), /* <, reduce: Expr14 */
-			reduce(71), /* >, reduce: Expr14 */
-			reduce(71), /* <=, reduce: Expr14 */
-			reduce(71), /* >=, reduce: Expr14 */
-			reduce(71), /* +, reduce: Expr14 */
-			reduce(71), /* -, reduce: Expr14 */
-			reduce(71), /* *, reduce: Expr14 */
-			reduce(71), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(91), // ), reduce: PrimaryExpr
+			reduce(91), // ,, reduce: PrimaryExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(91), // =, reduce: PrimaryExpr
+			reduce(91), // &&, reduce: PrimaryExpr
+			reduce(91), // ==, reduce: PrimaryExpr
+			reduce(91), // !=, reduce: PrimaryExpr
+			reduce(91), // <, reduce: PrimaryExpr
+			reduce(91), // >, reduce: PrimaryExpr
+			reduce(91), // <=, reduce: PrimaryExpr
+			reduce(91), // >=, reduce: PrimaryExpr
+			reduce(91), // +, reduce: PrimaryExpr
+			reduce(91), // -, reduce: PrimaryExpr
+			reduce(91), // *, reduce: PrimaryExpr
+			reduce(91), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S177
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(32), /* ;, reduce: OtherStmt */
-			reduce(32), /* ident, reduce: OtherStmt */
-			reduce(32), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(32), /* int_lit, reduce: OtherStmt */
-			reduce(32), /* char_lit, reduce: OtherStmt */
-			reduce(32), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(32), /* return, reduce: OtherStmt */
-			reduce(32), /* {, reduce: OtherStmt */
-			reduce(32), /* }, reduce: OtherStmt */
-			reduce(32), /* if, reduce: OtherStmt */
-			nil,        /* else */
-			reduce(32), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(32), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(32), /* !, reduce: OtherStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(94), // ), reduce: PrimaryExpr
+			reduce(94), // ,, reduce: PrimaryExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(94), // =, reduce: PrimaryExpr
+			reduce(94), // &&, reduce: PrimaryExpr
+			reduce(94), // ==, reduce: PrimaryExpr
+			reduce(94), // !=, reduce: PrimaryExpr
+			reduce(94), // <, reduce: PrimaryExpr
+			reduce(94), // >, reduce: PrimaryExpr
+			reduce(94), // <=, reduce: PrimaryExpr
+			reduce(94), // >=, reduce: PrimaryExpr
+			reduce(94), // +, reduce: PrimaryExpr
+			reduce(94), // -, reduce: PrimaryExpr
+			reduce(94), // *, reduce: PrimaryExpr
+			reduce(94), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S178
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(36), /* ;, reduce: BlockStmt */
-			reduce(36), /* ident, reduce: BlockStmt */
-			reduce(36), /* (, reduce: BlockStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(36), /* int_lit, reduce: BlockStmt */
-			reduce(36), /* char_lit, reduce: BlockStmt */
-			reduce(36), /* typedef, reduce: BlockStmt */
-			nil,        /* , */
-			reduce(36), /* return, reduce: BlockStmt */
-			reduce(36), /* {, reduce: BlockStmt */
-			reduce(36), /* }, reduce: BlockStmt */
-			reduce(36), /* if, reduce: BlockStmt */
-			nil,        /* else */
-			reduce(36), /* while, reduce: BlockStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(36), /* -, reduce: BlockStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(36), /* !, reduce: BlockStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(97), // ), reduce: Args
+			shift(269), // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S179
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(260), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(270), // (
+			nil,        // )
+			nil,        // ,
+			shift(271), // [
+			reduce(93), // ], reduce: PrimaryExpr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(93), // =, reduce: PrimaryExpr
+			reduce(93), // &&, reduce: PrimaryExpr
+			reduce(93), // ==, reduce: PrimaryExpr
+			reduce(93), // !=, reduce: PrimaryExpr
+			reduce(93), // <, reduce: PrimaryExpr
+			reduce(93), // >, reduce: PrimaryExpr
+			reduce(93), // <=, reduce: PrimaryExpr
+			reduce(93), // >=, reduce: PrimaryExpr
+			reduce(93), // +, reduce: PrimaryExpr
+			reduce(93), // -, reduce: PrimaryExpr
+			reduce(93), // *, reduce: PrimaryExpr
+			reduce(93), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S180
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(35), /* ;, reduce: OtherStmt */
-			reduce(35), /* ident, reduce: OtherStmt */
-			reduce(35), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(35), /* int_lit, reduce: OtherStmt */
-			reduce(35), /* char_lit, reduce: OtherStmt */
-			reduce(35), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(35), /* return, reduce: OtherStmt */
-			reduce(35), /* {, reduce: OtherStmt */
-			reduce(35), /* }, reduce: OtherStmt */
-			reduce(35), /* if, reduce: OtherStmt */
-			reduce(35), /* else, reduce: OtherStmt */
-			reduce(35), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(35), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(35), /* !, reduce: OtherStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S181
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(34), /* ;, reduce: OtherStmt */
-			reduce(34), /* ident, reduce: OtherStmt */
-			reduce(34), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(34), /* int_lit, reduce: OtherStmt */
-			reduce(34), /* char_lit, reduce: OtherStmt */
-			reduce(34), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(34), /* return, reduce: OtherStmt */
-			reduce(34), /* {, reduce: OtherStmt */
-			reduce(34), /* }, reduce: OtherStmt */
-			reduce(34), /* if, reduce: OtherStmt */
-			reduce(34), /* else, reduce: OtherStmt */
-			reduce(34), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(34), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(34), /* !, reduce: OtherStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(90), // ], reduce: PrimaryExpr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(90), // =, reduce: PrimaryExpr
+			reduce(90), // &&, reduce: PrimaryExpr
+			reduce(90), // ==, reduce: PrimaryExpr
+			reduce(90), // !=, reduce: PrimaryExpr
+			reduce(90), // <, reduce: PrimaryExpr
+			reduce(90), // >, reduce: PrimaryExpr
+			reduce(90), // <=, reduce: PrimaryExpr
+			reduce(90), // >=, reduce: PrimaryExpr
+			reduce(90), // +, reduce: PrimaryExpr
+			reduce(90), // -, reduce: PrimaryExpr
+			reduce(90), // *, reduce: PrimaryExpr
+			reduce(90), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S182
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(40), /* ;, reduce: OpenStmt */
-			reduce(40), /* ident, reduce: OpenStmt */
-			reduce(40), /* (, reduce: OpenStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(40), /* int_lit, reduce: OpenStmt */
-			reduce(40), /* char_lit, reduce: OpenStmt */
-			reduce(40), /* typedef, reduce: OpenStmt */
-			nil,        /* , */
-			reduce(40), /* return, reduce: OpenStmt */
-			reduce(40), /* {, reduce: OpenStmt */
-			reduce(40), /* }, reduce: OpenStmt */
-			reduce(40), /* if, reduce: OpenStmt */
-			nil,        /* else */
-			reduce(40), /* while, reduce: OpenStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(40), /* -, reduce: OpenStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(40), /* !, reduce: OpenStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(92), // ], reduce: PrimaryExpr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(92), // =, reduce: PrimaryExpr
+			reduce(92), // &&, reduce: PrimaryExpr
+			reduce(92), // ==, reduce: PrimaryExpr
+			reduce(92), // !=, reduce: PrimaryExpr
+			reduce(92), // <, reduce: PrimaryExpr
+			reduce(92), // >, reduce: PrimaryExpr
+			reduce(92), // <=, reduce: PrimaryExpr
+			reduce(92), // >=, reduce: PrimaryExpr
+			reduce(92), // +, reduce: PrimaryExpr
+			reduce(92), // -, reduce: PrimaryExpr
+			reduce(92), // *, reduce: PrimaryExpr
+			reduce(92), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S183
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(29), /* ;, reduce: Stmt */
-			reduce(29), /* ident, reduce: Stmt */
-			reduce(29), /* (, reduce: Stmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(29), /* int_lit, reduce: Stmt */
-			reduce(29), /* char_lit, reduce: Stmt */
-			reduce(29), /* typedef, reduce: Stmt */
-			nil,        /* , */
-			reduce(29), /* return, reduce: Stmt */
-			reduce(29), /* {, reduce: Stmt */
-			reduce(29), /* }, reduce: Stmt */
-			reduce(29), /* if, reduce: Stmt */
-			shift(261), /* else */
-			reduce(29), /* while, reduce: Stmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(29), /* -, reduce: Stmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(29), /* !, reduce: Stmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(273), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S184
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(39), /* ;, reduce: MatchedStmt */
-			reduce(39), /* ident, reduce: MatchedStmt */
-			reduce(39), /* (, reduce: MatchedStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(39), /* int_lit, reduce: MatchedStmt */
-			reduce(39), /* char_lit, reduce: MatchedStmt */
-			reduce(39), /* typedef, reduce: MatchedStmt */
-			nil,        /* , */
-			reduce(39), /* return, reduce: MatchedStmt */
-			reduce(39), /* {, reduce: MatchedStmt */
-			reduce(39), /* }, reduce: MatchedStmt */
-			reduce(39), /* if, reduce: MatchedStmt */
-			reduce(39), /* else, reduce: MatchedStmt */
-			reduce(39), /* while, reduce: MatchedStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(39), /* -, reduce: MatchedStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(39), /* !, reduce: MatchedStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(65), // ], reduce: Expr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S185
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(262), /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(66), // ], reduce: Expr2R
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			shift(274), // =
+			shift(275), // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S186
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(263), /* ; */
-			shift(88),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(68), // ], reduce: Expr5L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(68), // =, reduce: Expr5L
+			reduce(68), // &&, reduce: Expr5L
+			shift(276), // ==
+			shift(277), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S187
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(26),  /* ; */
-			shift(31),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			shift(14),  /* typedef */
-			nil,        /* , */
-			shift(41),  /* return */
-			shift(42),  /* { */
-			reduce(44), /* }, reduce: BlockItems */
-			shift(44),  /* if */
-			nil,        /* else */
-			shift(45),  /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(70), // ], reduce: Expr9L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(70), // =, reduce: Expr9L
+			reduce(70), // &&, reduce: Expr9L
+			reduce(70), // ==, reduce: Expr9L
+			reduce(70), // !=, reduce: Expr9L
+			shift(278), // <
+			shift(279), // >
+			shift(280), // <=
+			shift(281), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S188
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			nil,       /* ident */
-			shift(92), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(73), // ], reduce: Expr10L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(73), // =, reduce: Expr10L
+			reduce(73), // &&, reduce: Expr10L
+			reduce(73), // ==, reduce: Expr10L
+			reduce(73), // !=, reduce: Expr10L
+			reduce(73), // <, reduce: Expr10L
+			reduce(73), // >, reduce: Expr10L
+			reduce(73), // <=, reduce: Expr10L
+			reduce(73), // >=, reduce: Expr10L
+			shift(282), // +
+			shift(283), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S189
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			nil,       /* ; */
-			nil,       /* ident */
-			shift(92), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			nil,       /* int_lit */
-			nil,       /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			nil,       /* return */
-			nil,       /* { */
-			nil,       /* } */
-			nil,       /* if */
-			nil,       /* else */
-			nil,       /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			nil,       /* - */
-			nil,       /* * */
-			nil,       /* / */
-			nil,       /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(78), // ], reduce: Expr12L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(78), // =, reduce: Expr12L
+			reduce(78), // &&, reduce: Expr12L
+			reduce(78), // ==, reduce: Expr12L
+			reduce(78), // !=, reduce: Expr12L
+			reduce(78), // <, reduce: Expr12L
+			reduce(78), // >, reduce: Expr12L
+			reduce(78), // <=, reduce: Expr12L
+			reduce(78), // >=, reduce: Expr12L
+			reduce(78), // +, reduce: Expr12L
+			reduce(78), // -, reduce: Expr12L
+			shift(284), // *
+			shift(285), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S190
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(38), /* ;, reduce: MatchedStmt */
-			reduce(38), /* ident, reduce: MatchedStmt */
-			reduce(38), /* (, reduce: MatchedStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(38), /* int_lit, reduce: MatchedStmt */
-			reduce(38), /* char_lit, reduce: MatchedStmt */
-			reduce(38), /* typedef, reduce: MatchedStmt */
-			nil,        /* , */
-			reduce(38), /* return, reduce: MatchedStmt */
-			reduce(38), /* {, reduce: MatchedStmt */
-			reduce(38), /* }, reduce: MatchedStmt */
-			reduce(38), /* if, reduce: MatchedStmt */
-			nil,        /* else */
-			reduce(38), /* while, reduce: MatchedStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(38), /* -, reduce: MatchedStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(38), /* !, reduce: MatchedStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S191
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(42), /* ;, reduce: OpenStmt */
-			reduce(42), /* ident, reduce: OpenStmt */
-			reduce(42), /* (, reduce: OpenStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(42), /* int_lit, reduce: OpenStmt */
-			reduce(42), /* char_lit, reduce: OpenStmt */
-			reduce(42), /* typedef, reduce: OpenStmt */
-			nil,        /* , */
-			reduce(42), /* return, reduce: OpenStmt */
-			reduce(42), /* {, reduce: OpenStmt */
-			reduce(42), /* }, reduce: OpenStmt */
-			reduce(42), /* if, reduce: OpenStmt */
-			nil,        /* else */
-			reduce(42), /* while, reduce: OpenStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(42), /* -, reduce: OpenStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(42), /* !, reduce: OpenStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(81), // ], reduce: Expr13L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(81), // =, reduce: Expr13L
+			reduce(81), // &&, reduce: Expr13L
+			reduce(81), // ==, reduce: Expr13L
+			reduce(81), // !=, reduce: Expr13L
+			reduce(81), // <, reduce: Expr13L
+			reduce(81), // >, reduce: Expr13L
+			reduce(81), // <=, reduce: Expr13L
+			reduce(81), // >=, reduce: Expr13L
+			reduce(81), // +, reduce: Expr13L
+			reduce(81), // -, reduce: Expr13L
+			reduce(81), // *, reduce: Expr13L
+			reduce(81), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S192
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(52), /* ;, reduce: Expr2R */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(84), // ], reduce: Expr14
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(84), // =, reduce: Expr14
+			reduce(84), // &&, reduce: Expr14
+			reduce(84), // ==, reduce: Expr14
+			reduce(84), // !=, reduce: Expr14
+			reduce(84), // <, reduce: Expr14
+			reduce(84), // >, reduce: Expr14
+			reduce(84), // <=, reduce: Expr14
+			reduce(84), // >=, reduce: Expr14
+			reduce(84), // +, reduce: Expr14
+			reduce(84), // -, reduce: Expr14
+			reduce(84), // *, reduce: Expr14
+			reduce(84), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S193
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(54), /* ;, reduce: Expr5L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(54), /* =, reduce: Expr5L */
-			reduce(54), /* &&, reduce: Expr5L */
-			shift(98),  /* == */
-			shift(99),  /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S194
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(56), /* ;, reduce: Expr9L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(56), /* =, reduce: Expr9L */
-			reduce(56), /* &&, reduce: Expr9L */
-			reduce(56), /* ==, reduce: Expr9L */
-			reduce(56), /* !=, reduce: Expr9L */
-			shift(100), /* < */
-			shift(101), /* > */
-			shift(102), /* <= */
-			shift(103), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(87), // ], reduce: Expr15
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(87), // =, reduce: Expr15
+			reduce(87), // &&, reduce: Expr15
+			reduce(87), // ==, reduce: Expr15
+			reduce(87), // !=, reduce: Expr15
+			reduce(87), // <, reduce: Expr15
+			reduce(87), // >, reduce: Expr15
+			reduce(87), // <=, reduce: Expr15
+			reduce(87), // >=, reduce: Expr15
+			reduce(87), // +, reduce: Expr15
+			reduce(87), // -, reduce: Expr15
+			reduce(87), // *, reduce: Expr15
+			reduce(87), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S195
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(57), /* ;, reduce: Expr9L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(57), /* =, reduce: Expr9L */
-			reduce(57), /* &&, reduce: Expr9L */
-			reduce(57), /* ==, reduce: Expr9L */
-			reduce(57), /* !=, reduce: Expr9L */
-			shift(100), /* < */
-			shift(101), /* > */
-			shift(102), /* <= */
-			shift(103), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(91), // ], reduce: PrimaryExpr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(91), // =, reduce: PrimaryExpr
+			reduce(91), // &&, reduce: PrimaryExpr
+			reduce(91), // ==, reduce: PrimaryExpr
+			reduce(91), // !=, reduce: PrimaryExpr
+			reduce(91), // <, reduce: PrimaryExpr
+			reduce(91), // >, reduce: PrimaryExpr
+			reduce(91), // <=, reduce: PrimaryExpr
+			reduce(91), // >=, reduce: PrimaryExpr
+			reduce(91), // +, reduce: PrimaryExpr
+			reduce(91), // -, reduce: PrimaryExpr
+			reduce(91), // *, reduce: PrimaryExpr
+			reduce(91), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S196
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(59), /* ;, reduce: Expr10L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(59), /* =, reduce: Expr10L */
-			reduce(59), /* &&, reduce: Expr10L */
-			reduce(59), /* ==, reduce: Expr10L */
-			reduce(59), /* !=, reduce: Expr10L */
-			reduce(59), /* <, reduce: Expr10L */
-			reduce(59), /* >, reduce: Expr10L */
-			reduce(59), /* <=, reduce: Expr10L */
-			reduce(59), /* >=, reduce: Expr10L */
-			shift(104), /* + */
-			shift(105), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(94), // ], reduce: PrimaryExpr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(94), // =, reduce: PrimaryExpr
+			reduce(94), // &&, reduce: PrimaryExpr
+			reduce(94), // ==, reduce: PrimaryExpr
+			reduce(94), // !=, reduce: PrimaryExpr
+			reduce(94), // <, reduce: PrimaryExpr
+			reduce(94), // >, reduce: PrimaryExpr
+			reduce(94), // <=, reduce: PrimaryExpr
+			reduce(94), // >=, reduce: PrimaryExpr
+			reduce(94), // +, reduce: PrimaryExpr
+			reduce(94), // -, reduce: PrimaryExpr
+			reduce(94), // *, reduce: PrimaryExpr
+			reduce(94), // /, reduce: PrimaryExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S197
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(60), /* ;, reduce: Expr10L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(60), /* =, reduce: Expr10L */
-			reduce(60), /* &&, reduce: Expr10L */
-			reduce(60), /* ==, reduce: Expr10L */
-			reduce(60), /* !=, reduce: Expr10L */
-			reduce(60), /* <, reduce: Expr10L */
-			reduce(60), /* >, reduce: Expr10L */
-			reduce(60), /* <=, reduce: Expr10L */
-			reduce(60), /* >=, reduce: Expr10L */
-			shift(104), /* + */
-			shift(105), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			reduce(96), // ), reduce: Args
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S198
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(61), /* ;, reduce: Expr10L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(61), /* =, reduce: Expr10L */
-			reduce(61), /* &&, reduce: Expr10L */
-			reduce(61), /* ==, reduce: Expr10L */
-			reduce(61), /* !=, reduce: Expr10L */
-			reduce(61), /* <, reduce: Expr10L */
-			reduce(61), /* >, reduce: Expr10L */
-			reduce(61), /* <=, reduce: Expr10L */
-			reduce(61), /* >=, reduce: Expr10L */
-			shift(104), /* + */
-			shift(105), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S199
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(62), /* ;, reduce: Expr10L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(62), /* =, reduce: Expr10L */
-			reduce(62), /* &&, reduce: Expr10L */
-			reduce(62), /* ==, reduce: Expr10L */
-			reduce(62), /* !=, reduce: Expr10L */
-			reduce(62), /* <, reduce: Expr10L */
-			reduce(62), /* >, reduce: Expr10L */
-			reduce(62), /* <=, reduce: Expr10L */
-			reduce(62), /* >=, reduce: Expr10L */
-			shift(104), /* + */
-			shift(105), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(290), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S200
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(64), /* ;, reduce: Expr12L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(64), /* =, reduce: Expr12L */
-			reduce(64), /* &&, reduce: Expr12L */
-			reduce(64), /* ==, reduce: Expr12L */
-			reduce(64), /* !=, reduce: Expr12L */
-			reduce(64), /* <, reduce: Expr12L */
-			reduce(64), /* >, reduce: Expr12L */
-			reduce(64), /* <=, reduce: Expr12L */
-			reduce(64), /* >=, reduce: Expr12L */
-			reduce(64), /* +, reduce: Expr12L */
-			reduce(64), /* -, reduce: Expr12L */
-			shift(106), /* * */
-			shift(107), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(95), // ;, reduce: ParenExpr
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(95), // =, reduce: ParenExpr
+			reduce(95), // &&, reduce: ParenExpr
+			reduce(95), // ==, reduce: ParenExpr
+			reduce(95), // !=, reduce: ParenExpr
+			reduce(95), // <, reduce: ParenExpr
+			reduce(95), // >, reduce: ParenExpr
+			reduce(95), // <=, reduce: ParenExpr
+			reduce(95), // >=, reduce: ParenExpr
+			reduce(95), // +, reduce: ParenExpr
+			reduce(95), // -, reduce: ParenExpr
+			reduce(95), // *, reduce: ParenExpr
+			reduce(95), // /, reduce: ParenExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S201
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(65), /* ;, reduce: Expr12L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(65), /* =, reduce: Expr12L */
-			reduce(65), /* &&, reduce: Expr12L */
-			reduce(65), /* ==, reduce: Expr12L */
-			reduce(65), /* !=, reduce: Expr12L */
-			reduce(65), /* <, reduce: Expr12L */
-			reduce(65), /* >, reduce: Expr12L */
-			reduce(65), /* <=, reduce: Expr12L */
-			reduce(65), /* >=, reduce: Expr12L */
-			reduce(65), /* +, reduce: Expr12L */
-			reduce(65), /* -, reduce: Expr12L */
-			shift(106), /* * */
-			shift(107), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S202
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(67), /* ;, reduce: Expr13L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(67), /* =, reduce: Expr13L */
-			reduce(67), /* &&, reduce: Expr13L */
-			reduce(67), /* ==, reduce: Expr13L */
-			reduce(67), /* !=, reduce: Expr13L */
-			reduce(67), /* <, reduce: Expr13L */
-			reduce(67), /* >, reduce: Expr13L */
-			reduce(67), /* <=, reduce: Expr13L */
-			reduce(67), /* >=, reduce: Expr13L */
-			reduce(67), /* +, reduce: Expr13L */
-			reduce(67), /* -, reduce: Expr13L */
-			reduce(67), /* *, reduce: Expr13L */
-			reduce(67), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S203
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(68), /* ;, reduce: Expr13L */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(68), /* =, reduce: Expr13L */
-			reduce(68), /* &&, reduce: Expr13L */
-			reduce(68), /* ==, reduce: Expr13L */
-			reduce(68), /* !=, reduce: Expr13L */
-			reduce(68), /* <, reduce: Expr13L */
-			reduce(68), /* >, reduce: Expr13L */
-			reduce(68), /* <=, reduce: Expr13L */
-			reduce(68), /* >=, reduce: Expr13L */
-			reduce(68), /* +, reduce: Expr13L */
-			reduce(68), /* -, reduce: Expr13L */
-			reduce(68), /* *, reduce: Expr13L */
-			reduce(68), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S204
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(15), /* ), reduce: ScalarDecl */
-			shift(268), /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(15), /* ,, reduce: ScalarDecl */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S205
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(11), /* ;, reduce: FuncHeader */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			reduce(11), /* {, reduce: FuncHeader */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S206
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(112), /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S207
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(16), /* ;, reduce: ArrayDecl */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S208
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			reduce(80), /* ), reduce: Args */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S209
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S210
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(272), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S211
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S212
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(96),  // ident
+			shift(97),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(98),  // int_lit
+			shift(99),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(107), // -
+			nil,        // *
+			nil,        // /
+			shift(110), // !
+			shift(112), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S213
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(85), // ), reduce: Expr14
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(85), // =, reduce: Expr14
+			reduce(85), // &&, reduce: Expr14
+			reduce(85), // ==, reduce: Expr14
+			reduce(85), // !=, reduce: Expr14
+			reduce(85), // <, reduce: Expr14
+			reduce(85), // >, reduce: Expr14
+			reduce(85), // <=, reduce: Expr14
+			reduce(85), // >=, reduce: Expr14
+			reduce(85), // +, reduce: Expr14
+			reduce(85), // -, reduce: Expr14
+			reduce(85), // *, reduce: Expr14
+			reduce(85), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S214
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(86), // ), reduce: Expr14
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(86), // =, reduce: Expr14
+			reduce(86), // &&, reduce: Expr14
+			reduce(86), // ==, reduce: Expr14
+			reduce(86), // !=, reduce: Expr14
+			reduce(86), // <, reduce: Expr14
+			reduce(86), // >, reduce: Expr14
+			reduce(86), // <=, reduce: Expr14
+			reduce(86), // >=, reduce: Expr14
+			reduce(86), // +, reduce: Expr14
+			reduce(86), // -, reduce: Expr14
+			reduce(86), // *, reduce: Expr14
+			reduce(86), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S215
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // error, reduce: OtherStmt
+			reduce(45), // }, reduce: OtherStmt
+			reduce(45), // ;, reduce: OtherStmt
+			reduce(45), // extern, reduce: OtherStmt
+			reduce(45), // static, reduce: OtherStmt
+			reduce(45), // ident, reduce: OtherStmt
+			reduce(45), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(45), // int_lit, reduce: OtherStmt
+			reduce(45), // char_lit, reduce: OtherStmt
+			reduce(45), // typedef, reduce: OtherStmt
+			reduce(45), // const, reduce: OtherStmt
+			reduce(45), // return, reduce: OtherStmt
+			reduce(45), // {, reduce: OtherStmt
+			reduce(45), // if, reduce: OtherStmt
+			nil,        // else
+			reduce(45), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(45), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(45), // !, reduce: OtherStmt
+			reduce(45), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S216
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(50), // error, reduce: BlockStmt
+			reduce(50), // }, reduce: BlockStmt
+			reduce(50), // ;, reduce: BlockStmt
+			reduce(50), // extern, reduce: BlockStmt
+			reduce(50), // static, reduce: BlockStmt
+			reduce(50), // ident, reduce: BlockStmt
+			reduce(50), // (, reduce: BlockStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(50), // int_lit, reduce: BlockStmt
+			reduce(50), // char_lit, reduce: BlockStmt
+			reduce(50), // typedef, reduce: BlockStmt
+			reduce(50), // const, reduce: BlockStmt
+			reduce(50), // return, reduce: BlockStmt
+			reduce(50), // {, reduce: BlockStmt
+			reduce(50), // if, reduce: BlockStmt
+			nil,        // else
+			reduce(50), // while, reduce: BlockStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(50), // -, reduce: BlockStmt
+			nil,        // *
+			nil,        // /
+			reduce(50), // !, reduce: BlockStmt
+			reduce(50), // float_lit, reduce: BlockStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S217
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(49), // error, reduce: BlockStmt
+			reduce(49), // }, reduce: BlockStmt
+			reduce(49), // ;, reduce: BlockStmt
+			reduce(49), // extern, reduce: BlockStmt
+			reduce(49), // static, reduce: BlockStmt
+			reduce(49), // ident, reduce: BlockStmt
+			reduce(49), // (, reduce: BlockStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(49), // int_lit, reduce: BlockStmt
+			reduce(49), // char_lit, reduce: BlockStmt
+			reduce(49), // typedef, reduce: BlockStmt
+			reduce(49), // const, reduce: BlockStmt
+			reduce(49), // return, reduce: BlockStmt
+			reduce(49), // {, reduce: BlockStmt
+			reduce(49), // if, reduce: BlockStmt
+			nil,        // else
+			reduce(49), // while, reduce: BlockStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(49), // -, reduce: BlockStmt
+			nil,        // *
+			nil,        // /
+			reduce(49), // !, reduce: BlockStmt
+			reduce(49), // float_lit, reduce: BlockStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S218
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(303), // }
+			shift(86),  // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S219
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			reduce(51), // ␚, reduce: BlockStmt
+			nil,        // empty
+			reduce(51), // error, reduce: BlockStmt
+			nil,        // }
+			nil,        // ;
+			reduce(51), // extern, reduce: BlockStmt
+			reduce(51), // static, reduce: BlockStmt
+			reduce(51), // ident, reduce: BlockStmt
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			reduce(51), // typedef, reduce: BlockStmt
+			reduce(51), // const, reduce: BlockStmt
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S220
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(304), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S221
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // error, reduce: OtherStmt
+			reduce(48), // }, reduce: OtherStmt
+			reduce(48), // ;, reduce: OtherStmt
+			reduce(48), // extern, reduce: OtherStmt
+			reduce(48), // static, reduce: OtherStmt
+			reduce(48), // ident, reduce: OtherStmt
+			reduce(48), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(48), // int_lit, reduce: OtherStmt
+			reduce(48), // char_lit, reduce: OtherStmt
+			reduce(48), // typedef, reduce: OtherStmt
+			reduce(48), // const, reduce: OtherStmt
+			reduce(48), // return, reduce: OtherStmt
+			reduce(48), // {, reduce: OtherStmt
+			reduce(48), // if, reduce: OtherStmt
+			reduce(48), // else, reduce: OtherStmt
+			reduce(48), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(48), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(48), // !, reduce: OtherStmt
+			reduce(48), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S222
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(47), // error, reduce: OtherStmt
+			reduce(47), // }, reduce: OtherStmt
+			reduce(47), // ;, reduce: OtherStmt
+			reduce(47), // extern, reduce: OtherStmt
+			reduce(47), // static, reduce: OtherStmt
+			reduce(47), // ident, reduce: OtherStmt
+			reduce(47), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(47), // int_lit, reduce: OtherStmt
+			reduce(47), // char_lit, reduce: OtherStmt
+			reduce(47), // typedef, reduce: OtherStmt
+			reduce(47), // const, reduce: OtherStmt
+			reduce(47), // return, reduce: OtherStmt
+			reduce(47), // {, reduce: OtherStmt
+			reduce(47), // if, reduce: OtherStmt
+			reduce(47), // else, reduce: OtherStmt
+			reduce(47), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(47), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(47), // !, reduce: OtherStmt
+			reduce(47), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S223
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(70), /* ), reduce: Expr14 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(70), /* ,, reduce: Expr14 */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(70), /* =, reduce: Expr14 */
-			reduce(70), /* &&, reduce: Expr14 */
-			reduce(70), /* ==, reduce: Expr14 */
-			reduce(70), /* !=, reduce: Expr14 */
-			reduce(70), /* <, reduce: Expr14 */
-			reduce(70), /* >, reduce: Expr14 */
-			reduce(70), /* <=, reduce: Expr14 */
-			reduce(70), /* >=, reduce: Expr14 */
-			reduce(70), /* +, reduce: Expr14 */
-			reduce(70), /* -, reduce: Expr14 */
-			reduce(70), /* *, reduce: Expr14 */
-			reduce(70), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(55), // error, reduce: OpenStmt
+			reduce(55), // }, reduce: OpenStmt
+			reduce(55), // ;, reduce: OpenStmt
+			reduce(55), // extern, reduce: OpenStmt
+			reduce(55), // static, reduce: OpenStmt
+			reduce(55), // ident, reduce: OpenStmt
+			reduce(55), // (, reduce: OpenStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(55), // int_lit, reduce: OpenStmt
+			reduce(55), // char_lit, reduce: OpenStmt
+			reduce(55), // typedef, reduce: OpenStmt
+			reduce(55), // const, reduce: OpenStmt
+			reduce(55), // return, reduce: OpenStmt
+			reduce(55), // {, reduce: OpenStmt
+			reduce(55), // if, reduce: OpenStmt
+			nil,        // else
+			reduce(55), // while, reduce: OpenStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(55), // -, reduce: OpenStmt
+			nil,        // *
+			nil,        // /
+			reduce(55), // !, reduce: OpenStmt
+			reduce(55), // float_lit, reduce: OpenStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S224
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(71), /* ), reduce: Expr14 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(71), /* ,, reduce: Expr14 */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(71), /* =, reduce: Expr14 */
-			reduce(71), /* &&, reduce: Expr14 */
-			reduce(71), /* ==, reduce: Expr14 */
-			reduce(71), /* !=, reduce: Expr14 */
-			reduce(71), /* <, reduce: Expr14 */
-			reduce(71), /* >, reduce: Expr14 */
-			reduce(71), /* <=, reduce: Expr14 */
-			reduce(71), /* >=, reduce: Expr14 */
-			reduce(71), /* +, reduce: Expr14 */
-			reduce(71), /* -, reduce: Expr14 */
-			reduce(71), /* *, reduce: Expr14 */
-			reduce(71), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // error, reduce: Stmt
+			reduce(42), // }, reduce: Stmt
+			reduce(42), // ;, reduce: Stmt
+			reduce(42), // extern, reduce: Stmt
+			reduce(42), // static, reduce: Stmt
+			reduce(42), // ident, reduce: Stmt
+			reduce(42), // (, reduce: Stmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(42), // int_lit, reduce: Stmt
+			reduce(42), // char_lit, reduce: Stmt
+			reduce(42), // typedef, reduce: Stmt
+			reduce(42), // const, reduce: Stmt
+			reduce(42), // return, reduce: Stmt
+			reduce(42), // {, reduce: Stmt
+			reduce(42), // if, reduce: Stmt
+			shift(305), // else
+			reduce(42), // while, reduce: Stmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(42), // -, reduce: Stmt
+			nil,        // *
+			nil,        // /
+			reduce(42), // !, reduce: Stmt
+			reduce(42), // float_lit, reduce: Stmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S225
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(74), /* ;, reduce: Expr15 */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(74), /* =, reduce: Expr15 */
-			reduce(74), /* &&, reduce: Expr15 */
-			reduce(74), /* ==, reduce: Expr15 */
-			reduce(74), /* !=, reduce: Expr15 */
-			reduce(74), /* <, reduce: Expr15 */
-			reduce(74), /* >, reduce: Expr15 */
-			reduce(74), /* <=, reduce: Expr15 */
-			reduce(74), /* >=, reduce: Expr15 */
-			reduce(74), /* +, reduce: Expr15 */
-			reduce(74), /* -, reduce: Expr15 */
-			reduce(74), /* *, reduce: Expr15 */
-			reduce(74), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(54), // error, reduce: MatchedStmt
+			reduce(54), // }, reduce: MatchedStmt
+			reduce(54), // ;, reduce: MatchedStmt
+			reduce(54), // extern, reduce: MatchedStmt
+			reduce(54), // static, reduce: MatchedStmt
+			reduce(54), // ident, reduce: MatchedStmt
+			reduce(54), // (, reduce: MatchedStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(54), // int_lit, reduce: MatchedStmt
+			reduce(54), // char_lit, reduce: MatchedStmt
+			reduce(54), // typedef, reduce: MatchedStmt
+			reduce(54), // const, reduce: MatchedStmt
+			reduce(54), // return, reduce: MatchedStmt
+			reduce(54), // {, reduce: MatchedStmt
+			reduce(54), // if, reduce: MatchedStmt
+			reduce(54), // else, reduce: MatchedStmt
+			reduce(54), // while, reduce: MatchedStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(54), // -, reduce: MatchedStmt
+			nil,        // *
+			nil,        // /
+			reduce(54), // !, reduce: MatchedStmt
+			reduce(54), // float_lit, reduce: MatchedStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S226
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(306), // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S227
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(123), /* ident */
-			shift(124), /* ( */
-			reduce(80), /* ), reduce: Args */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(125), /* int_lit */
-			shift(126), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(134), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(137), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(307), // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S228
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(309), // error
+			reduce(59), // }, reduce: BlockItems
+			shift(45),  // ;
+			shift(11),  // extern
+			shift(12),  // static
+			shift(51),  // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			shift(19),  // typedef
+			shift(20),  // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S229
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(288), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(124), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S230
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(73), /* ;, reduce: Expr15 */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(73), /* =, reduce: Expr15 */
-			reduce(73), /* &&, reduce: Expr15 */
-			reduce(73), /* ==, reduce: Expr15 */
-			reduce(73), /* !=, reduce: Expr15 */
-			reduce(73), /* <, reduce: Expr15 */
-			reduce(73), /* >, reduce: Expr15 */
-			reduce(73), /* <=, reduce: Expr15 */
-			reduce(73), /* >=, reduce: Expr15 */
-			reduce(73), /* +, reduce: Expr15 */
-			reduce(73), /* -, reduce: Expr15 */
-			reduce(73), /* *, reduce: Expr15 */
-			reduce(73), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			shift(124), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S231
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(53), // error, reduce: MatchedStmt
+			reduce(53), // }, reduce: MatchedStmt
+			reduce(53), // ;, reduce: MatchedStmt
+			reduce(53), // extern, reduce: MatchedStmt
+			reduce(53), // static, reduce: MatchedStmt
+			reduce(53), // ident, reduce: MatchedStmt
+			reduce(53), // (, reduce: MatchedStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(53), // int_lit, reduce: MatchedStmt
+			reduce(53), // char_lit, reduce: MatchedStmt
+			reduce(53), // typedef, reduce: MatchedStmt
+			reduce(53), // const, reduce: MatchedStmt
+			reduce(53), // return, reduce: MatchedStmt
+			reduce(53), // {, reduce: MatchedStmt
+			reduce(53), // if, reduce: MatchedStmt
+			nil,        // else
+			reduce(53), // while, reduce: MatchedStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(53), // -, reduce: MatchedStmt
+			nil,        // *
+			nil,        // /
+			reduce(53), // !, reduce: MatchedStmt
+			reduce(53), // float_lit, reduce: MatchedStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S232
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(57), // error, reduce: OpenStmt
+			reduce(57), // }, reduce: OpenStmt
+			reduce(57), // ;, reduce: OpenStmt
+			reduce(57), // extern, reduce: OpenStmt
+			reduce(57), // static, reduce: OpenStmt
+			reduce(57), // ident, reduce: OpenStmt
+			reduce(57), // (, reduce: OpenStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(57), // int_lit, reduce: OpenStmt
+			reduce(57), // char_lit, reduce: OpenStmt
+			reduce(57), // typedef, reduce: OpenStmt
+			reduce(57), // const, reduce: OpenStmt
+			reduce(57), // return, reduce: OpenStmt
+			reduce(57), // {, reduce: OpenStmt
+			reduce(57), // if, reduce: OpenStmt
+			nil,        // else
+			reduce(57), // while, reduce: OpenStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(57), // -, reduce: OpenStmt
+			nil,        // *
+			nil,        // /
+			reduce(57), // !, reduce: OpenStmt
+			reduce(57), // float_lit, reduce: OpenStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S233
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(67), // ;, reduce: Expr2R
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S234
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(69), // ;, reduce: Expr5L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(69), // =, reduce: Expr5L
+			reduce(69), // &&, reduce: Expr5L
+			shift(129), // ==
+			shift(130), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S235
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(71), // ;, reduce: Expr9L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(71), // =, reduce: Expr9L
+			reduce(71), // &&, reduce: Expr9L
+			reduce(71), // ==, reduce: Expr9L
+			reduce(71), // !=, reduce: Expr9L
+			shift(131), // <
+			shift(132), // >
+			shift(133), // <=
+			shift(134), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S236
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(72), // ;, reduce: Expr9L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(72), // =, reduce: Expr9L
+			reduce(72), // &&, reduce: Expr9L
+			reduce(72), // ==, reduce: Expr9L
+			reduce(72), // !=, reduce: Expr9L
+			shift(131), // <
+			shift(132), // >
+			shift(133), // <=
+			shift(134), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S237
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(74), // ;, reduce: Expr10L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(74), // =, reduce: Expr10L
+			reduce(74), // &&, reduce: Expr10L
+			reduce(74), // ==, reduce: Expr10L
+			reduce(74), // !=, reduce: Expr10L
+			reduce(74), // <, reduce: Expr10L
+			reduce(74), // >, reduce: Expr10L
+			reduce(74), // <=, reduce: Expr10L
+			reduce(74), // >=, reduce: Expr10L
+			shift(135), // +
+			shift(136), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S238
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(75), // ;, reduce: Expr10L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(75), // =, reduce: Expr10L
+			reduce(75), // &&, reduce: Expr10L
+			reduce(75), // ==, reduce: Expr10L
+			reduce(75), // !=, reduce: Expr10L
+			reduce(75), // <, reduce: Expr10L
+			reduce(75), // >, reduce: Expr10L
+			reduce(75), // <=, reduce: Expr10L
+			reduce(75), // >=, reduce: Expr10L
+			shift(135), // +
+			shift(136), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S239
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(76), // ;, reduce: Expr10L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(76), // =, reduce: Expr10L
+			reduce(76), // &&, reduce: Expr10L
+			reduce(76), // ==, reduce: Expr10L
+			reduce(76), // !=, reduce: Expr10L
+			reduce(76), // <, reduce: Expr10L
+			reduce(76), // >, reduce: Expr10L
+			reduce(76), // <=, reduce: Expr10L
+			reduce(76), // >=, reduce: Expr10L
+			shift(135), // +
+			shift(136), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S240
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(77), // ;, reduce: Expr10L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(77), // =, reduce: Expr10L
+			reduce(77), // &&, reduce: Expr10L
+			reduce(77), // ==, reduce: Expr10L
+			reduce(77), // !=, reduce: Expr10L
+			reduce(77), // <, reduce: Expr10L
+			reduce(77), // >, reduce: Expr10L
+			reduce(77), // <=, reduce: Expr10L
+			reduce(77), // >=, reduce: Expr10L
+			shift(135), // +
+			shift(136), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S241
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(79), // ;, reduce: Expr12L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(79), // =, reduce: Expr12L
+			reduce(79), // &&, reduce: Expr12L
+			reduce(79), // ==, reduce: Expr12L
+			reduce(79), // !=, reduce: Expr12L
+			reduce(79), // <, reduce: Expr12L
+			reduce(79), // >, reduce: Expr12L
+			reduce(79), // <=, reduce: Expr12L
+			reduce(79), // >=, reduce: Expr12L
+			reduce(79), // +, reduce: Expr12L
+			reduce(79), // -, reduce: Expr12L
+			shift(137), // *
+			shift(138), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S242
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			shift(142), /* ident */
-			shift(143), /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(144), /* int_lit */
-			shift(145), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(153), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(156), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(80), // ;, reduce: Expr12L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(80), // =, reduce: Expr12L
+			reduce(80), // &&, reduce: Expr12L
+			reduce(80), // ==, reduce: Expr12L
+			reduce(80), // !=, reduce: Expr12L
+			reduce(80), // <, reduce: Expr12L
+			reduce(80), // >, reduce: Expr12L
+			reduce(80), // <=, reduce: Expr12L
+			reduce(80), // >=, reduce: Expr12L
+			reduce(80), // +, reduce: Expr12L
+			reduce(80), // -, reduce: Expr12L
+			shift(137), // *
+			shift(138), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S243
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(70), /* ], reduce: Expr14 */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(70), /* =, reduce: Expr14 */
-			reduce(70), /* &&, reduce: Expr14 */
-			reduce(70), /* ==, reduce: Expr14 */
-			reduce(70), /* !=, reduce: Expr14 */
-			reduce(70), /* <, reduce: Expr14 */
-			reduce(70), /* >, reduce: Expr14 */
-			reduce(70), /* <=, reduce: Expr14 */
-			reduce(70), /* >=, reduce: Expr14 */
-			reduce(70), /* +, reduce: Expr14 */
-			reduce(70), /* -, reduce: Expr14 */
-			reduce(70), /* *, reduce: Expr14 */
-			reduce(70), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(82), // ;, reduce: Expr13L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(82), // =, reduce: Expr13L
+			reduce(82), // &&, reduce: Expr13L
+			reduce(82), // ==, reduce: Expr13L
+			reduce(82), // !=, reduce: Expr13L
+			reduce(82), // <, reduce: Expr13L
+			reduce(82), // >, reduce: Expr13L
+			reduce(82), // <=, reduce: Expr13L
+			reduce(82), // >=, reduce: Expr13L
+			reduce(82), // +, reduce: Expr13L
+			reduce(82), // -, reduce: Expr13L
+			reduce(82), // *, reduce: Expr13L
+			reduce(82), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S244
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(71), /* ], reduce: Expr14 */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(71), /* =, reduce: Expr14 */
-			reduce(71), /* &&, reduce: Expr14 */
-			reduce(71), /* ==, reduce: Expr14 */
-			reduce(71), /* !=, reduce: Expr14 */
-			reduce(71), /* <, reduce: Expr14 */
-			reduce(71), /* >, reduce: Expr14 */
-			reduce(71), /* <=, reduce: Expr14 */
-			reduce(71), /* >=, reduce: Expr14 */
-			reduce(71), /* +, reduce: Expr14 */
-			reduce(71), /* -, reduce: Expr14 */
-			reduce(71), /* *, reduce: Expr14 */
-			reduce(71), /* /, reduce: Expr14 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(83), // ;, reduce: Expr13L
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(83), // =, reduce: Expr13L
+			reduce(83), // &&, reduce: Expr13L
+			reduce(83), // ==, reduce: Expr13L
+			reduce(83), // !=, reduce: Expr13L
+			reduce(83), // <, reduce: Expr13L
+			reduce(83), // >, reduce: Expr13L
+			reduce(83), // <=, reduce: Expr13L
+			reduce(83), // >=, reduce: Expr13L
+			reduce(83), // +, reduce: Expr13L
+			reduce(83), // -, reduce: Expr13L
+			reduce(83), // *, reduce: Expr13L
+			reduce(83), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S245
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(301), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(27), // ), reduce: ScalarDecl
+			reduce(27), // ,, reduce: ScalarDecl
+			shift(314), // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S246
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(302), /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(19), // ;, reduce: FuncHeader
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			reduce(19), // {, reduce: FuncHeader
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S247
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(79), /* ), reduce: ParenExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(79), /* =, reduce: ParenExpr */
-			reduce(79), /* &&, reduce: ParenExpr */
-			reduce(79), /* ==, reduce: ParenExpr */
-			reduce(79), /* !=, reduce: ParenExpr */
-			reduce(79), /* <, reduce: ParenExpr */
-			reduce(79), /* >, reduce: ParenExpr */
-			reduce(79), /* <=, reduce: ParenExpr */
-			reduce(79), /* >=, reduce: ParenExpr */
-			reduce(79), /* +, reduce: ParenExpr */
-			reduce(79), /* -, reduce: ParenExpr */
-			reduce(79), /* *, reduce: ParenExpr */
-			reduce(79), /* /, reduce: ParenExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			reduce(34), // ident, reduce: BasicType
+			nil,        // (
+			reduce(34), // ), reduce: BasicType
+			reduce(34), // ,, reduce: BasicType
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S248
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(52), /* ), reduce: Expr2R */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(142), // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			shift(148), // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S249
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(54), /* ), reduce: Expr5L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(54), /* =, reduce: Expr5L */
-			reduce(54), /* &&, reduce: Expr5L */
-			shift(165), /* == */
-			shift(166), /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(28), // ;, reduce: ArrayDecl
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(28), // ,, reduce: ArrayDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S250
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(56), /* ), reduce: Expr9L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(56), /* =, reduce: Expr9L */
-			reduce(56), /* &&, reduce: Expr9L */
-			reduce(56), /* ==, reduce: Expr9L */
-			reduce(56), /* !=, reduce: Expr9L */
-			shift(167), /* < */
-			shift(168), /* > */
-			shift(169), /* <= */
-			shift(170), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(23), // ;, reduce: VarDecls
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			reduce(23), // ,, reduce: VarDecls
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S251
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(57), /* ), reduce: Expr9L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(57), /* =, reduce: Expr9L */
-			reduce(57), /* &&, reduce: Expr9L */
-			reduce(57), /* ==, reduce: Expr9L */
-			reduce(57), /* !=, reduce: Expr9L */
-			shift(167), /* < */
-			shift(168), /* > */
-			shift(169), /* <= */
-			shift(170), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			reduce(96), // ), reduce: Args
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S252
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(59), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(59), /* =, reduce: Expr10L */
-			reduce(59), /* &&, reduce: Expr10L */
-			reduce(59), /* ==, reduce: Expr10L */
-			reduce(59), /* !=, reduce: Expr10L */
-			reduce(59), /* <, reduce: Expr10L */
-			reduce(59), /* >, reduce: Expr10L */
-			reduce(59), /* <=, reduce: Expr10L */
-			reduce(59), /* >=, reduce: Expr10L */
-			shift(171), /* + */
-			shift(172), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S253
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(60), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(60), /* =, reduce: Expr10L */
-			reduce(60), /* &&, reduce: Expr10L */
-			reduce(60), /* ==, reduce: Expr10L */
-			reduce(60), /* !=, reduce: Expr10L */
-			reduce(60), /* <, reduce: Expr10L */
-			reduce(60), /* >, reduce: Expr10L */
-			reduce(60), /* <=, reduce: Expr10L */
-			reduce(60), /* >=, reduce: Expr10L */
-			shift(171), /* + */
-			shift(172), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(318), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S254
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(61), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(61), /* =, reduce: Expr10L */
-			reduce(61), /* &&, reduce: Expr10L */
-			reduce(61), /* ==, reduce: Expr10L */
-			reduce(61), /* !=, reduce: Expr10L */
-			reduce(61), /* <, reduce: Expr10L */
-			reduce(61), /* >, reduce: Expr10L */
-			reduce(61), /* <=, reduce: Expr10L */
-			reduce(61), /* >=, reduce: Expr10L */
-			shift(171), /* + */
-			shift(172), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S255
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(62), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(62), /* =, reduce: Expr10L */
-			reduce(62), /* &&, reduce: Expr10L */
-			reduce(62), /* ==, reduce: Expr10L */
-			reduce(62), /* !=, reduce: Expr10L */
-			reduce(62), /* <, reduce: Expr10L */
-			reduce(62), /* >, reduce: Expr10L */
-			reduce(62), /* <=, reduce: Expr10L */
-			reduce(62), /* >=, reduce: Expr10L */
-			shift(171), /* + */
-			shift(172), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S256
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(64), /* ), reduce: Expr12L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(64), /* =, reduce: Expr12L */
-			reduce(64), /* &&, reduce: Expr12L */
-			reduce(64), /* ==, reduce: Expr12L */
-			reduce(64), /* !=, reduce: Expr12L */
-			reduce(64), /* <, reduce: Expr12L */
-			reduce(64), /* >, reduce: Expr12L */
-			reduce(64), /* <=, reduce: Expr12L */
-			reduce(64), /* >=, reduce: Expr12L */
-			reduce(64), /* +, reduce: Expr12L */
-			reduce(64), /* -, reduce: Expr12L */
-			shift(173), /* * */
-			shift(174), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S257
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(65), /* ), reduce: Expr12L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(65), /* =, reduce: Expr12L */
-			reduce(65), /* &&, reduce: Expr12L */
-			reduce(65), /* ==, reduce: Expr12L */
-			reduce(65), /* !=, reduce: Expr12L */
-			reduce(65), /* <, reduce: Expr12L */
-			reduce(65), /* >, reduce: Expr12L */
-			reduce(65), /* <=, reduce: Expr12L */
-			reduce(65), /* >=, reduce: Expr12L */
-			reduce(65), /* +, reduce: Expr12L */
-			reduce(65), /* -, reduce: Expr12L */
-			shift(173), /* * */
-			shift(174), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S258
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(67), /* ), reduce: Expr13L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(67), /* =, reduce: Expr13L */
-			reduce(67), /* &&, reduce: Expr13L */
-			reduce(67), /* ==, reduce: Expr13L */
-			reduce(67), /* !=, reduce: Expr13L */
-			reduce(67), /* <, reduce: Expr13L */
-			reduce(67), /* >, reduce: Expr13L */
-			reduce(67), /* <=, reduce: Expr13L */
-			reduce(67), /* >=, reduce: Expr13L */
-			reduce(67), /* +, reduce: Expr13L */
-			reduce(67), /* -, reduce: Expr13L */
-			reduce(67), /* *, reduce: Expr13L */
-			reduce(67), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S259
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(68), /* ), reduce: Expr13L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(68), /* =, reduce: Expr13L */
-			reduce(68), /* &&, reduce: Expr13L */
-			reduce(68), /* ==, reduce: Expr13L */
-			reduce(68), /* !=, reduce: Expr13L */
-			reduce(68), /* <, reduce: Expr13L */
-			reduce(68), /* >, reduce: Expr13L */
-			reduce(68), /* <=, reduce: Expr13L */
-			reduce(68), /* >=, reduce: Expr13L */
-			reduce(68), /* +, reduce: Expr13L */
-			reduce(68), /* -, reduce: Expr13L */
-			reduce(68), /* *, reduce: Expr13L */
-			reduce(68), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S260
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(43), /* ;, reduce: Condition */
-			reduce(43), /* ident, reduce: Condition */
-			reduce(43), /* (, reduce: Condition */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(43), /* int_lit, reduce: Condition */
-			reduce(43), /* char_lit, reduce: Condition */
-			nil,        /* typedef */
-			nil,        /* , */
-			reduce(43), /* return, reduce: Condition */
-			reduce(43), /* {, reduce: Condition */
-			nil,        /* } */
-			reduce(43), /* if, reduce: Condition */
-			nil,        /* else */
-			reduce(43), /* while, reduce: Condition */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(43), /* -, reduce: Condition */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(43), /* !, reduce: Condition */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S261
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       /* INVALID */
-			nil,       /* $ */
-			nil,       /* empty */
-			shift(26), /* ; */
-			shift(88), /* ident */
-			shift(32), /* ( */
-			nil,       /* ) */
-			nil,       /* [ */
-			nil,       /* ] */
-			shift(34), /* int_lit */
-			shift(35), /* char_lit */
-			nil,       /* typedef */
-			nil,       /* , */
-			shift(41), /* return */
-			shift(42), /* { */
-			nil,       /* } */
-			shift(44), /* if */
-			nil,       /* else */
-			shift(45), /* while */
-			nil,       /* = */
-			nil,       /* && */
-			nil,       /* == */
-			nil,       /* != */
-			nil,       /* < */
-			nil,       /* > */
-			nil,       /* <= */
-			nil,       /* >= */
-			nil,       /* + */
-			shift(54), /* - */
-			nil,       /* * */
-			nil,       /* / */
-			shift(57), /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S262
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(31), /* ;, reduce: OtherStmt */
-			reduce(31), /* ident, reduce: OtherStmt */
-			reduce(31), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(31), /* int_lit, reduce: OtherStmt */
-			reduce(31), /* char_lit, reduce: OtherStmt */
-			reduce(31), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(31), /* return, reduce: OtherStmt */
-			reduce(31), /* {, reduce: OtherStmt */
-			reduce(31), /* }, reduce: OtherStmt */
-			reduce(31), /* if, reduce: OtherStmt */
-			reduce(31), /* else, reduce: OtherStmt */
-			reduce(31), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(31), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(31), /* !, reduce: OtherStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S263
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(33), /* ;, reduce: OtherStmt */
-			reduce(33), /* ident, reduce: OtherStmt */
-			reduce(33), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(33), /* int_lit, reduce: OtherStmt */
-			reduce(33), /* char_lit, reduce: OtherStmt */
-			reduce(33), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(33), /* return, reduce: OtherStmt */
-			reduce(33), /* {, reduce: OtherStmt */
-			reduce(33), /* }, reduce: OtherStmt */
-			reduce(33), /* if, reduce: OtherStmt */
-			reduce(33), /* else, reduce: OtherStmt */
-			reduce(33), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(33), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(33), /* !, reduce: OtherStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S264
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(305), /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S265
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			shift(306), /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S266
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(180), /* ; */
-			shift(88),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			shift(186), /* return */
-			shift(187), /* { */
-			nil,        /* } */
-			shift(188), /* if */
-			nil,        /* else */
-			shift(189), /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(85), // ), reduce: Expr14
+			reduce(85), // ,, reduce: Expr14
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(85), // =, reduce: Expr14
+			reduce(85), // &&, reduce: Expr14
+			reduce(85), // ==, reduce: Expr14
+			reduce(85), // !=, reduce: Expr14
+			reduce(85), // <, reduce: Expr14
+			reduce(85), // >, reduce: Expr14
+			reduce(85), // <=, reduce: Expr14
+			reduce(85), // >=, reduce: Expr14
+			reduce(85), // +, reduce: Expr14
+			reduce(85), // -, reduce: Expr14
+			reduce(85), // *, reduce: Expr14
+			reduce(85), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S267
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(180), /* ; */
-			shift(88),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			shift(186), /* return */
-			shift(187), /* { */
-			nil,        /* } */
-			shift(188), /* if */
-			nil,        /* else */
-			shift(189), /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(86), // ), reduce: Expr14
+			reduce(86), // ,, reduce: Expr14
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(86), // =, reduce: Expr14
+			reduce(86), // &&, reduce: Expr14
+			reduce(86), // ==, reduce: Expr14
+			reduce(86), // !=, reduce: Expr14
+			reduce(86), // <, reduce: Expr14
+			reduce(86), // >, reduce: Expr14
+			reduce(86), // <=, reduce: Expr14
+			reduce(86), // >=, reduce: Expr14
+			reduce(86), // +, reduce: Expr14
+			reduce(86), // -, reduce: Expr14
+			reduce(86), // *, reduce: Expr14
+			reduce(86), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S268
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(310), /* ] */
-			shift(121), /* int_lit */
-			shift(122), /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(89), // ;, reduce: Expr15
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(89), // =, reduce: Expr15
+			reduce(89), // &&, reduce: Expr15
+			reduce(89), // ==, reduce: Expr15
+			reduce(89), // !=, reduce: Expr15
+			reduce(89), // <, reduce: Expr15
+			reduce(89), // >, reduce: Expr15
+			reduce(89), // <=, reduce: Expr15
+			reduce(89), // >=, reduce: Expr15
+			reduce(89), // +, reduce: Expr15
+			reduce(89), // -, reduce: Expr15
+			reduce(89), // *, reduce: Expr15
+			reduce(89), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S269
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(25), /* ), reduce: ParamList */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(25), /* ,, reduce: ParamList */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S270
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(311), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(159), // ident
+			shift(160), // (
+			reduce(96), // ), reduce: Args
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(161), // int_lit
+			shift(162), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(170), // -
+			nil,        // *
+			nil,        // /
+			shift(173), // !
+			shift(176), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S271
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(312), /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S272
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(79), /* ), reduce: ParenExpr */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(79), /* ,, reduce: ParenExpr */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(79), /* =, reduce: ParenExpr */
-			reduce(79), /* &&, reduce: ParenExpr */
-			reduce(79), /* ==, reduce: ParenExpr */
-			reduce(79), /* !=, reduce: ParenExpr */
-			reduce(79), /* <, reduce: ParenExpr */
-			reduce(79), /* >, reduce: ParenExpr */
-			reduce(79), /* <=, reduce: ParenExpr */
-			reduce(79), /* >=, reduce: ParenExpr */
-			reduce(79), /* +, reduce: ParenExpr */
-			reduce(79), /* -, reduce: ParenExpr */
-			reduce(79), /* *, reduce: ParenExpr */
-			reduce(79), /* /, reduce: ParenExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(334), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S273
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(52), /* ), reduce: Expr2R */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(52), /* ,, reduce: Expr2R */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(88), // ;, reduce: Expr15
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(88), // =, reduce: Expr15
+			reduce(88), // &&, reduce: Expr15
+			reduce(88), // ==, reduce: Expr15
+			reduce(88), // !=, reduce: Expr15
+			reduce(88), // <, reduce: Expr15
+			reduce(88), // >, reduce: Expr15
+			reduce(88), // <=, reduce: Expr15
+			reduce(88), // >=, reduce: Expr15
+			reduce(88), // +, reduce: Expr15
+			reduce(88), // -, reduce: Expr15
+			reduce(88), // *, reduce: Expr15
+			reduce(88), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S274
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(54), /* ), reduce: Expr5L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(54), /* ,, reduce: Expr5L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(54), /* =, reduce: Expr5L */
-			reduce(54), /* &&, reduce: Expr5L */
-			shift(213), /* == */
-			shift(214), /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S275
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(56), /* ), reduce: Expr9L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(56), /* ,, reduce: Expr9L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(56), /* =, reduce: Expr9L */
-			reduce(56), /* &&, reduce: Expr9L */
-			reduce(56), /* ==, reduce: Expr9L */
-			reduce(56), /* !=, reduce: Expr9L */
-			shift(215), /* < */
-			shift(216), /* > */
-			shift(217), /* <= */
-			shift(218), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S276
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(57), /* ), reduce: Expr9L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(57), /* ,, reduce: Expr9L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(57), /* =, reduce: Expr9L */
-			reduce(57), /* &&, reduce: Expr9L */
-			reduce(57), /* ==, reduce: Expr9L */
-			reduce(57), /* !=, reduce: Expr9L */
-			shift(215), /* < */
-			shift(216), /* > */
-			shift(217), /* <= */
-			shift(218), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S277
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(59), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(59), /* ,, reduce: Expr10L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(59), /* =, reduce: Expr10L */
-			reduce(59), /* &&, reduce: Expr10L */
-			reduce(59), /* ==, reduce: Expr10L */
-			reduce(59), /* !=, reduce: Expr10L */
-			reduce(59), /* <, reduce: Expr10L */
-			reduce(59), /* >, reduce: Expr10L */
-			reduce(59), /* <=, reduce: Expr10L */
-			reduce(59), /* >=, reduce: Expr10L */
-			shift(219), /* + */
-			shift(220), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S278
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(60), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(60), /* ,, reduce: Expr10L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(60), /* =, reduce: Expr10L */
-			reduce(60), /* &&, reduce: Expr10L */
-			reduce(60), /* ==, reduce: Expr10L */
-			reduce(60), /* !=, reduce: Expr10L */
-			reduce(60), /* <, reduce: Expr10L */
-			reduce(60), /* >, reduce: Expr10L */
-			reduce(60), /* <=, reduce: Expr10L */
-			reduce(60), /* >=, reduce: Expr10L */
-			shift(219), /* + */
-			shift(220), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S279
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(61), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(61), /* ,, reduce: Expr10L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(61), /* =, reduce: Expr10L */
-			reduce(61), /* &&, reduce: Expr10L */
-			reduce(61), /* ==, reduce: Expr10L */
-			reduce(61), /* !=, reduce: Expr10L */
-			reduce(61), /* <, reduce: Expr10L */
-			reduce(61), /* >, reduce: Expr10L */
-			reduce(61), /* <=, reduce: Expr10L */
-			reduce(61), /* >=, reduce: Expr10L */
-			shift(219), /* + */
-			shift(220), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S280
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(62), /* ), reduce: Expr10L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(62), /* ,, reduce: Expr10L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(62), /* =, reduce: Expr10L */
-			reduce(62), /* &&, reduce: Expr10L */
-			reduce(62), /* ==, reduce: Expr10L */
-			reduce(62), /* !=, reduce: Expr10L */
-			reduce(62), /* <, reduce: Expr10L */
-			reduce(62), /* >, reduce: Expr10L */
-			reduce(62), /* <=, reduce: Expr10L */
-			reduce(62), /* >=, reduce: Expr10L */
-			shift(219), /* + */
-			shift(220), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S281
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(64), /* ), reduce: Expr12L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(64), /* ,, reduce: Expr12L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(64), /* =, reduce: Expr12L */
-			reduce(64), /* &&, reduce: Expr12L */
-			reduce(64), /* ==, reduce: Expr12L */
-			reduce(64), /* !=, reduce: Expr12L */
-			reduce(64), /* <, reduce: Expr12L */
-			reduce(64), /* >, reduce: Expr12L */
-			reduce(64), /* <=, reduce: Expr12L */
-			reduce(64), /* >=, reduce: Expr12L */
-			reduce(64), /* +, reduce: Expr12L */
-			reduce(64), /* -, reduce: Expr12L */
-			shift(221), /* * */
-			shift(222), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S282
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(65), /* ), reduce: Expr12L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(65), /* ,, reduce: Expr12L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(65), /* =, reduce: Expr12L */
-			reduce(65), /* &&, reduce: Expr12L */
-			reduce(65), /* ==, reduce: Expr12L */
-			reduce(65), /* !=, reduce: Expr12L */
-			reduce(65), /* <, reduce: Expr12L */
-			reduce(65), /* >, reduce: Expr12L */
-			reduce(65), /* <=, reduce: Expr12L */
-			reduce(65), /* >=, reduce: Expr12L */
-			reduce(65), /* +, reduce: Expr12L */
-			reduce(65), /* -, reduce: Expr12L */
-			shift(221), /* * */
-			shift(222), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S283
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(67), /* ), reduce: Expr13L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(67), /* ,, reduce: Expr13L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(67), /* =, reduce: Expr13L */
-			reduce(67), /* &&, reduce: Expr13L */
-			reduce(67), /* ==, reduce: Expr13L */
-			reduce(67), /* !=, reduce: Expr13L */
-			reduce(67), /* <, reduce: Expr13L */
-			reduce(67), /* >, reduce: Expr13L */
-			reduce(67), /* <=, reduce: Expr13L */
-			reduce(67), /* >=, reduce: Expr13L */
-			reduce(67), /* +, reduce: Expr13L */
-			reduce(67), /* -, reduce: Expr13L */
-			reduce(67), /* *, reduce: Expr13L */
-			reduce(67), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S284
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(68), /* ), reduce: Expr13L */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(68), /* ,, reduce: Expr13L */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(68), /* =, reduce: Expr13L */
-			reduce(68), /* &&, reduce: Expr13L */
-			reduce(68), /* ==, reduce: Expr13L */
-			reduce(68), /* !=, reduce: Expr13L */
-			reduce(68), /* <, reduce: Expr13L */
-			reduce(68), /* >, reduce: Expr13L */
-			reduce(68), /* <=, reduce: Expr13L */
-			reduce(68), /* >=, reduce: Expr13L */
-			reduce(68), /* +, reduce: Expr13L */
-			reduce(68), /* -, reduce: Expr13L */
-			reduce(68), /* *, reduce: Expr13L */
-			reduce(68), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S285
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(83), /* ), reduce: ExprList */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(83), /* ,, reduce: ExprList */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			shift(179), // ident
+			shift(180), // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(181), // int_lit
+			shift(182), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(190), // -
+			nil,        // *
+			nil,        // /
+			shift(193), // !
+			shift(195), // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S286
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			shift(313), /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(85), // ], reduce: Expr14
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(85), // =, reduce: Expr14
+			reduce(85), // &&, reduce: Expr14
+			reduce(85), // ==, reduce: Expr14
+			reduce(85), // !=, reduce: Expr14
+			reduce(85), // <, reduce: Expr14
+			reduce(85), // >, reduce: Expr14
+			reduce(85), // <=, reduce: Expr14
+			reduce(85), // >=, reduce: Expr14
+			reduce(85), // +, reduce: Expr14
+			reduce(85), // -, reduce: Expr14
+			reduce(85), // *, reduce: Expr14
+			reduce(85), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S287
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(314), /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(86), // ], reduce: Expr14
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(86), // =, reduce: Expr14
+			reduce(86), // &&, reduce: Expr14
+			reduce(86), // ==, reduce: Expr14
+			reduce(86), // !=, reduce: Expr14
+			reduce(86), // <, reduce: Expr14
+			reduce(86), // >, reduce: Expr14
+			reduce(86), // <=, reduce: Expr14
+			reduce(86), // >=, reduce: Expr14
+			reduce(86), // +, reduce: Expr14
+			reduce(86), // -, reduce: Expr14
+			reduce(86), // *, reduce: Expr14
+			reduce(86), // /, reduce: Expr14
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S288
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(79), /* ], reduce: ParenExpr */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(79), /* =, reduce: ParenExpr */
-			reduce(79), /* &&, reduce: ParenExpr */
-			reduce(79), /* ==, reduce: ParenExpr */
-			reduce(79), /* !=, reduce: ParenExpr */
-			reduce(79), /* <, reduce: ParenExpr */
-			reduce(79), /* >, reduce: ParenExpr */
-			reduce(79), /* <=, reduce: ParenExpr */
-			reduce(79), /* >=, reduce: ParenExpr */
-			reduce(79), /* +, reduce: ParenExpr */
-			reduce(79), /* -, reduce: ParenExpr */
-			reduce(79), /* *, reduce: ParenExpr */
-			reduce(79), /* /, reduce: ParenExpr */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(347), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S289
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(52), /* ], reduce: Expr2R */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(348), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S290
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(54), /* ], reduce: Expr5L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(54), /* =, reduce: Expr5L */
-			reduce(54), /* &&, reduce: Expr5L */
-			shift(233), /* == */
-			shift(234), /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(95), // ), reduce: ParenExpr
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(95), // =, reduce: ParenExpr
+			reduce(95), // &&, reduce: ParenExpr
+			reduce(95), // ==, reduce: ParenExpr
+			reduce(95), // !=, reduce: ParenExpr
+			reduce(95), // <, reduce: ParenExpr
+			reduce(95), // >, reduce: ParenExpr
+			reduce(95), // <=, reduce: ParenExpr
+			reduce(95), // >=, reduce: ParenExpr
+			reduce(95), // +, reduce: ParenExpr
+			reduce(95), // -, reduce: ParenExpr
+			reduce(95), // *, reduce: ParenExpr
+			reduce(95), // /, reduce: ParenExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S291
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(56), /* ], reduce: Expr9L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(56), /* =, reduce: Expr9L */
-			reduce(56), /* &&, reduce: Expr9L */
-			reduce(56), /* ==, reduce: Expr9L */
-			reduce(56), /* !=, reduce: Expr9L */
-			shift(235), /* < */
-			shift(236), /* > */
-			shift(237), /* <= */
-			shift(238), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(67), // ), reduce: Expr2R
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S292
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(57), /* ], reduce: Expr9L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(57), /* =, reduce: Expr9L */
-			reduce(57), /* &&, reduce: Expr9L */
-			reduce(57), /* ==, reduce: Expr9L */
-			reduce(57), /* !=, reduce: Expr9L */
-			shift(235), /* < */
-			shift(236), /* > */
-			shift(237), /* <= */
-			shift(238), /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(69), // ), reduce: Expr5L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(69), // =, reduce: Expr5L
+			reduce(69), // &&, reduce: Expr5L
+			shift(203), // ==
+			shift(204), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S293
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(59), /* ], reduce: Expr10L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(59), /* =, reduce: Expr10L */
-			reduce(59), /* &&, reduce: Expr10L */
-			reduce(59), /* ==, reduce: Expr10L */
-			reduce(59), /* !=, reduce: Expr10L */
-			reduce(59), /* <, reduce: Expr10L */
-			reduce(59), /* >, reduce: Expr10L */
-			reduce(59), /* <=, reduce: Expr10L */
-			reduce(59), /* >=, reduce: Expr10L */
-			shift(239), /* + */
-			shift(240), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(71), // ), reduce: Expr9L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(71), // =, reduce: Expr9L
+			reduce(71), // &&, reduce: Expr9L
+			reduce(71), // ==, reduce: Expr9L
+			reduce(71), // !=, reduce: Expr9L
+			shift(205), // <
+			shift(206), // >
+			shift(207), // <=
+			shift(208), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S294
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(60), /* ], reduce: Expr10L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(60), /* =, reduce: Expr10L */
-			reduce(60), /* &&, reduce: Expr10L */
-			reduce(60), /* ==, reduce: Expr10L */
-			reduce(60), /* !=, reduce: Expr10L */
-			reduce(60), /* <, reduce: Expr10L */
-			reduce(60), /* >, reduce: Expr10L */
-			reduce(60), /* <=, reduce: Expr10L */
-			reduce(60), /* >=, reduce: Expr10L */
-			shift(239), /* + */
-			shift(240), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(72), // ), reduce: Expr9L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(72), // =, reduce: Expr9L
+			reduce(72), // &&, reduce: Expr9L
+			reduce(72), // ==, reduce: Expr9L
+			reduce(72), // !=, reduce: Expr9L
+			shift(205), // <
+			shift(206), // >
+			shift(207), // <=
+			shift(208), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S295
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(61), /* ], reduce: Expr10L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(61), /* =, reduce: Expr10L */
-			reduce(61), /* &&, reduce: Expr10L */
-			reduce(61), /* ==, reduce: Expr10L */
-			reduce(61), /* !=, reduce: Expr10L */
-			reduce(61), /* <, reduce: Expr10L */
-			reduce(61), /* >, reduce: Expr10L */
-			reduce(61), /* <=, reduce: Expr10L */
-			reduce(61), /* >=, reduce: Expr10L */
-			shift(239), /* + */
-			shift(240), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(74), // ), reduce: Expr10L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(74), // =, reduce: Expr10L
+			reduce(74), // &&, reduce: Expr10L
+			reduce(74), // ==, reduce: Expr10L
+			reduce(74), // !=, reduce: Expr10L
+			reduce(74), // <, reduce: Expr10L
+			reduce(74), // >, reduce: Expr10L
+			reduce(74), // <=, reduce: Expr10L
+			reduce(74), // >=, reduce: Expr10L
+			shift(209), // +
+			shift(210), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S296
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(62), /* ], reduce: Expr10L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(62), /* =, reduce: Expr10L */
-			reduce(62), /* &&, reduce: Expr10L */
-			reduce(62), /* ==, reduce: Expr10L */
-			reduce(62), /* !=, reduce: Expr10L */
-			reduce(62), /* <, reduce: Expr10L */
-			reduce(62), /* >, reduce: Expr10L */
-			reduce(62), /* <=, reduce: Expr10L */
-			reduce(62), /* >=, reduce: Expr10L */
-			shift(239), /* + */
-			shift(240), /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(75), // ), reduce: Expr10L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(75), // =, reduce: Expr10L
+			reduce(75), // &&, reduce: Expr10L
+			reduce(75), // ==, reduce: Expr10L
+			reduce(75), // !=, reduce: Expr10L
+			reduce(75), // <, reduce: Expr10L
+			reduce(75), // >, reduce: Expr10L
+			reduce(75), // <=, reduce: Expr10L
+			reduce(75), // >=, reduce: Expr10L
+			shift(209), // +
+			shift(210), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S297
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(64), /* ], reduce: Expr12L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(64), /* =, reduce: Expr12L */
-			reduce(64), /* &&, reduce: Expr12L */
-			reduce(64), /* ==, reduce: Expr12L */
-			reduce(64), /* !=, reduce: Expr12L */
-			reduce(64), /* <, reduce: Expr12L */
-			reduce(64), /* >, reduce: Expr12L */
-			reduce(64), /* <=, reduce: Expr12L */
-			reduce(64), /* >=, reduce: Expr12L */
-			reduce(64), /* +, reduce: Expr12L */
-			reduce(64), /* -, reduce: Expr12L */
-			shift(241), /* * */
-			shift(242), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(76), // ), reduce: Expr10L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(76), // =, reduce: Expr10L
+			reduce(76), // &&, reduce: Expr10L
+			reduce(76), // ==, reduce: Expr10L
+			reduce(76), // !=, reduce: Expr10L
+			reduce(76), // <, reduce: Expr10L
+			reduce(76), // >, reduce: Expr10L
+			reduce(76), // <=, reduce: Expr10L
+			reduce(76), // >=, reduce: Expr10L
+			shift(209), // +
+			shift(210), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S298
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(65), /* ], reduce: Expr12L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(65), /* =, reduce: Expr12L */
-			reduce(65), /* &&, reduce: Expr12L */
-			reduce(65), /* ==, reduce: Expr12L */
-			reduce(65), /* !=, reduce: Expr12L */
-			reduce(65), /* <, reduce: Expr12L */
-			reduce(65), /* >, reduce: Expr12L */
-			reduce(65), /* <=, reduce: Expr12L */
-			reduce(65), /* >=, reduce: Expr12L */
-			reduce(65), /* +, reduce: Expr12L */
-			reduce(65), /* -, reduce: Expr12L */
-			shift(241), /* * */
-			shift(242), /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(77), // ), reduce: Expr10L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(77), // =, reduce: Expr10L
+			reduce(77), // &&, reduce: Expr10L
+			reduce(77), // ==, reduce: Expr10L
+			reduce(77), // !=, reduce: Expr10L
+			reduce(77), // <, reduce: Expr10L
+			reduce(77), // >, reduce: Expr10L
+			reduce(77), // <=, reduce: Expr10L
+			reduce(77), // >=, reduce: Expr10L
+			shift(209), // +
+			shift(210), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S299
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(67), /* ], reduce: Expr13L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(67), /* =, reduce: Expr13L */
-			reduce(67), /* &&, reduce: Expr13L */
-			reduce(67), /* ==, reduce: Expr13L */
-			reduce(67), /* !=, reduce: Expr13L */
-			reduce(67), /* <, reduce: Expr13L */
-			reduce(67), /* >, reduce: Expr13L */
-			reduce(67), /* <=, reduce: Expr13L */
-			reduce(67), /* >=, reduce: Expr13L */
-			reduce(67), /* +, reduce: Expr13L */
-			reduce(67), /* -, reduce: Expr13L */
-			reduce(67), /* *, reduce: Expr13L */
-			reduce(67), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(79), // ), reduce: Expr12L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(79), // =, reduce: Expr12L
+			reduce(79), // &&, reduce: Expr12L
+			reduce(79), // ==, reduce: Expr12L
+			reduce(79), // !=, reduce: Expr12L
+			reduce(79), // <, reduce: Expr12L
+			reduce(79), // >, reduce: Expr12L
+			reduce(79), // <=, reduce: Expr12L
+			reduce(79), // >=, reduce: Expr12L
+			reduce(79), // +, reduce: Expr12L
+			reduce(79), // -, reduce: Expr12L
+			shift(211), // *
+			shift(212), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S300
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(68), /* ], reduce: Expr13L */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(68), /* =, reduce: Expr13L */
-			reduce(68), /* &&, reduce: Expr13L */
-			reduce(68), /* ==, reduce: Expr13L */
-			reduce(68), /* !=, reduce: Expr13L */
-			reduce(68), /* <, reduce: Expr13L */
-			reduce(68), /* >, reduce: Expr13L */
-			reduce(68), /* <=, reduce: Expr13L */
-			reduce(68), /* >=, reduce: Expr13L */
-			reduce(68), /* +, reduce: Expr13L */
-			reduce(68), /* -, reduce: Expr13L */
-			reduce(68), /* *, reduce: Expr13L */
-			reduce(68), /* /, reduce: Expr13L */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(80), // ), reduce: Expr12L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(80), // =, reduce: Expr12L
+			reduce(80), // &&, reduce: Expr12L
+			reduce(80), // ==, reduce: Expr12L
+			reduce(80), // !=, reduce: Expr12L
+			reduce(80), // <, reduce: Expr12L
+			reduce(80), // >, reduce: Expr12L
+			reduce(80), // <=, reduce: Expr12L
+			reduce(80), // >=, reduce: Expr12L
+			reduce(80), // +, reduce: Expr12L
+			reduce(80), // -, reduce: Expr12L
+			shift(211), // *
+			shift(212), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S301
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(74), /* ), reduce: Expr15 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(74), /* =, reduce: Expr15 */
-			reduce(74), /* &&, reduce: Expr15 */
-			reduce(74), /* ==, reduce: Expr15 */
-			reduce(74), /* !=, reduce: Expr15 */
-			reduce(74), /* <, reduce: Expr15 */
-			reduce(74), /* >, reduce: Expr15 */
-			reduce(74), /* <=, reduce: Expr15 */
-			reduce(74), /* >=, reduce: Expr15 */
-			reduce(74), /* +, reduce: Expr15 */
-			reduce(74), /* -, reduce: Expr15 */
-			reduce(74), /* *, reduce: Expr15 */
-			reduce(74), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(82), // ), reduce: Expr13L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(82), // =, reduce: Expr13L
+			reduce(82), // &&, reduce: Expr13L
+			reduce(82), // ==, reduce: Expr13L
+			reduce(82), // !=, reduce: Expr13L
+			reduce(82), // <, reduce: Expr13L
+			reduce(82), // >, reduce: Expr13L
+			reduce(82), // <=, reduce: Expr13L
+			reduce(82), // >=, reduce: Expr13L
+			reduce(82), // +, reduce: Expr13L
+			reduce(82), // -, reduce: Expr13L
+			reduce(82), // *, reduce: Expr13L
+			reduce(82), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S302
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(73), /* ), reduce: Expr15 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(73), /* =, reduce: Expr15 */
-			reduce(73), /* &&, reduce: Expr15 */
-			reduce(73), /* ==, reduce: Expr15 */
-			reduce(73), /* !=, reduce: Expr15 */
-			reduce(73), /* <, reduce: Expr15 */
-			reduce(73), /* >, reduce: Expr15 */
-			reduce(73), /* <=, reduce: Expr15 */
-			reduce(73), /* >=, reduce: Expr15 */
-			reduce(73), /* +, reduce: Expr15 */
-			reduce(73), /* -, reduce: Expr15 */
-			reduce(73), /* *, reduce: Expr15 */
-			reduce(73), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(83), // ), reduce: Expr13L
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(83), // =, reduce: Expr13L
+			reduce(83), // &&, reduce: Expr13L
+			reduce(83), // ==, reduce: Expr13L
+			reduce(83), // !=, reduce: Expr13L
+			reduce(83), // <, reduce: Expr13L
+			reduce(83), // >, reduce: Expr13L
+			reduce(83), // <=, reduce: Expr13L
+			reduce(83), // >=, reduce: Expr13L
+			reduce(83), // +, reduce: Expr13L
+			reduce(83), // -, reduce: Expr13L
+			reduce(83), // *, reduce: Expr13L
+			reduce(83), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S303
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(37), /* ;, reduce: MatchedStmt */
-			reduce(37), /* ident, reduce: MatchedStmt */
-			reduce(37), /* (, reduce: MatchedStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(37), /* int_lit, reduce: MatchedStmt */
-			reduce(37), /* char_lit, reduce: MatchedStmt */
-			reduce(37), /* typedef, reduce: MatchedStmt */
-			nil,        /* , */
-			reduce(37), /* return, reduce: MatchedStmt */
-			reduce(37), /* {, reduce: MatchedStmt */
-			reduce(37), /* }, reduce: MatchedStmt */
-			reduce(37), /* if, reduce: MatchedStmt */
-			nil,        /* else */
-			reduce(37), /* while, reduce: MatchedStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(37), /* -, reduce: MatchedStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(37), /* !, reduce: MatchedStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(51), // error, reduce: BlockStmt
+			reduce(51), // }, reduce: BlockStmt
+			reduce(51), // ;, reduce: BlockStmt
+			reduce(51), // extern, reduce: BlockStmt
+			reduce(51), // static, reduce: BlockStmt
+			reduce(51), // ident, reduce: BlockStmt
+			reduce(51), // (, reduce: BlockStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(51), // int_lit, reduce: BlockStmt
+			reduce(51), // char_lit, reduce: BlockStmt
+			reduce(51), // typedef, reduce: BlockStmt
+			reduce(51), // const, reduce: BlockStmt
+			reduce(51), // return, reduce: BlockStmt
+			reduce(51), // {, reduce: BlockStmt
+			reduce(51), // if, reduce: BlockStmt
+			nil,        // else
+			reduce(51), // while, reduce: BlockStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(51), // -, reduce: BlockStmt
+			nil,        // *
+			nil,        // /
+			reduce(51), // !, reduce: BlockStmt
+			reduce(51), // float_lit, reduce: BlockStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S304
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(41), /* ;, reduce: OpenStmt */
-			reduce(41), /* ident, reduce: OpenStmt */
-			reduce(41), /* (, reduce: OpenStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(41), /* int_lit, reduce: OpenStmt */
-			reduce(41), /* char_lit, reduce: OpenStmt */
-			reduce(41), /* typedef, reduce: OpenStmt */
-			nil,        /* , */
-			reduce(41), /* return, reduce: OpenStmt */
-			reduce(41), /* {, reduce: OpenStmt */
-			reduce(41), /* }, reduce: OpenStmt */
-			reduce(41), /* if, reduce: OpenStmt */
-			nil,        /* else */
-			reduce(41), /* while, reduce: OpenStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(41), /* -, reduce: OpenStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(41), /* !, reduce: OpenStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			reduce(58), // ;, reduce: Condition
+			nil,        // extern
+			nil,        // static
+			reduce(58), // ident, reduce: Condition
+			reduce(58), // (, reduce: Condition
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(58), // int_lit, reduce: Condition
+			reduce(58), // char_lit, reduce: Condition
+			nil,        // typedef
+			nil,        // const
+			reduce(58), // return, reduce: Condition
+			reduce(58), // {, reduce: Condition
+			reduce(58), // if, reduce: Condition
+			nil,        // else
+			reduce(58), // while, reduce: Condition
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(58), // -, reduce: Condition
+			nil,        // *
+			nil,        // /
+			reduce(58), // !, reduce: Condition
+			reduce(58), // float_lit, reduce: Condition
+			nil,        // comment
 		},
 	},
 	actionRow{ // S305
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(32), /* ;, reduce: OtherStmt */
-			reduce(32), /* ident, reduce: OtherStmt */
-			reduce(32), /* (, reduce: OtherStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(32), /* int_lit, reduce: OtherStmt */
-			reduce(32), /* char_lit, reduce: OtherStmt */
-			reduce(32), /* typedef, reduce: OtherStmt */
-			nil,        /* , */
-			reduce(32), /* return, reduce: OtherStmt */
-			reduce(32), /* {, reduce: OtherStmt */
-			reduce(32), /* }, reduce: OtherStmt */
-			reduce(32), /* if, reduce: OtherStmt */
-			reduce(32), /* else, reduce: OtherStmt */
-			reduce(32), /* while, reduce: OtherStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(32), /* -, reduce: OtherStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(32), /* !, reduce: OtherStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(45),  // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S306
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(36), /* ;, reduce: BlockStmt */
-			reduce(36), /* ident, reduce: BlockStmt */
-			reduce(36), /* (, reduce: BlockStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(36), /* int_lit, reduce: BlockStmt */
-			reduce(36), /* char_lit, reduce: BlockStmt */
-			reduce(36), /* typedef, reduce: BlockStmt */
-			nil,        /* , */
-			reduce(36), /* return, reduce: BlockStmt */
-			reduce(36), /* {, reduce: BlockStmt */
-			reduce(36), /* }, reduce: BlockStmt */
-			reduce(36), /* if, reduce: BlockStmt */
-			reduce(36), /* else, reduce: BlockStmt */
-			reduce(36), /* while, reduce: BlockStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(36), /* -, reduce: BlockStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(36), /* !, reduce: BlockStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // error, reduce: OtherStmt
+			reduce(44), // }, reduce: OtherStmt
+			reduce(44), // ;, reduce: OtherStmt
+			reduce(44), // extern, reduce: OtherStmt
+			reduce(44), // static, reduce: OtherStmt
+			reduce(44), // ident, reduce: OtherStmt
+			reduce(44), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(44), // int_lit, reduce: OtherStmt
+			reduce(44), // char_lit, reduce: OtherStmt
+			reduce(44), // typedef, reduce: OtherStmt
+			reduce(44), // const, reduce: OtherStmt
+			reduce(44), // return, reduce: OtherStmt
+			reduce(44), // {, reduce: OtherStmt
+			reduce(44), // if, reduce: OtherStmt
+			reduce(44), // else, reduce: OtherStmt
+			reduce(44), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(44), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(44), // !, reduce: OtherStmt
+			reduce(44), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S307
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(29), /* ;, reduce: Stmt */
-			reduce(29), /* ident, reduce: Stmt */
-			reduce(29), /* (, reduce: Stmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(29), /* int_lit, reduce: Stmt */
-			reduce(29), /* char_lit, reduce: Stmt */
-			reduce(29), /* typedef, reduce: Stmt */
-			nil,        /* , */
-			reduce(29), /* return, reduce: Stmt */
-			reduce(29), /* {, reduce: Stmt */
-			reduce(29), /* }, reduce: Stmt */
-			reduce(29), /* if, reduce: Stmt */
-			shift(315), /* else */
-			reduce(29), /* while, reduce: Stmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(29), /* -, reduce: Stmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(29), /* !, reduce: Stmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // error, reduce: OtherStmt
+			reduce(46), // }, reduce: OtherStmt
+			reduce(46), // ;, reduce: OtherStmt
+			reduce(46), // extern, reduce: OtherStmt
+			reduce(46), // static, reduce: OtherStmt
+			reduce(46), // ident, reduce: OtherStmt
+			reduce(46), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(46), // int_lit, reduce: OtherStmt
+			reduce(46), // char_lit, reduce: OtherStmt
+			reduce(46), // typedef, reduce: OtherStmt
+			reduce(46), // const, reduce: OtherStmt
+			reduce(46), // return, reduce: OtherStmt
+			reduce(46), // {, reduce: OtherStmt
+			reduce(46), // if, reduce: OtherStmt
+			reduce(46), // else, reduce: OtherStmt
+			reduce(46), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(46), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(46), // !, reduce: OtherStmt
+			reduce(46), // float_lit, reduce: OtherStmt
+			nil,        // comment
 		},
 	},
 	actionRow{ // S308
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(38), /* ;, reduce: MatchedStmt */
-			reduce(38), /* ident, reduce: MatchedStmt */
-			reduce(38), /* (, reduce: MatchedStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(38), /* int_lit, reduce: MatchedStmt */
-			reduce(38), /* char_lit, reduce: MatchedStmt */
-			reduce(38), /* typedef, reduce: MatchedStmt */
-			nil,        /* , */
-			reduce(38), /* return, reduce: MatchedStmt */
-			reduce(38), /* {, reduce: MatchedStmt */
-			reduce(38), /* }, reduce: MatchedStmt */
-			reduce(38), /* if, reduce: MatchedStmt */
-			reduce(38), /* else, reduce: MatchedStmt */
-			reduce(38), /* while, reduce: MatchedStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(38), /* -, reduce: MatchedStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(38), /* !, reduce: MatchedStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(351), // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S309
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			shift(316), /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(352), // }
+			shift(86),  // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S310
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(17), /* ), reduce: ArrayDecl */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(17), /* ,, reduce: ArrayDecl */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(353), // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S311
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(74), /* ), reduce: Expr15 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(74), /* ,, reduce: Expr15 */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(74), /* =, reduce: Expr15 */
-			reduce(74), /* &&, reduce: Expr15 */
-			reduce(74), /* ==, reduce: Expr15 */
-			reduce(74), /* !=, reduce: Expr15 */
-			reduce(74), /* <, reduce: Expr15 */
-			reduce(74), /* >, reduce: Expr15 */
-			reduce(74), /* <=, reduce: Expr15 */
-			reduce(74), /* >=, reduce: Expr15 */
-			reduce(74), /* +, reduce: Expr15 */
-			reduce(74), /* -, reduce: Expr15 */
-			reduce(74), /* *, reduce: Expr15 */
-			reduce(74), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(354), // error
+			reduce(60), // }, reduce: BlockItems
+			shift(45),  // ;
+			shift(11),  // extern
+			shift(12),  // static
+			shift(51),  // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			shift(19),  // typedef
+			shift(20),  // const
+			shift(61),  // return
+			shift(62),  // {
+			shift(65),  // if
+			nil,        // else
+			shift(66),  // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S312
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(73), /* ), reduce: Expr15 */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(73), /* ,, reduce: Expr15 */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(73), /* =, reduce: Expr15 */
-			reduce(73), /* &&, reduce: Expr15 */
-			reduce(73), /* ==, reduce: Expr15 */
-			reduce(73), /* !=, reduce: Expr15 */
-			reduce(73), /* <, reduce: Expr15 */
-			reduce(73), /* >, reduce: Expr15 */
-			reduce(73), /* <=, reduce: Expr15 */
-			reduce(73), /* >=, reduce: Expr15 */
-			reduce(73), /* +, reduce: Expr15 */
-			reduce(73), /* -, reduce: Expr15 */
-			reduce(73), /* *, reduce: Expr15 */
-			reduce(73), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(221), // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			shift(227), // return
+			shift(228), // {
+			shift(229), // if
+			nil,        // else
+			shift(230), // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S313
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(74), /* ], reduce: Expr15 */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(74), /* =, reduce: Expr15 */
-			reduce(74), /* &&, reduce: Expr15 */
-			reduce(74), /* ==, reduce: Expr15 */
-			reduce(74), /* !=, reduce: Expr15 */
-			reduce(74), /* <, reduce: Expr15 */
-			reduce(74), /* >, reduce: Expr15 */
-			reduce(74), /* <=, reduce: Expr15 */
-			reduce(74), /* >=, reduce: Expr15 */
-			reduce(74), /* +, reduce: Expr15 */
-			reduce(74), /* -, reduce: Expr15 */
-			reduce(74), /* *, reduce: Expr15 */
-			reduce(74), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(221), // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			shift(227), // return
+			shift(228), // {
+			shift(229), // if
+			nil,        // else
+			shift(230), // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S314
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			reduce(73), /* ], reduce: Expr15 */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			reduce(73), /* =, reduce: Expr15 */
-			reduce(73), /* &&, reduce: Expr15 */
-			reduce(73), /* ==, reduce: Expr15 */
-			reduce(73), /* !=, reduce: Expr15 */
-			reduce(73), /* <, reduce: Expr15 */
-			reduce(73), /* >, reduce: Expr15 */
-			reduce(73), /* <=, reduce: Expr15 */
-			reduce(73), /* >=, reduce: Expr15 */
-			reduce(73), /* +, reduce: Expr15 */
-			reduce(73), /* -, reduce: Expr15 */
-			reduce(73), /* *, reduce: Expr15 */
-			reduce(73), /* /, reduce: Expr15 */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(358), // ]
+			shift(153), // int_lit
+			shift(154), // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S315
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			shift(180), /* ; */
-			shift(88),  /* ident */
-			shift(32),  /* ( */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			shift(34),  /* int_lit */
-			shift(35),  /* char_lit */
-			nil,        /* typedef */
-			nil,        /* , */
-			shift(186), /* return */
-			shift(187), /* { */
-			nil,        /* } */
-			shift(188), /* if */
-			nil,        /* else */
-			shift(189), /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			shift(54),  /* - */
-			nil,        /* * */
-			nil,        /* / */
-			shift(57),  /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(38), // ), reduce: ParamList
+			reduce(38), // ,, reduce: ParamList
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S316
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			nil,        /* ; */
-			nil,        /* ident */
-			nil,        /* ( */
-			reduce(16), /* ), reduce: ArrayDecl */
-			nil,        /* [ */
-			nil,        /* ] */
-			nil,        /* int_lit */
-			nil,        /* char_lit */
-			nil,        /* typedef */
-			reduce(16), /* ,, reduce: ArrayDecl */
-			nil,        /* return */
-			nil,        /* { */
-			nil,        /* } */
-			nil,        /* if */
-			nil,        /* else */
-			nil,        /* while */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			nil,        /* - */
-			nil,        /* * */
-			nil,        /* / */
-			nil,        /* ! */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(359), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
 		},
 	},
 	actionRow{ // S317
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        /* INVALID */
-			nil,        /* $ */
-			nil,        /* empty */
-			reduce(37), /* ;, reduce: MatchedStmt */
-			reduce(37), /* ident, reduce: MatchedStmt */
-			reduce(37), /* (, reduce: MatchedStmt */
-			nil,        /* ) */
-			nil,        /* [ */
-			nil,        /* ] */
-			reduce(37), /* int_lit, reduce: MatchedStmt */
-			reduce(37), /* char_lit, reduce: MatchedStmt */
-			reduce(37), /* typedef, reduce: MatchedStmt */
-			nil,        /* , */
-			reduce(37), /* return, reduce: MatchedStmt */
-			reduce(37), /* {, reduce: MatchedStmt */
-			reduce(37), /* }, reduce: MatchedStmt */
-			reduce(37), /* if, reduce: MatchedStmt */
-			reduce(37), /* else, reduce: MatchedStmt */
-			reduce(37), /* while, reduce: MatchedStmt */
-			nil,        /* = */
-			nil,        /* && */
-			nil,        /* == */
-			nil,        /* != */
-			nil,        /* < */
-			nil,        /* > */
-			nil,        /* <= */
-			nil,        /* >= */
-			nil,        /* + */
-			reduce(37), /* -, reduce: MatchedStmt */
-			nil,        /* * */
-			nil,        /* / */
-			reduce(37), /* !, reduce: MatchedStmt */
-
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(360), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S318
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(95), // ), reduce: ParenExpr
+			reduce(95), // ,, reduce: ParenExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(95), // =, reduce: ParenExpr
+			reduce(95), // &&, reduce: ParenExpr
+			reduce(95), // ==, reduce: ParenExpr
+			reduce(95), // !=, reduce: ParenExpr
+			reduce(95), // <, reduce: ParenExpr
+			reduce(95), // >, reduce: ParenExpr
+			reduce(95), // <=, reduce: ParenExpr
+			reduce(95), // >=, reduce: ParenExpr
+			reduce(95), // +, reduce: ParenExpr
+			reduce(95), // -, reduce: ParenExpr
+			reduce(95), // *, reduce: ParenExpr
+			reduce(95), // /, reduce: ParenExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S319
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(67), // ), reduce: Expr2R
+			reduce(67), // ,, reduce: Expr2R
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S320
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(69), // ), reduce: Expr5L
+			reduce(69), // ,, reduce: Expr5L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(69), // =, reduce: Expr5L
+			reduce(69), // &&, reduce: Expr5L
+			shift(256), // ==
+			shift(257), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S321
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(71), // ), reduce: Expr9L
+			reduce(71), // ,, reduce: Expr9L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(71), // =, reduce: Expr9L
+			reduce(71), // &&, reduce: Expr9L
+			reduce(71), // ==, reduce: Expr9L
+			reduce(71), // !=, reduce: Expr9L
+			shift(258), // <
+			shift(259), // >
+			shift(260), // <=
+			shift(261), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S322
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(72), // ), reduce: Expr9L
+			reduce(72), // ,, reduce: Expr9L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(72), // =, reduce: Expr9L
+			reduce(72), // &&, reduce: Expr9L
+			reduce(72), // ==, reduce: Expr9L
+			reduce(72), // !=, reduce: Expr9L
+			shift(258), // <
+			shift(259), // >
+			shift(260), // <=
+			shift(261), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S323
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(74), // ), reduce: Expr10L
+			reduce(74), // ,, reduce: Expr10L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(74), // =, reduce: Expr10L
+			reduce(74), // &&, reduce: Expr10L
+			reduce(74), // ==, reduce: Expr10L
+			reduce(74), // !=, reduce: Expr10L
+			reduce(74), // <, reduce: Expr10L
+			reduce(74), // >, reduce: Expr10L
+			reduce(74), // <=, reduce: Expr10L
+			reduce(74), // >=, reduce: Expr10L
+			shift(262), // +
+			shift(263), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S324
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(75), // ), reduce: Expr10L
+			reduce(75), // ,, reduce: Expr10L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(75), // =, reduce: Expr10L
+			reduce(75), // &&, reduce: Expr10L
+			reduce(75), // ==, reduce: Expr10L
+			reduce(75), // !=, reduce: Expr10L
+			reduce(75), // <, reduce: Expr10L
+			reduce(75), // >, reduce: Expr10L
+			reduce(75), // <=, reduce: Expr10L
+			reduce(75), // >=, reduce: Expr10L
+			shift(262), // +
+			shift(263), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S325
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(76), // ), reduce: Expr10L
+			reduce(76), // ,, reduce: Expr10L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(76), // =, reduce: Expr10L
+			reduce(76), // &&, reduce: Expr10L
+			reduce(76), // ==, reduce: Expr10L
+			reduce(76), // !=, reduce: Expr10L
+			reduce(76), // <, reduce: Expr10L
+			reduce(76), // >, reduce: Expr10L
+			reduce(76), // <=, reduce: Expr10L
+			reduce(76), // >=, reduce: Expr10L
+			shift(262), // +
+			shift(263), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S326
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(77), // ), reduce: Expr10L
+			reduce(77), // ,, reduce: Expr10L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(77), // =, reduce: Expr10L
+			reduce(77), // &&, reduce: Expr10L
+			reduce(77), // ==, reduce: Expr10L
+			reduce(77), // !=, reduce: Expr10L
+			reduce(77), // <, reduce: Expr10L
+			reduce(77), // >, reduce: Expr10L
+			reduce(77), // <=, reduce: Expr10L
+			reduce(77), // >=, reduce: Expr10L
+			shift(262), // +
+			shift(263), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S327
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(79), // ), reduce: Expr12L
+			reduce(79), // ,, reduce: Expr12L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(79), // =, reduce: Expr12L
+			reduce(79), // &&, reduce: Expr12L
+			reduce(79), // ==, reduce: Expr12L
+			reduce(79), // !=, reduce: Expr12L
+			reduce(79), // <, reduce: Expr12L
+			reduce(79), // >, reduce: Expr12L
+			reduce(79), // <=, reduce: Expr12L
+			reduce(79), // >=, reduce: Expr12L
+			reduce(79), // +, reduce: Expr12L
+			reduce(79), // -, reduce: Expr12L
+			shift(264), // *
+			shift(265), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S328
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(80), // ), reduce: Expr12L
+			reduce(80), // ,, reduce: Expr12L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(80), // =, reduce: Expr12L
+			reduce(80), // &&, reduce: Expr12L
+			reduce(80), // ==, reduce: Expr12L
+			reduce(80), // !=, reduce: Expr12L
+			reduce(80), // <, reduce: Expr12L
+			reduce(80), // >, reduce: Expr12L
+			reduce(80), // <=, reduce: Expr12L
+			reduce(80), // >=, reduce: Expr12L
+			reduce(80), // +, reduce: Expr12L
+			reduce(80), // -, reduce: Expr12L
+			shift(264), // *
+			shift(265), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S329
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(82), // ), reduce: Expr13L
+			reduce(82), // ,, reduce: Expr13L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(82), // =, reduce: Expr13L
+			reduce(82), // &&, reduce: Expr13L
+			reduce(82), // ==, reduce: Expr13L
+			reduce(82), // !=, reduce: Expr13L
+			reduce(82), // <, reduce: Expr13L
+			reduce(82), // >, reduce: Expr13L
+			reduce(82), // <=, reduce: Expr13L
+			reduce(82), // >=, reduce: Expr13L
+			reduce(82), // +, reduce: Expr13L
+			reduce(82), // -, reduce: Expr13L
+			reduce(82), // *, reduce: Expr13L
+			reduce(82), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S330
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(83), // ), reduce: Expr13L
+			reduce(83), // ,, reduce: Expr13L
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(83), // =, reduce: Expr13L
+			reduce(83), // &&, reduce: Expr13L
+			reduce(83), // ==, reduce: Expr13L
+			reduce(83), // !=, reduce: Expr13L
+			reduce(83), // <, reduce: Expr13L
+			reduce(83), // >, reduce: Expr13L
+			reduce(83), // <=, reduce: Expr13L
+			reduce(83), // >=, reduce: Expr13L
+			reduce(83), // +, reduce: Expr13L
+			reduce(83), // -, reduce: Expr13L
+			reduce(83), // *, reduce: Expr13L
+			reduce(83), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S331
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(99), // ), reduce: ExprList
+			reduce(99), // ,, reduce: ExprList
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S332
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			shift(361), // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S333
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(362), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S334
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(95), // ], reduce: ParenExpr
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(95), // =, reduce: ParenExpr
+			reduce(95), // &&, reduce: ParenExpr
+			reduce(95), // ==, reduce: ParenExpr
+			reduce(95), // !=, reduce: ParenExpr
+			reduce(95), // <, reduce: ParenExpr
+			reduce(95), // >, reduce: ParenExpr
+			reduce(95), // <=, reduce: ParenExpr
+			reduce(95), // >=, reduce: ParenExpr
+			reduce(95), // +, reduce: ParenExpr
+			reduce(95), // -, reduce: ParenExpr
+			reduce(95), // *, reduce: ParenExpr
+			reduce(95), // /, reduce: ParenExpr
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S335
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(67), // ], reduce: Expr2R
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S336
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(69), // ], reduce: Expr5L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(69), // =, reduce: Expr5L
+			reduce(69), // &&, reduce: Expr5L
+			shift(276), // ==
+			shift(277), // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S337
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(71), // ], reduce: Expr9L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(71), // =, reduce: Expr9L
+			reduce(71), // &&, reduce: Expr9L
+			reduce(71), // ==, reduce: Expr9L
+			reduce(71), // !=, reduce: Expr9L
+			shift(278), // <
+			shift(279), // >
+			shift(280), // <=
+			shift(281), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S338
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(72), // ], reduce: Expr9L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(72), // =, reduce: Expr9L
+			reduce(72), // &&, reduce: Expr9L
+			reduce(72), // ==, reduce: Expr9L
+			reduce(72), // !=, reduce: Expr9L
+			shift(278), // <
+			shift(279), // >
+			shift(280), // <=
+			shift(281), // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S339
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(74), // ], reduce: Expr10L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(74), // =, reduce: Expr10L
+			reduce(74), // &&, reduce: Expr10L
+			reduce(74), // ==, reduce: Expr10L
+			reduce(74), // !=, reduce: Expr10L
+			reduce(74), // <, reduce: Expr10L
+			reduce(74), // >, reduce: Expr10L
+			reduce(74), // <=, reduce: Expr10L
+			reduce(74), // >=, reduce: Expr10L
+			shift(282), // +
+			shift(283), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S340
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(75), // ], reduce: Expr10L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(75), // =, reduce: Expr10L
+			reduce(75), // &&, reduce: Expr10L
+			reduce(75), // ==, reduce: Expr10L
+			reduce(75), // !=, reduce: Expr10L
+			reduce(75), // <, reduce: Expr10L
+			reduce(75), // >, reduce: Expr10L
+			reduce(75), // <=, reduce: Expr10L
+			reduce(75), // >=, reduce: Expr10L
+			shift(282), // +
+			shift(283), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S341
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(76), // ], reduce: Expr10L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(76), // =, reduce: Expr10L
+			reduce(76), // &&, reduce: Expr10L
+			reduce(76), // ==, reduce: Expr10L
+			reduce(76), // !=, reduce: Expr10L
+			reduce(76), // <, reduce: Expr10L
+			reduce(76), // >, reduce: Expr10L
+			reduce(76), // <=, reduce: Expr10L
+			reduce(76), // >=, reduce: Expr10L
+			shift(282), // +
+			shift(283), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S342
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(77), // ], reduce: Expr10L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(77), // =, reduce: Expr10L
+			reduce(77), // &&, reduce: Expr10L
+			reduce(77), // ==, reduce: Expr10L
+			reduce(77), // !=, reduce: Expr10L
+			reduce(77), // <, reduce: Expr10L
+			reduce(77), // >, reduce: Expr10L
+			reduce(77), // <=, reduce: Expr10L
+			reduce(77), // >=, reduce: Expr10L
+			shift(282), // +
+			shift(283), // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S343
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(79), // ], reduce: Expr12L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(79), // =, reduce: Expr12L
+			reduce(79), // &&, reduce: Expr12L
+			reduce(79), // ==, reduce: Expr12L
+			reduce(79), // !=, reduce: Expr12L
+			reduce(79), // <, reduce: Expr12L
+			reduce(79), // >, reduce: Expr12L
+			reduce(79), // <=, reduce: Expr12L
+			reduce(79), // >=, reduce: Expr12L
+			reduce(79), // +, reduce: Expr12L
+			reduce(79), // -, reduce: Expr12L
+			shift(284), // *
+			shift(285), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S344
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(80), // ], reduce: Expr12L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(80), // =, reduce: Expr12L
+			reduce(80), // &&, reduce: Expr12L
+			reduce(80), // ==, reduce: Expr12L
+			reduce(80), // !=, reduce: Expr12L
+			reduce(80), // <, reduce: Expr12L
+			reduce(80), // >, reduce: Expr12L
+			reduce(80), // <=, reduce: Expr12L
+			reduce(80), // >=, reduce: Expr12L
+			reduce(80), // +, reduce: Expr12L
+			reduce(80), // -, reduce: Expr12L
+			shift(284), // *
+			shift(285), // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S345
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(82), // ], reduce: Expr13L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(82), // =, reduce: Expr13L
+			reduce(82), // &&, reduce: Expr13L
+			reduce(82), // ==, reduce: Expr13L
+			reduce(82), // !=, reduce: Expr13L
+			reduce(82), // <, reduce: Expr13L
+			reduce(82), // >, reduce: Expr13L
+			reduce(82), // <=, reduce: Expr13L
+			reduce(82), // >=, reduce: Expr13L
+			reduce(82), // +, reduce: Expr13L
+			reduce(82), // -, reduce: Expr13L
+			reduce(82), // *, reduce: Expr13L
+			reduce(82), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S346
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(83), // ], reduce: Expr13L
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(83), // =, reduce: Expr13L
+			reduce(83), // &&, reduce: Expr13L
+			reduce(83), // ==, reduce: Expr13L
+			reduce(83), // !=, reduce: Expr13L
+			reduce(83), // <, reduce: Expr13L
+			reduce(83), // >, reduce: Expr13L
+			reduce(83), // <=, reduce: Expr13L
+			reduce(83), // >=, reduce: Expr13L
+			reduce(83), // +, reduce: Expr13L
+			reduce(83), // -, reduce: Expr13L
+			reduce(83), // *, reduce: Expr13L
+			reduce(83), // /, reduce: Expr13L
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S347
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(89), // ), reduce: Expr15
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(89), // =, reduce: Expr15
+			reduce(89), // &&, reduce: Expr15
+			reduce(89), // ==, reduce: Expr15
+			reduce(89), // !=, reduce: Expr15
+			reduce(89), // <, reduce: Expr15
+			reduce(89), // >, reduce: Expr15
+			reduce(89), // <=, reduce: Expr15
+			reduce(89), // >=, reduce: Expr15
+			reduce(89), // +, reduce: Expr15
+			reduce(89), // -, reduce: Expr15
+			reduce(89), // *, reduce: Expr15
+			reduce(89), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S348
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(88), // ), reduce: Expr15
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(88), // =, reduce: Expr15
+			reduce(88), // &&, reduce: Expr15
+			reduce(88), // ==, reduce: Expr15
+			reduce(88), // !=, reduce: Expr15
+			reduce(88), // <, reduce: Expr15
+			reduce(88), // >, reduce: Expr15
+			reduce(88), // <=, reduce: Expr15
+			reduce(88), // >=, reduce: Expr15
+			reduce(88), // +, reduce: Expr15
+			reduce(88), // -, reduce: Expr15
+			reduce(88), // *, reduce: Expr15
+			reduce(88), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S349
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(52), // error, reduce: MatchedStmt
+			reduce(52), // }, reduce: MatchedStmt
+			reduce(52), // ;, reduce: MatchedStmt
+			reduce(52), // extern, reduce: MatchedStmt
+			reduce(52), // static, reduce: MatchedStmt
+			reduce(52), // ident, reduce: MatchedStmt
+			reduce(52), // (, reduce: MatchedStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(52), // int_lit, reduce: MatchedStmt
+			reduce(52), // char_lit, reduce: MatchedStmt
+			reduce(52), // typedef, reduce: MatchedStmt
+			reduce(52), // const, reduce: MatchedStmt
+			reduce(52), // return, reduce: MatchedStmt
+			reduce(52), // {, reduce: MatchedStmt
+			reduce(52), // if, reduce: MatchedStmt
+			nil,        // else
+			reduce(52), // while, reduce: MatchedStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(52), // -, reduce: MatchedStmt
+			nil,        // *
+			nil,        // /
+			reduce(52), // !, reduce: MatchedStmt
+			reduce(52), // float_lit, reduce: MatchedStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S350
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(56), // error, reduce: OpenStmt
+			reduce(56), // }, reduce: OpenStmt
+			reduce(56), // ;, reduce: OpenStmt
+			reduce(56), // extern, reduce: OpenStmt
+			reduce(56), // static, reduce: OpenStmt
+			reduce(56), // ident, reduce: OpenStmt
+			reduce(56), // (, reduce: OpenStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(56), // int_lit, reduce: OpenStmt
+			reduce(56), // char_lit, reduce: OpenStmt
+			reduce(56), // typedef, reduce: OpenStmt
+			reduce(56), // const, reduce: OpenStmt
+			reduce(56), // return, reduce: OpenStmt
+			reduce(56), // {, reduce: OpenStmt
+			reduce(56), // if, reduce: OpenStmt
+			nil,        // else
+			reduce(56), // while, reduce: OpenStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(56), // -, reduce: OpenStmt
+			nil,        // *
+			nil,        // /
+			reduce(56), // !, reduce: OpenStmt
+			reduce(56), // float_lit, reduce: OpenStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S351
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // error, reduce: OtherStmt
+			reduce(45), // }, reduce: OtherStmt
+			reduce(45), // ;, reduce: OtherStmt
+			reduce(45), // extern, reduce: OtherStmt
+			reduce(45), // static, reduce: OtherStmt
+			reduce(45), // ident, reduce: OtherStmt
+			reduce(45), // (, reduce: OtherStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(45), // int_lit, reduce: OtherStmt
+			reduce(45), // char_lit, reduce: OtherStmt
+			reduce(45), // typedef, reduce: OtherStmt
+			reduce(45), // const, reduce: OtherStmt
+			reduce(45), // return, reduce: OtherStmt
+			reduce(45), // {, reduce: OtherStmt
+			reduce(45), // if, reduce: OtherStmt
+			reduce(45), // else, reduce: OtherStmt
+			reduce(45), // while, reduce: OtherStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(45), // -, reduce: OtherStmt
+			nil,        // *
+			nil,        // /
+			reduce(45), // !, reduce: OtherStmt
+			reduce(45), // float_lit, reduce: OtherStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S352
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(50), // error, reduce: BlockStmt
+			reduce(50), // }, reduce: BlockStmt
+			reduce(50), // ;, reduce: BlockStmt
+			reduce(50), // extern, reduce: BlockStmt
+			reduce(50), // static, reduce: BlockStmt
+			reduce(50), // ident, reduce: BlockStmt
+			reduce(50), // (, reduce: BlockStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(50), // int_lit, reduce: BlockStmt
+			reduce(50), // char_lit, reduce: BlockStmt
+			reduce(50), // typedef, reduce: BlockStmt
+			reduce(50), // const, reduce: BlockStmt
+			reduce(50), // return, reduce: BlockStmt
+			reduce(50), // {, reduce: BlockStmt
+			reduce(50), // if, reduce: BlockStmt
+			reduce(50), // else, reduce: BlockStmt
+			reduce(50), // while, reduce: BlockStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(50), // -, reduce: BlockStmt
+			nil,        // *
+			nil,        // /
+			reduce(50), // !, reduce: BlockStmt
+			reduce(50), // float_lit, reduce: BlockStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S353
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(49), // error, reduce: BlockStmt
+			reduce(49), // }, reduce: BlockStmt
+			reduce(49), // ;, reduce: BlockStmt
+			reduce(49), // extern, reduce: BlockStmt
+			reduce(49), // static, reduce: BlockStmt
+			reduce(49), // ident, reduce: BlockStmt
+			reduce(49), // (, reduce: BlockStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(49), // int_lit, reduce: BlockStmt
+			reduce(49), // char_lit, reduce: BlockStmt
+			reduce(49), // typedef, reduce: BlockStmt
+			reduce(49), // const, reduce: BlockStmt
+			reduce(49), // return, reduce: BlockStmt
+			reduce(49), // {, reduce: BlockStmt
+			reduce(49), // if, reduce: BlockStmt
+			reduce(49), // else, reduce: BlockStmt
+			reduce(49), // while, reduce: BlockStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(49), // -, reduce: BlockStmt
+			nil,        // *
+			nil,        // /
+			reduce(49), // !, reduce: BlockStmt
+			reduce(49), // float_lit, reduce: BlockStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S354
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			shift(363), // }
+			shift(86),  // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S355
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // error, reduce: Stmt
+			reduce(42), // }, reduce: Stmt
+			reduce(42), // ;, reduce: Stmt
+			reduce(42), // extern, reduce: Stmt
+			reduce(42), // static, reduce: Stmt
+			reduce(42), // ident, reduce: Stmt
+			reduce(42), // (, reduce: Stmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(42), // int_lit, reduce: Stmt
+			reduce(42), // char_lit, reduce: Stmt
+			reduce(42), // typedef, reduce: Stmt
+			reduce(42), // const, reduce: Stmt
+			reduce(42), // return, reduce: Stmt
+			reduce(42), // {, reduce: Stmt
+			reduce(42), // if, reduce: Stmt
+			shift(364), // else
+			reduce(42), // while, reduce: Stmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(42), // -, reduce: Stmt
+			nil,        // *
+			nil,        // /
+			reduce(42), // !, reduce: Stmt
+			reduce(42), // float_lit, reduce: Stmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S356
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(53), // error, reduce: MatchedStmt
+			reduce(53), // }, reduce: MatchedStmt
+			reduce(53), // ;, reduce: MatchedStmt
+			reduce(53), // extern, reduce: MatchedStmt
+			reduce(53), // static, reduce: MatchedStmt
+			reduce(53), // ident, reduce: MatchedStmt
+			reduce(53), // (, reduce: MatchedStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(53), // int_lit, reduce: MatchedStmt
+			reduce(53), // char_lit, reduce: MatchedStmt
+			reduce(53), // typedef, reduce: MatchedStmt
+			reduce(53), // const, reduce: MatchedStmt
+			reduce(53), // return, reduce: MatchedStmt
+			reduce(53), // {, reduce: MatchedStmt
+			reduce(53), // if, reduce: MatchedStmt
+			reduce(53), // else, reduce: MatchedStmt
+			reduce(53), // while, reduce: MatchedStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(53), // -, reduce: MatchedStmt
+			nil,        // *
+			nil,        // /
+			reduce(53), // !, reduce: MatchedStmt
+			reduce(53), // float_lit, reduce: MatchedStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S357
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			shift(365), // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S358
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(29), // ), reduce: ArrayDecl
+			reduce(29), // ,, reduce: ArrayDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S359
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(89), // ), reduce: Expr15
+			reduce(89), // ,, reduce: Expr15
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(89), // =, reduce: Expr15
+			reduce(89), // &&, reduce: Expr15
+			reduce(89), // ==, reduce: Expr15
+			reduce(89), // !=, reduce: Expr15
+			reduce(89), // <, reduce: Expr15
+			reduce(89), // >, reduce: Expr15
+			reduce(89), // <=, reduce: Expr15
+			reduce(89), // >=, reduce: Expr15
+			reduce(89), // +, reduce: Expr15
+			reduce(89), // -, reduce: Expr15
+			reduce(89), // *, reduce: Expr15
+			reduce(89), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S360
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(88), // ), reduce: Expr15
+			reduce(88), // ,, reduce: Expr15
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(88), // =, reduce: Expr15
+			reduce(88), // &&, reduce: Expr15
+			reduce(88), // ==, reduce: Expr15
+			reduce(88), // !=, reduce: Expr15
+			reduce(88), // <, reduce: Expr15
+			reduce(88), // >, reduce: Expr15
+			reduce(88), // <=, reduce: Expr15
+			reduce(88), // >=, reduce: Expr15
+			reduce(88), // +, reduce: Expr15
+			reduce(88), // -, reduce: Expr15
+			reduce(88), // *, reduce: Expr15
+			reduce(88), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S361
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(89), // ], reduce: Expr15
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(89), // =, reduce: Expr15
+			reduce(89), // &&, reduce: Expr15
+			reduce(89), // ==, reduce: Expr15
+			reduce(89), // !=, reduce: Expr15
+			reduce(89), // <, reduce: Expr15
+			reduce(89), // >, reduce: Expr15
+			reduce(89), // <=, reduce: Expr15
+			reduce(89), // >=, reduce: Expr15
+			reduce(89), // +, reduce: Expr15
+			reduce(89), // -, reduce: Expr15
+			reduce(89), // *, reduce: Expr15
+			reduce(89), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S362
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			reduce(88), // ], reduce: Expr15
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			reduce(88), // =, reduce: Expr15
+			reduce(88), // &&, reduce: Expr15
+			reduce(88), // ==, reduce: Expr15
+			reduce(88), // !=, reduce: Expr15
+			reduce(88), // <, reduce: Expr15
+			reduce(88), // >, reduce: Expr15
+			reduce(88), // <=, reduce: Expr15
+			reduce(88), // >=, reduce: Expr15
+			reduce(88), // +, reduce: Expr15
+			reduce(88), // -, reduce: Expr15
+			reduce(88), // *, reduce: Expr15
+			reduce(88), // /, reduce: Expr15
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S363
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(51), // error, reduce: BlockStmt
+			reduce(51), // }, reduce: BlockStmt
+			reduce(51), // ;, reduce: BlockStmt
+			reduce(51), // extern, reduce: BlockStmt
+			reduce(51), // static, reduce: BlockStmt
+			reduce(51), // ident, reduce: BlockStmt
+			reduce(51), // (, reduce: BlockStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(51), // int_lit, reduce: BlockStmt
+			reduce(51), // char_lit, reduce: BlockStmt
+			reduce(51), // typedef, reduce: BlockStmt
+			reduce(51), // const, reduce: BlockStmt
+			reduce(51), // return, reduce: BlockStmt
+			reduce(51), // {, reduce: BlockStmt
+			reduce(51), // if, reduce: BlockStmt
+			reduce(51), // else, reduce: BlockStmt
+			reduce(51), // while, reduce: BlockStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(51), // -, reduce: BlockStmt
+			nil,        // *
+			nil,        // /
+			reduce(51), // !, reduce: BlockStmt
+			reduce(51), // float_lit, reduce: BlockStmt
+			nil,        // comment
+		},
+	},
+	actionRow{ // S364
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			shift(221), // ;
+			nil,        // extern
+			nil,        // static
+			shift(116), // ident
+			shift(52),  // (
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			shift(54),  // int_lit
+			shift(55),  // char_lit
+			nil,        // typedef
+			nil,        // const
+			shift(227), // return
+			shift(228), // {
+			shift(229), // if
+			nil,        // else
+			shift(230), // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			shift(74),  // -
+			nil,        // *
+			nil,        // /
+			shift(77),  // !
+			shift(79),  // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S365
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // error
+			nil,        // }
+			nil,        // ;
+			nil,        // extern
+			nil,        // static
+			nil,        // ident
+			nil,        // (
+			reduce(28), // ), reduce: ArrayDecl
+			reduce(28), // ,, reduce: ArrayDecl
+			nil,        // [
+			nil,        // ]
+			nil,        // int_lit
+			nil,        // char_lit
+			nil,        // typedef
+			nil,        // const
+			nil,        // return
+			nil,        // {
+			nil,        // if
+			nil,        // else
+			nil,        // while
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			nil,        // -
+			nil,        // *
+			nil,        // /
+			nil,        // !
+			nil,        // float_lit
+			nil,        // comment
+		},
+	},
+	actionRow{ // S366
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(52), // error, reduce: MatchedStmt
+			reduce(52), // }, reduce: MatchedStmt
+			reduce(52), // ;, reduce: MatchedStmt
+			reduce(52), // extern, reduce: MatchedStmt
+			reduce(52), // static, reduce: MatchedStmt
+			reduce(52), // ident, reduce: MatchedStmt
+			reduce(52), // (, reduce: MatchedStmt
+			nil,        // )
+			nil,        // ,
+			nil,        // [
+			nil,        // ]
+			reduce(52), // int_lit, reduce: MatchedStmt
+			reduce(52), // char_lit, reduce: MatchedStmt
+			reduce(52), // typedef, reduce: MatchedStmt
+			reduce(52), // const, reduce: MatchedStmt
+			reduce(52), // return, reduce: MatchedStmt
+			reduce(52), // {, reduce: MatchedStmt
+			reduce(52), // if, reduce: MatchedStmt
+			reduce(52), // else, reduce: MatchedStmt
+			reduce(52), // while, reduce: MatchedStmt
+			nil,        // =
+			nil,        // &&
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+			nil,        // +
+			reduce(52), // -, reduce: MatchedStmt
+			nil,        // *
+			nil,        // /
+			reduce(52), // !, reduce: MatchedStmt
+			reduce(52), // float_lit, reduce: MatchedStmt
+			nil,        // comment
 		},
 	},
 }
@@ -3,24 +3,92 @@ package parser
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/mewmew/uc/gocc/errors"
+	gocctoken "github.com/mewmew/uc/gocc/token"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 )
 
-// NewError returns a user-friendly parse error.
-func NewError(err *errors.Error) error {
+// NewError returns a user-friendly parse error, with positional information
+// based on the given input source (which may be nil).
+//
+// The error format is as follows.
+//
+//    (file:line:column) error: unexpected ')', expected one of '(' or ';'
+//       return );
+//              ^
+func NewError(err *errors.Error, src *semerrors.Source) error {
 	if err.Err != nil {
 		return err.Err
 	}
-	// TODO: Add line:col positional tracking.
 	var expected []string
 	for _, tok := range err.ExpectedTokens {
 		if tok == "error" {
 			// Remove "error" production rule from the set of expected tokens.
 			continue
 		}
-		expected = append(expected, tok)
+		expected = append(expected, tokenName(tok))
 	}
 	sort.Strings(expected)
-	return fmt.Errorf("%d: unexpected %q, expected %q", err.ErrorToken.Pos.Offset, string(err.ErrorToken.Lit), expected)
+	tok := err.ErrorToken
+	pos := token.Pos(tok.Pos.Offset)
+	e := semerrors.Newf(pos, "unexpected %s, expected %s", describeToken(tok), describeExpected(expected))
+	if tok.Type != gocctoken.EOF {
+		// Underline the unexpected token.
+		e.Range(pos, pos+token.Pos(len(tok.Lit)))
+	}
+	e.Src = src
+	return e
+}
+
+// describeToken returns a user-friendly description of the given token.
+func describeToken(tok *gocctoken.Token) string {
+	switch tok.Type {
+	case gocctoken.INVALID:
+		return fmt.Sprintf("invalid token %q", string(tok.Lit))
+	case gocctoken.EOF:
+		return tokenName(gocctoken.TokMap.Id(tok.Type))
+	}
+	name := gocctoken.TokMap.Id(tok.Type)
+	if _, ok := literalNames[name]; ok {
+		return fmt.Sprintf("%s %q", tokenName(name), string(tok.Lit))
+	}
+	return tokenName(name)
+}
+
+// describeExpected returns a user-friendly description of the given set of
+// expected tokens.
+func describeExpected(names []string) string {
+	switch len(names) {
+	case 0:
+		return "end of file"
+	case 1:
+		return names[0]
+	default:
+		return fmt.Sprintf("one of %s or %s", strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+	}
+}
+
+// literalNames maps from Gocc token names of identifiers and basic literals to
+// user-friendly token names.
+var literalNames = map[string]string{
+	"ident":     token.Ident.String(),
+	"int_lit":   token.IntLit.String(),
+	"float_lit": token.FloatLit.String(),
+	"char_lit":  token.CharLit.String(),
+}
+
+// tokenName returns a user-friendly name of the given Gocc token name; e.g.
+// "identifier" for identifiers, and quoted source text for operators,
+// delimiters and keywords (e.g. `';'`).
+func tokenName(name string) string {
+	if friendly, ok := literalNames[name]; ok {
+		return friendly
+	}
+	if name == gocctoken.TokMap.Id(gocctoken.EOF) {
+		return "end of file"
+	}
+	return fmt.Sprintf("'%s'", name)
 }
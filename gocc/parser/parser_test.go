@@ -1,16 +1,21 @@
 package parser_test
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"reflect"
 	"testing"
 
 	"github.com/kr/pretty"
 	"github.com/mewmew/uc/ast"
-	"github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/gocc/parser"
 	"github.com/mewmew/uc/gocc/scanner"
+	handscanner "github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/token"
 )
 
@@ -76,6 +81,7 @@ func TestParser(t *testing.T) {
 											},
 										},
 									},
+									Semicolon: 33,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -96,6 +102,7 @@ func TestParser(t *testing.T) {
 											Rparen: 42,
 										},
 									},
+									Semicolon: 43,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -121,6 +128,7 @@ func TestParser(t *testing.T) {
 											Val:    "10",
 										},
 									},
+									Semicolon: 54,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -163,6 +171,7 @@ func TestParser(t *testing.T) {
 											Rparen: 72,
 										},
 									},
+									Semicolon: 73,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -192,6 +201,7 @@ func TestParser(t *testing.T) {
 											},
 										},
 									},
+									Semicolon: 88,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -216,6 +226,7 @@ func TestParser(t *testing.T) {
 											},
 										},
 									},
+									Semicolon: 100,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -241,6 +252,7 @@ func TestParser(t *testing.T) {
 											Val:    "28",
 										},
 									},
+									Semicolon: 114,
 								},
 							},
 							Rbrace: 116,
@@ -312,6 +324,7 @@ func TestParser(t *testing.T) {
 											Val:    "42",
 										},
 									},
+									Semicolon: 43,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -335,6 +348,7 @@ func TestParser(t *testing.T) {
 											},
 										},
 									},
+									Semicolon: 55,
 								},
 							},
 							Rbrace: 57,
@@ -422,6 +436,7 @@ func TestParser(t *testing.T) {
 												},
 											},
 										},
+										Semicolon: 55,
 									},
 								},
 								&ast.IfStmt{
@@ -453,6 +468,7 @@ func TestParser(t *testing.T) {
 												},
 											},
 										},
+										Semicolon: 76,
 									},
 								},
 							},
@@ -531,6 +547,7 @@ func TestParser(t *testing.T) {
 												Val:    "1",
 											},
 										},
+										Semicolon: 43,
 									},
 									Else: &ast.ExprStmt{
 										X: &ast.BinaryExpr{
@@ -546,6 +563,7 @@ func TestParser(t *testing.T) {
 												Val:    "2",
 											},
 										},
+										Semicolon: 58,
 									},
 								},
 							},
@@ -644,6 +662,7 @@ func TestParser(t *testing.T) {
 											Val:    "42",
 										},
 									},
+									Semicolon: 57,
 								},
 								&ast.ExprStmt{
 									X: &ast.BinaryExpr{
@@ -715,6 +734,7 @@ func TestParser(t *testing.T) {
 											},
 										},
 									},
+									Semicolon: 123,
 								},
 							},
 							Rbrace: 164,
@@ -870,7 +890,8 @@ func TestParser(t *testing.T) {
 							Lbrace: 13,
 							Items: []ast.BlockItem{
 								&ast.ReturnStmt{
-									Return: 17,
+									Return:    17,
+									Semicolon: 23,
 								},
 							},
 							Rbrace: 25,
@@ -907,6 +928,7 @@ func TestParser(t *testing.T) {
 										Kind:   token.IntLit,
 										Val:    "42",
 									},
+									Semicolon: 53,
 								},
 							},
 							Rbrace: 55,
@@ -945,6 +967,7 @@ func TestParser(t *testing.T) {
 										Lparen: 77,
 										Rparen: 78,
 									},
+									Semicolon: 79,
 								},
 								&ast.ExprStmt{
 									X: &ast.CallExpr{
@@ -955,6 +978,7 @@ func TestParser(t *testing.T) {
 										Lparen: 84,
 										Rparen: 85,
 									},
+									Semicolon: 86,
 								},
 							},
 							Rbrace: 88,
@@ -1038,6 +1062,7 @@ func TestParser(t *testing.T) {
 													Val:    "42",
 												},
 											},
+											Semicolon: 57,
 										},
 									},
 								},
@@ -1067,6 +1092,7 @@ func TestParser(t *testing.T) {
 													Val:    "42",
 												},
 											},
+											Semicolon: 83,
 										},
 									},
 								},
@@ -1152,6 +1178,7 @@ func TestParser(t *testing.T) {
 													Val:    "4711",
 												},
 											},
+											Semicolon: 133,
 										},
 										Else: &ast.ExprStmt{
 											X: &ast.BinaryExpr{
@@ -1167,6 +1194,7 @@ func TestParser(t *testing.T) {
 													Val:    "42",
 												},
 											},
+											Semicolon: 148,
 										},
 									},
 								},
@@ -1177,6 +1205,151 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			// NOTE: Declarations with multiple declarators are valid since the
+			// grammar was extended; pe07.c no longer contains a syntax error.
+			path: "../../testdata/incorrect/parser/pe07.c",
+			want: &ast.File{
+				Decls: []ast.Decl{
+					&ast.VarDecl{
+						VarType: &ast.Ident{
+							NamePos: 67,
+							Name:    "int",
+						},
+						VarName: &ast.Ident{
+							NamePos: 71,
+							Name:    "a",
+						},
+					},
+					&ast.VarDecl{
+						VarType: &ast.Ident{
+							NamePos: 67,
+							Name:    "int",
+						},
+						VarName: &ast.Ident{
+							NamePos: 74,
+							Name:    "b",
+						},
+					},
+					&ast.FuncDecl{
+						FuncType: &ast.FuncType{
+							Result: &ast.Ident{
+								NamePos: 105,
+								Name:    "int",
+							},
+							Lparen: 113,
+							Params: []*ast.VarDecl{
+								{
+									VarType: &ast.Ident{
+										NamePos: 114,
+										Name:    "void",
+									},
+								},
+							},
+							Rparen: 118,
+						},
+						FuncName: &ast.Ident{
+							NamePos: 109,
+							Name:    "main",
+						},
+						Body: &ast.BlockStmt{
+							Lbrace: 120,
+							Items: []ast.BlockItem{
+								&ast.EmptyStmt{
+									Semicolon: 124,
+								},
+							},
+							Rbrace: 126,
+						},
+					},
+				},
+			},
+		},
+		{
+			path: "../../testdata/extra/parser/multi-declarators.c",
+			want: &ast.File{
+				Decls: []ast.Decl{
+					&ast.VarDecl{
+						VarType: &ast.Ident{
+							NamePos: 41,
+							Name:    "int",
+						},
+						VarName: &ast.Ident{
+							NamePos: 45,
+							Name:    "x",
+						},
+					},
+					&ast.VarDecl{
+						VarType: &ast.ArrayType{
+							Elem: &ast.Ident{
+								NamePos: 41,
+								Name:    "int",
+							},
+							Lbracket: 49,
+							Len:      10,
+							Rbracket: 52,
+						},
+						VarName: &ast.Ident{
+							NamePos: 48,
+							Name:    "y",
+						},
+					},
+					&ast.FuncDecl{
+						FuncType: &ast.FuncType{
+							Result: &ast.Ident{
+								NamePos: 56,
+								Name:    "void",
+							},
+							Lparen: 62,
+							Params: []*ast.VarDecl{
+								{
+									VarType: &ast.Ident{
+										NamePos: 63,
+										Name:    "void",
+									},
+								},
+							},
+							Rparen: 67,
+						},
+						FuncName: &ast.Ident{
+							NamePos: 61,
+							Name:    "f",
+						},
+						Body: &ast.BlockStmt{
+							Lbrace: 69,
+							Items: []ast.BlockItem{
+								&ast.VarDecl{
+									VarType: &ast.Ident{
+										NamePos: 72,
+										Name:    "char",
+									},
+									VarName: &ast.Ident{
+										NamePos: 77,
+										Name:    "a",
+									},
+								},
+								&ast.VarDecl{
+									VarType: &ast.ArrayType{
+										Elem: &ast.Ident{
+											NamePos: 72,
+											Name:    "char",
+										},
+										Lbracket: 81,
+										Len:      4,
+										Rbracket: 83,
+									},
+									VarName: &ast.Ident{
+										NamePos: 80,
+										Name:    "b",
+									},
+								},
+							},
+							Rbrace: 86,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, g := range golden {
@@ -1207,35 +1380,45 @@ func TestParserError(t *testing.T) {
 	}{
 		{
 			path: "../../testdata/incorrect/parser/pe01.c",
-			want: `102: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit"]`,
+			want: `(../../testdata/incorrect/parser/pe01.c:5:12) error: unexpected ')', expected one of '!', '(', '-', character literal, floating-point literal, identifier or integer literal
+  a = (a + ) * a;   //  Unexpected token ')'
+           ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe02.c",
-			want: `112: unexpected "}", expected ["!=" "&&" "*" "+" "-" "/" ";" "<" "<=" "=" "==" ">" ">="]`,
+			want: `(../../testdata/incorrect/parser/pe02.c:4:1) error: unexpected '}', expected one of '!=', '&&', '*', '+', '-', '/', ';', '<', '<=', '=', '==', '>' or '>='
+}
+^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe03.c",
-			want: `129: unexpected "}", expected ["!" "(" "-" ";" "char_lit" "ident" "if" "int_lit" "return" "while" "{"]`,
+			want: `(../../testdata/incorrect/parser/pe03.c:6:1) error: unexpected '}', expected one of '!', '(', '-', ';', 'if', 'return', 'while', '{', character literal, floating-point literal, identifier or integer literal
+}
+^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe04.c",
-			want: `111: unexpected "a", expected ["!=" "&&" "(" "*" "+" "-" "/" ";" "<" "<=" "=" "==" ">" ">=" "["]`,
+			want: `(../../testdata/incorrect/parser/pe04.c:5:20) error: unexpected identifier "a", expected one of '!=', '&&', '(', '*', '+', '-', '/', ';', '<', '<=', '=', '==', '>', '>=' or '['
+  if (a != 0) then a=1; // Shouldn't be a 'then' here
+                   ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe05.c",
-			want: `71: unexpected "else", expected ["ident"]`,
+			want: `(../../testdata/incorrect/parser/pe05.c:3:5) error: unexpected 'else', expected identifier
+int else;  // Bad identifier
+    ^~~~`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe06.c",
-			want: `73: unexpected "b", expected ["(" ";" "["]`,
-		},
-		{
-			path: "../../testdata/incorrect/parser/pe07.c",
-			want: `72: unexpected ",", expected ["(" ";" "["]`,
+			want: `(../../testdata/incorrect/parser/pe06.c:3:7) error: unexpected identifier "b", expected one of '(', ',', ';' or '['
+int a b; // Unexpected identifier
+      ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe08.c",
-			want: `86: unexpected "42", expected [";" "{"]`,
+			want: `(../../testdata/incorrect/parser/pe08.c:3:6) error: unexpected integer literal "42", expected one of ';' or '{'
+     42; // Procedure definition must have {}
+     ^~`,
 		},
 		{
 			// TODO: The ';' at offset 80 in pe09.c shuold probably be a '{', as
@@ -1243,19 +1426,27 @@ func TestParserError(t *testing.T) {
 			//
 			// Update this test case if the test file is fixed.
 			path: "../../testdata/incorrect/parser/pe09.c",
-			want: `87: unexpected ";", expected ["$" "ident" "typedef"]`,
+			want: `(../../testdata/incorrect/parser/pe09.c:3:6) error: unexpected ';', expected one of 'const', 'extern', 'static', 'typedef', end of file or identifier
+     ; // '}' missing 
+     ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe10.c",
-			want: `135: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit"]`,
+			want: `(../../testdata/incorrect/parser/pe10.c:8:13) error: unexpected ')', expected one of '!', '(', '-', character literal, floating-point literal, identifier or integer literal
+  foo(1, 2, ); // Unexpected token ')'
+            ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe11.c",
-			want: `70: unexpected "(", expected ["ident"]`,
+			want: `(../../testdata/incorrect/parser/pe11.c:3:4) error: unexpected '(', expected identifier
+foo(0);
+   ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe12.c",
-			want: `77: unexpected "{", expected ["(" ";" "["]`,
+			want: `(../../testdata/incorrect/parser/pe12.c:3:11) error: unexpected '{', expected one of '(', ',', ';' or '['
+void fred { // Missing parameter list
+          ^`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe13.c",
@@ -1280,21 +1471,150 @@ func TestParserError(t *testing.T) {
 		},
 	}
 
+	semerrors.UseColor = false
+
 	for _, g := range golden {
 		log.Println("path:", g.path)
-		s, err := scanner.Open(g.path)
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		s := scanner.NewFromBytes(buf)
+		src := semerrors.NewSource(g.path, string(buf))
+		p := parser.NewParser()
+		_, err = p.ParseFile(s, src)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != g.want {
+			t.Errorf("%q: error mismatch; expected `%v`, got `%v`", g.path, g.want, got)
+		}
+	}
+}
+
+func TestParserRecovery(t *testing.T) {
+	var golden = []struct {
+		path string
+		// Names of the top-level declarations of the partial file, and the
+		// number of block items of the function bodies.
+		decls []string
+		items []int
+		want  string
+	}{
+		{
+			path:  "../../testdata/extra/parser/multiple-errors.c",
+			decls: []string{"f", "g", "h", "main"},
+			items: []int{1, 0, 1, 1},
+			want: `(../../testdata/extra/parser/multiple-errors.c:2:11) error: unexpected ')', expected one of '!', '(', '-', character literal, floating-point literal, identifier or integer literal
+ a = (a + ) * a;
+          ^
+(../../testdata/extra/parser/multiple-errors.c:8:1) error: unexpected '}', expected one of '!=', '&&', '*', '+', '-', '/', ';', '<', '<=', '=', '==', '>' or '>='
+}
+^
+(../../testdata/extra/parser/multiple-errors.c:10:7) error: unexpected identifier "y", expected one of '(', ',', ';' or '['
+int x y;
+      ^
+(../../testdata/extra/parser/multiple-errors.c:13:9) error: unexpected ';', expected one of '!', '(', '-', character literal, floating-point literal, identifier or integer literal
+ b = b +;
+        ^`,
+		},
+		{
+			path:  "../../testdata/extra/parser/missing-rbrace.c",
+			decls: []string{"x", "f"},
+			items: []int{1},
+			want: `(../../testdata/extra/parser/missing-rbrace.c:9:12) error: unexpected end of file, expected one of '!', '(', '-', ';', 'const', 'extern', 'if', 'return', 'static', 'typedef', 'while', '{', '}', character literal, floating-point literal, identifier or integer literal
+ return x;
+           ^`,
+		},
+	}
+
+	semerrors.UseColor = false
+
+	for _, g := range golden {
+		log.Println("path:", g.path)
+		buf, err := ioutil.ReadFile(g.path)
 		if err != nil {
 			t.Error(err)
 			continue
 		}
+		s := scanner.NewFromBytes(buf)
+		src := semerrors.NewSource(g.path, string(buf))
 		p := parser.NewParser()
-		_, err = p.Parse(s)
+		file, err := p.ParseFile(s, src)
 		got := ""
 		if err != nil {
-			if e, ok := err.(*errors.Error); ok {
-				// Unwrap Gocc error.
-				err = parser.NewError(e)
+			got = err.Error()
+		}
+		if got != g.want {
+			t.Errorf("%q: error mismatch; expected `%v`, got `%v`", g.path, g.want, got)
+		}
+		if file == nil {
+			t.Errorf("%q: unable to recover from syntax errors", g.path)
+			continue
+		}
+		var decls []string
+		var items []int
+		for _, decl := range file.Decls {
+			decls = append(decls, decl.Name().String())
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Body != nil {
+				items = append(items, len(decl.Body.Items))
 			}
+		}
+		if !reflect.DeepEqual(decls, g.decls) {
+			t.Errorf("%q: declarations mismatch; expected %v, got %v", g.path, g.decls, decls)
+		}
+		if !reflect.DeepEqual(items, g.items) {
+			t.Errorf("%q: block items mismatch; expected %v, got %v", g.path, g.items, items)
+		}
+	}
+}
+
+func TestParserLexError(t *testing.T) {
+	var golden = []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../../testdata/incorrect/lexer/bad.c",
+			want: `(../../testdata/incorrect/lexer/bad.c:12:3) error: unexpected eof in block comment
+  /*
+  ^`,
+		},
+		{
+			path: "../../testdata/incorrect/lexer/long-char.c",
+			want: `(../../testdata/incorrect/lexer/long-char.c:4:7) error: unterminated character literal
+  c = 'cc'; // Not OK
+      ^
+(../../testdata/incorrect/lexer/long-char.c:4:10) error: unterminated character literal
+  c = 'cc'; // Not OK
+         ^`,
+		},
+		{
+			path: "../../testdata/incorrect/lexer/stray-char.c",
+			want: `(../../testdata/incorrect/lexer/stray-char.c:3:9) error: unexpected U+0040 '@'
+  x = 1 @ 2; // Not OK
+        ^`,
+		},
+	}
+
+	semerrors.UseColor = false
+
+	for _, g := range golden {
+		log.Println("path:", g.path)
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		// Use the hand-written lexer, which records lexical errors.
+		s := handscanner.NewFromBytes(buf)
+		src := semerrors.NewSource(g.path, string(buf))
+		p := parser.NewParser()
+		_, err = p.ParseFile(s, src)
+		got := ""
+		if err != nil {
 			got = err.Error()
 		}
 		if got != g.want {
@@ -1303,4 +1623,147 @@ func TestParserError(t *testing.T) {
 	}
 }
 
+func TestParserComments(t *testing.T) {
+	const path = "../../testdata/extra/parser/comments.c"
+	// Number of comment groups.
+	const wantGroups = 9
+	// Doc comments of declarations, in source order.
+	const want = `x: "x is documented\nby a comment group.\n"
+y: ""
+z: ""
+myint: "Block comment doc.\n"
+w: ""
+f: "f is documented.\n"
+a: "a is documented.\n"
+`
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanners := []struct {
+		name string
+		s    parser.Scanner
+	}{
+		{name: "gocc", s: scanner.NewFromBytes(buf)},
+		{name: "hand", s: handscanner.NewFromBytes(buf)},
+	}
+	for _, s := range scanners {
+		file, err := parser.NewParser().ParseFile(s.s, nil)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		if len(file.Comments) != wantGroups {
+			t.Errorf("%q (%s scanner): number of comment groups mismatch; expected %d, got %d", path, s.name, wantGroups, len(file.Comments))
+		}
+		got := new(bytes.Buffer)
+		f := func(n ast.Node) error {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				fmt.Fprintf(got, "%v: %q\n", n.Name(), n.Doc.Text())
+			case *ast.TypeDef:
+				fmt.Fprintf(got, "%v: %q\n", n.Name(), n.Doc.Text())
+			case *ast.VarDecl:
+				// Skip anonymous parameters.
+				if n.VarName != nil {
+					fmt.Fprintf(got, "%v: %q\n", n.Name(), n.Doc.Text())
+				}
+			}
+			return nil
+		}
+		// Visit declarations in source order.
+		if err := astutil.WalkBeforeAfter(file, f, func(ast.Node) error { return nil }); err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != want {
+			t.Errorf("%q (%s scanner): doc comments mismatch; expected `%v`, got `%v`", path, s.name, want, got)
+		}
+	}
+}
+
+func TestParserFileSet(t *testing.T) {
+	// Inputs of the file set, the last of which contains an undeclared
+	// identifier.
+	inputs := []struct {
+		path  string
+		input string
+	}{
+		{path: "a.c", input: "int x;\n"},
+		{path: "b.c", input: "int y;\n// f is documented.\nint f(void) {\n\treturn y;\n}\n"},
+		{path: "c.c", input: "int g(void) {\n\treturn z;\n}\n"},
+	}
+	// Source positions of the declared identifiers, and the identifiers of the
+	// return statements.
+	const want = `x: a.c:1:5
+y: b.c:1:5
+f: b.c:3:5
+y: b.c:4:9
+g: c.c:1:5
+z: c.c:2:9
+`
+	const wantDoc = "f is documented.\n"
+	const wantErr = `(c.c:2:9) error: undeclared identifier "z"
+ return z;
+        ^`
+
+	semerrors.UseColor = false
+
+	newScanners := []struct {
+		name string
+		new  func(buf []byte) parser.Scanner
+	}{
+		{name: "gocc", new: func(buf []byte) parser.Scanner { return scanner.NewFromBytes(buf) }},
+		{name: "hand", new: func(buf []byte) parser.Scanner { return handscanner.NewFromBytes(buf) }},
+	}
+	for _, s := range newScanners {
+		fset := token.NewFileSet()
+		got := new(bytes.Buffer)
+		for _, in := range inputs {
+			src := semerrors.AddSource(fset, in.path, in.input)
+			file, err := parser.NewParser().ParseFile(s.new([]byte(in.input)), src)
+			if err != nil {
+				t.Errorf("%q (%s scanner): %v", in.path, s.name, err)
+				continue
+			}
+			f := func(n ast.Node) (bool, error) {
+				switch n := n.(type) {
+				case ast.Decl:
+					// Skip anonymous parameters.
+					if name := n.Name(); name != nil {
+						fmt.Fprintf(got, "%v: %v\n", name, fset.Position(name.Start()))
+					}
+				case *ast.ReturnStmt:
+					fmt.Fprintf(got, "%v: %v\n", n.Result, fset.Position(n.Result.Start()))
+				}
+				return true, nil
+			}
+			if err := astutil.Inspect(file, f); err != nil {
+				t.Fatal(err)
+			}
+			if in.path == "b.c" {
+				fn := file.Decls[1].(*ast.FuncDecl)
+				if doc := fn.Doc.Text(); doc != wantDoc {
+					t.Errorf("%q (%s scanner): doc comment mismatch; expected %q, got %q", in.path, s.name, wantDoc, doc)
+				}
+			}
+			if in.path == "c.c" {
+				_, err := sem.Check(file)
+				errs, ok := err.(semerrors.List)
+				if !ok {
+					t.Errorf("%q (%s scanner): expected semantic error, got %v", in.path, s.name, err)
+					continue
+				}
+				errs.SetSource(src)
+				if errs.Error() != wantErr {
+					t.Errorf("%q (%s scanner): error mismatch; expected `%v`, got `%v`", in.path, s.name, wantErr, errs.Error())
+				}
+			}
+		}
+		if got.String() != want {
+			t.Errorf("%s scanner: positions mismatch; expected `%v`, got `%v`", s.name, want, got)
+		}
+	}
+}
+
 // TODO: add benchmark
@@ -0,0 +1,234 @@
+package parser
+
+import (
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astx"
+	parseError "github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/gocc/token"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	uctoken "github.com/mewmew/uc/token"
+)
+
+// ParseFile parses the given input into a file, recovering from syntax errors
+// at declaration and statement boundaries. The syntax errors encountered, and
+// the lexical errors recorded by scanners providing an Errors method (such as
+// the hand-written scanner), are returned as a semerrors.List, with positional
+// information based on the given input source (which may be nil).
+//
+// The comments recorded by scanners providing Comments and Input methods (such
+// as the Gocc generated and the hand-written scanners) are added to the
+// returned file, and doc comments are attached to the declarations they
+// precede.
+//
+// The positions of the returned file are relative to the base of the file of
+// the given input source; i.e. the base is added to the token offsets of the
+// scanner.
+//
+// Declarations and statements containing syntax errors are omitted from the
+// returned partial file. If the parser is unable to recover from a syntax error
+// (e.g. unexpected end of file), the partial file contains the top-level
+// declarations parsed before the syntax error.
+//
+// NOTE: The error recovery of Parse is unable to handle every state of the
+// grammar; use ParseFile to parse input which may contain syntax errors.
+func (p *Parser) ParseFile(scanner Scanner, src *semerrors.Source) (*ast.File, error) {
+	var base uctoken.Pos
+	if src != nil {
+		base = uctoken.Pos(src.File.Base())
+	}
+	file, errs, err := p.parseFile(&baseScanner{Scanner: scanner, base: base}, src)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	if s, ok := scanner.(errorScanner); ok {
+		// Report lexical errors.
+		for _, e := range s.Errors() {
+			e.Pos += base
+			e.Start += base
+			e.End += base
+			errs = append(errs, e)
+		}
+		errs.SetSource(src)
+		errs.Sort()
+	}
+	if s, ok := scanner.(commentScanner); ok {
+		// Record comments and attach doc comments to declarations.
+		comments := s.Comments()
+		for _, c := range comments {
+			c.Slash += base
+		}
+		astx.AddComments(file, comments, s.Input(), base)
+	}
+	return file, errs.Err()
+}
+
+// A baseScanner adds a base position to the token offsets of a scanner, and
+// keeps track of the lexical errors recorded by the scanner.
+type baseScanner struct {
+	Scanner
+	// Base position added to token offsets.
+	base uctoken.Pos
+	// Reports whether a lexical error was recorded while scanning the last
+	// token; i.e. whether the last token directly follows a skipped error
+	// token.
+	lexErr bool
+}
+
+// Scan lexes and returns the next token of the source input.
+func (s *baseScanner) Scan() *token.Token {
+	nerrs := 0
+	es, ok := s.Scanner.(errorScanner)
+	if ok {
+		nerrs = len(es.Errors())
+	}
+	tok := s.Scanner.Scan()
+	s.lexErr = ok && len(es.Errors()) > nerrs
+	if s.base == 0 {
+		return tok
+	}
+	// Shift a copy of the token, as scanners may return shared tokens (e.g.
+	// end-of-file tokens).
+	t := *tok
+	t.Offset += int(s.base)
+	return &t
+}
+
+// An errorScanner is a scanner which records lexical errors.
+type errorScanner interface {
+	// Errors returns the lexical errors encountered while scanning.
+	Errors() semerrors.List
+}
+
+// A commentScanner is a scanner which records comments.
+type commentScanner interface {
+	// Comments returns the comments encountered while scanning, in source
+	// order.
+	Comments() []*ast.Comment
+	// Input returns the source input.
+	Input() string
+}
+
+// parseFile parses the given input into a file, recovering from syntax errors.
+// The returned error is non-nil only if an unexpected error occurred (i.e. not
+// a syntax error).
+func (p *Parser) parseFile(scanner *baseScanner, src *semerrors.Source) (*ast.File, semerrors.List, error) {
+	var errs semerrors.List
+	// Number of tokens to shift before reporting syntax errors again, as
+	// syntax errors directly following error recovery tend to be cascading
+	// errors.
+	quiet := 0
+	// report records the syntax error of the current state, unless reported at
+	// the same position as the previous syntax error, or directly following a
+	// lexical error.
+	report := func() error {
+		if scanner.lexErr {
+			// The syntax error is caused by the error token skipped by the
+			// scanner, which has already been reported as a lexical error.
+			return nil
+		}
+		e := p.newError(nil).(*parseError.Error)
+		if n := len(errs); n > 0 && errs[n-1].Pos == uctoken.Pos(e.ErrorToken.Pos.Offset) {
+			return nil
+		}
+		err := NewError(e, src)
+		d, ok := err.(*semerrors.Diagnostic)
+		if !ok {
+			return errutil.Err(err)
+		}
+		errs.Add(d)
+		return nil
+	}
+
+	// NOTE: The parse loop is based on Parser.Parse, but records the set of
+	// expected tokens before the parser stack is unwound during error
+	// recovery, and uses its own error recovery.
+	p.Reset()
+	p.nextToken = scanner.Scan()
+	for {
+		action := actionTab[p.stack.top()].actions[p.nextToken.Type]
+		if action == nil {
+			if quiet == 0 {
+				if err := report(); err != nil {
+					return nil, nil, errutil.Err(err)
+				}
+			}
+			quiet = 3
+			// Top-level declarations parsed before the syntax error, in case
+			// the parser is unable to recover.
+			decls := p.decls()
+			if !p.recover(scanner) {
+				if p.nextToken.Type == token.EOF {
+					// Report unexpected end of file.
+					if err := report(); err != nil {
+						return nil, nil, errutil.Err(err)
+					}
+				}
+				// Unable to recover from syntax error.
+				return &ast.File{Decls: decls}, errs, nil
+			}
+			if action = actionTab[p.stack.top()].actions[p.nextToken.Type]; action == nil {
+				panic("Error recovery led to invalid action")
+			}
+		}
+		switch act := action.(type) {
+		case accept:
+			res := p.stack.popN(1)[0]
+			file, ok := res.(*ast.File)
+			if !ok {
+				return nil, nil, errutil.Newf("invalid file type; expected *ast.File, got %T", res)
+			}
+			return file, errs, nil
+		case shift:
+			p.stack.push(int(act), p.nextToken)
+			p.nextToken = scanner.Scan()
+			if quiet > 0 {
+				quiet--
+			}
+		case reduce:
+			prod := productionsTable[int(act)]
+			attrib, err := prod.ReduceFunc(p.stack.popN(prod.NumSymbols), p.Context)
+			if err != nil {
+				return nil, nil, errutil.Err(err)
+			}
+			p.stack.push(gotoTab[p.stack.top()][prod.NTType], attrib)
+		default:
+			panic("unknown action: " + action.String())
+		}
+	}
+}
+
+// decls returns the top-level declarations parsed so far; i.e. the declaration
+// list at the bottom of the parser stack, if any.
+func (p *Parser) decls() []ast.Decl {
+	if p.stack.topIndex() < 1 {
+		return nil
+	}
+	decls, _ := p.stack.attrib[1].([]ast.Decl)
+	return decls
+}
+
+// recover recovers from a syntax error by unwinding the parser stack to the
+// topmost state which may shift the error token, and skipping input tokens
+// until a token which is valid after the error token is reached. The boolean
+// return value indicates success.
+func (p *Parser) recover(scanner Scanner) bool {
+	errorType := token.TokMap.Type("error")
+	for {
+		if act, ok := actionTab[p.stack.top()].actions[errorType].(shift); ok {
+			p.stack.push(int(act), nil)
+			break
+		}
+		if p.stack.topIndex() == 0 {
+			return false
+		}
+		p.stack.popN(1)
+	}
+	for actionTab[p.stack.top()].actions[p.nextToken.Type] == nil {
+		if p.nextToken.Type == token.EOF {
+			return false
+		}
+		p.nextToken = scanner.Scan()
+	}
+	return true
+}
@@ -8,14 +8,62 @@ import (
 	"strings"
 
 	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/gocc/lexer"
 	"github.com/mewmew/uc/gocc/token"
+	uctoken "github.com/mewmew/uc/token"
 )
 
 // Scanner represents the lexer interface used by the Gocc parser.
 type Scanner interface {
 	// Scan lexes and returns the next token of the source input.
 	Scan() *token.Token
+	// Comments returns the comments encountered while scanning, in source
+	// order.
+	Comments() []*ast.Comment
+	// Input returns the source input.
+	Input() string
+}
+
+// a scanner is a lexer which implements the Gocc Scanner interface, and records
+// comments.
+type scanner struct {
+	// Gocc generated lexer.
+	*lexer.Lexer
+	// Source input.
+	input string
+	// Comments encountered while scanning.
+	comments []*ast.Comment
+}
+
+// Ensure that scanner implements the Gocc Scanner interface.
+var _ Scanner = &scanner{}
+
+// commentType is the token type of comments.
+var commentType = token.TokMap.Type("comment")
+
+// Scan lexes and returns the next token of the source input.
+func (s *scanner) Scan() *token.Token {
+	for {
+		tok := s.Lexer.Scan()
+		if tok.Type != commentType {
+			return tok
+		}
+		// Record comment and skip the comment token. The terminating line break
+		// of line comments is not part of the comment.
+		text := strings.TrimRight(string(tok.Lit), "\r\n")
+		s.comments = append(s.comments, &ast.Comment{Slash: uctoken.Pos(tok.Offset), Text: text})
+	}
+}
+
+// Comments returns the comments encountered while scanning, in source order.
+func (s *scanner) Comments() []*ast.Comment {
+	return s.comments
+}
+
+// Input returns the source input.
+func (s *scanner) Input() string {
+	return s.input
 }
 
 const (
@@ -68,5 +116,5 @@ func NewFromBytes(input []byte) Scanner {
 		input = append(input, '\n')
 	}
 
-	return lexer.NewLexer(input)
+	return &scanner{Lexer: lexer.NewLexer(input), input: string(input)}
 }
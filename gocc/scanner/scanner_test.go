@@ -69,7 +69,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 143},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 221},
 				},
@@ -465,7 +465,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 1024},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					// Note, a new line character has been inserted to ensure that
 					// the file ends with a new line; thus the actual offset of the
@@ -569,7 +569,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 66},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 68},
 				},
@@ -730,7 +730,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 35},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					// Note, a new line character has been inserted to ensure that
 					// the file ends with a new line; thus the actual offset of the
@@ -784,7 +784,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 20},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 23},
 				},
@@ -925,7 +925,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 157},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 159},
 				},
@@ -1086,7 +1086,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 114},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 117},
 				},
@@ -1302,7 +1302,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 105},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 107},
 				},
@@ -1603,7 +1603,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 116},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 118},
 				},
@@ -1654,7 +1654,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 308},
 				},
 				{
-					Type: token.TokMap.Type("$"),
+					Type: token.EOF,
 					Lit:  []byte(""),
 					Pos:  token.Pos{Offset: 313},
 				},
@@ -1,9 +1,12 @@
-// generated by gocc; DO NOT EDIT.
+// Code generated by gocc; DO NOT EDIT.
 
 package token
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 )
 
 type Token struct {
@@ -20,13 +23,20 @@ const (
 )
 
 type Pos struct {
-	Offset int
-	Line   int
-	Column int
+	Offset  int
+	Line    int
+	Column  int
+	Context Context
 }
 
-func (this Pos) String() string {
-	return fmt.Sprintf("Pos(offset=%d, line=%d, column=%d)", this.Offset, this.Line, this.Column)
+func (p Pos) String() string {
+	// If the context provides a filename, provide a human-readable File:Line:Column representation.
+	switch src := p.Context.(type) {
+	case Sourcer:
+		return fmt.Sprintf("%s:%d:%d", src.Source(), p.Line, p.Column)
+	default:
+		return fmt.Sprintf("Pos(offset=%d, line=%d, column=%d)", p.Offset, p.Line, p.Column)
+	}
 }
 
 type TokenMap struct {
@@ -34,47 +44,118 @@ type TokenMap struct {
 	idMap   map[string]Type
 }
 
-func (this TokenMap) Id(tok Type) string {
-	if int(tok) < len(this.typeMap) {
-		return this.typeMap[tok]
+func (m TokenMap) Id(tok Type) string {
+	if int(tok) < len(m.typeMap) {
+		return m.typeMap[tok]
 	}
 	return "unknown"
 }
 
-func (this TokenMap) Type(tok string) Type {
-	if typ, exist := this.idMap[tok]; exist {
+func (m TokenMap) Type(tok string) Type {
+	if typ, exist := m.idMap[tok]; exist {
 		return typ
 	}
 	return INVALID
 }
 
-func (this TokenMap) TokenString(tok *Token) string {
-	//TODO: refactor to print pos & token string properly
-	return fmt.Sprintf("%s(%d,%s)", this.Id(tok.Type), tok.Type, tok.Lit)
+func (m TokenMap) TokenString(tok *Token) string {
+	return fmt.Sprintf("%s(%d,%s)", m.Id(tok.Type), tok.Type, tok.Lit)
+}
+
+func (m TokenMap) StringType(typ Type) string {
+	return fmt.Sprintf("%s(%d)", m.Id(typ), typ)
+}
+
+// Equals returns returns true if the token Type and Lit are matches.
+func (t *Token) Equals(rhs interface{}) bool {
+	switch rhsT := rhs.(type) {
+	case *Token:
+		return t == rhsT || (t.Type == rhsT.Type && bytes.Equal(t.Lit, rhsT.Lit))
+	default:
+		return false
+	}
+}
+
+// CharLiteralValue returns the string value of the char literal.
+func (t *Token) CharLiteralValue() string {
+	return string(t.Lit[1 : len(t.Lit)-1])
+}
+
+// Float32Value returns the float32 value of the token or an error if the token literal does not
+// denote a valid float32.
+func (t *Token) Float32Value() (float32, error) {
+	if v, err := strconv.ParseFloat(string(t.Lit), 32); err != nil {
+		return 0, err
+	} else {
+		return float32(v), nil
+	}
 }
 
-func (this TokenMap) StringType(typ Type) string {
-	return fmt.Sprintf("%s(%d)", this.Id(typ), typ)
+// Float64Value returns the float64 value of the token or an error if the token literal does not
+// denote a valid float64.
+func (t *Token) Float64Value() (float64, error) {
+	return strconv.ParseFloat(string(t.Lit), 64)
+}
+
+// IDValue returns the string representation of an identifier token.
+func (t *Token) IDValue() string {
+	return string(t.Lit)
+}
+
+// Int32Value returns the int32 value of the token or an error if the token literal does not
+// denote a valid float64.
+func (t *Token) Int32Value() (int32, error) {
+	if v, err := strconv.ParseInt(string(t.Lit), 10, 64); err != nil {
+		return 0, err
+	} else {
+		return int32(v), nil
+	}
+}
+
+// Int64Value returns the int64 value of the token or an error if the token literal does not
+// denote a valid float64.
+func (t *Token) Int64Value() (int64, error) {
+	return strconv.ParseInt(string(t.Lit), 10, 64)
+}
+
+// UTF8Rune decodes the UTF8 rune in the token literal. It returns utf8.RuneError if
+// the token literal contains an invalid rune.
+func (t *Token) UTF8Rune() (rune, error) {
+	r, _ := utf8.DecodeRune(t.Lit)
+	if r == utf8.RuneError {
+		err := fmt.Errorf("Invalid rune")
+		return r, err
+	}
+	return r, nil
+}
+
+// StringValue returns the string value of the token literal.
+func (t *Token) StringValue() string {
+	return string(t.Lit[1 : len(t.Lit)-1])
 }
 
 var TokMap = TokenMap{
 	typeMap: []string{
 		"INVALID",
-		"$",
+		"␚",
 		"empty",
+		"error",
+		"}",
 		";",
+		"extern",
+		"static",
 		"ident",
 		"(",
 		")",
+		",",
 		"[",
 		"]",
 		"int_lit",
 		"char_lit",
 		"typedef",
-		",",
+		"const",
 		"return",
 		"{",
-		"}",
 		"if",
 		"else",
 		"while",
@@ -91,40 +172,48 @@ var TokMap = TokenMap{
 		"*",
 		"/",
 		"!",
+		"float_lit",
+		"comment",
 	},
 
 	idMap: map[string]Type{
-		"INVALID":  0,
-		"$":        1,
-		"empty":    2,
-		";":        3,
-		"ident":    4,
-		"(":        5,
-		")":        6,
-		"[":        7,
-		"]":        8,
-		"int_lit":  9,
-		"char_lit": 10,
-		"typedef":  11,
-		",":        12,
-		"return":   13,
-		"{":        14,
-		"}":        15,
-		"if":       16,
-		"else":     17,
-		"while":    18,
-		"=":        19,
-		"&&":       20,
-		"==":       21,
-		"!=":       22,
-		"<":        23,
-		">":        24,
-		"<=":       25,
-		">=":       26,
-		"+":        27,
-		"-":        28,
-		"*":        29,
-		"/":        30,
-		"!":        31,
+		"INVALID":   0,
+		"␚":         1,
+		"empty":     2,
+		"error":     3,
+		"}":         4,
+		";":         5,
+		"extern":    6,
+		"static":    7,
+		"ident":     8,
+		"(":         9,
+		")":         10,
+		",":         11,
+		"[":         12,
+		"]":         13,
+		"int_lit":   14,
+		"char_lit":  15,
+		"typedef":   16,
+		"const":     17,
+		"return":    18,
+		"{":         19,
+		"if":        20,
+		"else":      21,
+		"while":     22,
+		"=":         23,
+		"&&":        24,
+		"==":        25,
+		"!=":        26,
+		"<":         27,
+		">":         28,
+		"<=":        29,
+		">=":        30,
+		"+":         31,
+		"-":         32,
+		"*":         33,
+		"/":         34,
+		"!":         35,
+		"float_lit": 36,
+		"comment":   37,
 	},
 }
@@ -26,14 +26,23 @@ import (
 // related to lexing are recorded as error tokens with relevant position
 // information.
 func Parse(r io.Reader) ([]token.Token, error) {
+	input, err := ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString(input), nil
+}
+
+// ReadAll reads the input from r, decoding UTF-16 input to UTF-8 if prefixed
+// by a byte order mark. The UTF-8 byte order mark is stripped.
+func ReadAll(r io.Reader) (string, error) {
 	br := bufio.NewReader(r)
 	ur := newUnicodeReader(br)
 	buf, err := ioutil.ReadAll(ur)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	input := string(buf)
-	return ParseString(input), nil
+	return string(buf), nil
 }
 
 // ParseFile lexes the input read from path into a slice of tokens. Potential
@@ -99,7 +108,7 @@ func (l *lexer) errorf(format string, args ...interface{}) {
 	tok := token.Token{
 		Kind: token.Error,
 		Val:  err,
-		Pos:  l.start,
+		Pos:  token.Pos(l.start),
 	}
 	l.tokens = append(l.tokens, tok)
 }
@@ -111,7 +120,7 @@ func (l *lexer) errorfCur(format string, args ...interface{}) {
 	tok := token.Token{
 		Kind: token.Error,
 		Val:  err,
-		Pos:  l.cur - width,
+		Pos:  token.Pos(l.cur - width),
 	}
 	l.tokens = append(l.tokens, tok)
 }
@@ -144,7 +153,7 @@ func (l *lexer) emitCustom(kind token.Kind, val string) {
 	tok := token.Token{
 		Kind: kind,
 		Val:  val,
-		Pos:  l.start,
+		Pos:  token.Pos(l.start),
 	}
 	l.tokens = append(l.tokens, tok)
 	// Advance the token start position.
@@ -3435,6 +3435,81 @@ func TestLexer(t *testing.T) {
 				},
 			},
 		},
+		{
+			path: "../../testdata/extra/lexer/float-lit.c",
+			toks: []token.Token{
+				{
+					Kind: token.Comment,
+					Val:  "// Floating-point literals.",
+					Pos:  0,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  "1.5",
+					Pos:  28,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  "2.",
+					Pos:  32,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  ".25",
+					Pos:  35,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  "1e10",
+					Pos:  39,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  "2.5E-3",
+					Pos:  44,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  "3.14f",
+					Pos:  51,
+				},
+				{
+					Kind: token.FloatLit,
+					Val:  "1e+2F",
+					Pos:  57,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "42",
+					Pos:  63,
+				},
+				{
+					Kind: token.Error,
+					Val:  "unexpected U+002E '.'",
+					Pos:  66,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "x",
+					Pos:  67,
+				},
+				{
+					Kind: token.Error,
+					Val:  "exponent has no digits",
+					Pos:  69,
+				},
+				{
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  71,
+				},
+				{
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  73,
+				},
+			},
+		},
 	}
 
 	for _, g := range golden {
@@ -3467,6 +3542,50 @@ func TestLexer(t *testing.T) {
 	}
 }
 
+func TestLexerEOF(t *testing.T) {
+	golden := []struct {
+		input string
+		want  []token.Token
+	}{
+		{
+			input: "a /",
+			want: []token.Token{
+				{Kind: token.Ident, Val: "a", Pos: 0},
+				{Kind: token.Div, Val: "/", Pos: 2},
+				{Kind: token.EOF, Val: "", Pos: 3},
+			},
+		},
+		{
+			input: "a &",
+			want: []token.Token{
+				{Kind: token.Ident, Val: "a", Pos: 0},
+				{Kind: token.Error, Val: "expected '&' after '&', got EOF", Pos: 2},
+				{Kind: token.EOF, Val: "", Pos: 3},
+			},
+		},
+		{
+			input: `'\`,
+			want: []token.Token{
+				{Kind: token.Error, Val: "unterminated character literal", Pos: 0},
+				{Kind: token.Error, Val: `unexpected U+005C '\'`, Pos: 1},
+				{Kind: token.EOF, Val: "", Pos: 2},
+			},
+		},
+	}
+	for _, g := range golden {
+		tokens := lexer.ParseString(g.input)
+		if len(tokens) != len(g.want) {
+			t.Errorf("%q: invalid number of tokens; expected %d tokens, got %d", g.input, len(g.want), len(tokens))
+			continue
+		}
+		for j, got := range tokens {
+			if want := g.want[j]; got != want {
+				t.Errorf("%q: token %d mismatch; expected %#v, got %#v", g.input, j, want, got)
+			}
+		}
+	}
+}
+
 func BenchmarkLexer(b *testing.B) {
 	buf, err := ioutil.ReadFile("../../testdata/noisy/advanced/eval.c")
 	if err != nil {
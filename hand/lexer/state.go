@@ -101,11 +101,13 @@ func lexToken(l *lexer) stateFn {
 		return lexCharLit
 	case '!':
 		return lexExclaim
+	case '.':
+		return lexDot
 	}
 
-	// Lex integer literal.
+	// Lex integer or floating-point literal.
 	if isDigit(r) {
-		return lexIntLit // 123
+		return lexNumber // 123, 1.5
 	}
 
 	// Lex identifier or keyword.
@@ -128,6 +130,10 @@ func lexSlash(l *lexer) stateFn {
 	case '*':
 		// Block comment (/*).
 		return lexBlockComment
+	case eof:
+		// Division operator (/) at end of input.
+		l.emit(token.Div)
+		return lexToken
 	default:
 		// Division operator (/).
 		l.backup()
@@ -184,9 +190,13 @@ func lexBlockComment(l *lexer) stateFn {
 // lexAmpersand lexes a logical AND operator (&&). An ampersand (&) has already
 // been consumed.
 func lexAmpersand(l *lexer) stateFn {
-	if r := l.next(); r == '&' {
+	switch r := l.next(); r {
+	case '&':
 		l.emit(token.Land)
-	} else {
+	case eof:
+		// Emit error token but continue lexing next token.
+		l.emitErrorf("expected '&' after '&', got EOF")
+	default:
 		// Emit error token but continue lexing next token.
 		l.backup()
 		l.emitErrorf("expected '&' after '&', got %#U", r)
@@ -274,6 +284,14 @@ func lexCharLit(l *lexer) stateFn {
 			// be the case, rewrite errorfCur to take another arugment cur, and let
 			// it restore the position.
 			r := l.next()
+			if r == eof {
+				// Emit error token but continue lexing next token.
+				l.errorf("unterminated character literal")
+				// Continue lexing directly after the token prefix.
+				l.cur = cur
+				l.ignore()
+				return lexToken
+			}
 			l.backup()
 			l.errorfCur(`unknown escape sequence '\%c'`, r)
 			// Continue lexing directly after the token prefix.
@@ -296,16 +314,65 @@ func lexCharLit(l *lexer) stateFn {
 	return lexToken
 }
 
-// lexIntLit lexes an integer literal (e.g. 123). A decimal digit (0-9) has
-// already been consumed.
+// lexNumber lexes an integer literal (e.g. 123) or a floating-point literal
+// (e.g. 1.5). A decimal digit (0-9) has already been consumed.
 //
-//    IntLit = [0-9]+
-func lexIntLit(l *lexer) stateFn {
+//    IntLit   = [0-9]+
+//    FloatLit = [0-9]+ "." [0-9]* Exponent? [fF]?
+//             | [0-9]+ Exponent [fF]?
+func lexNumber(l *lexer) stateFn {
 	l.acceptRun(decimal)
+	if l.accept(".") {
+		return lexFraction
+	}
+	if l.accept("eE") {
+		return lexExponent
+	}
 	l.emit(token.IntLit)
 	return lexToken
 }
 
+// lexDot lexes a floating-point literal starting with a decimal point (e.g.
+// .5). A dot character (.) has already been consumed.
+//
+//    FloatLit = "." [0-9]+ Exponent? [fF]?
+func lexDot(l *lexer) stateFn {
+	if !l.accept(decimal) {
+		// Emit error token but continue lexing next token.
+		l.emitErrorf("unexpected %#U", '.')
+		return lexToken
+	}
+	return lexFraction
+}
+
+// lexFraction lexes the fractional part of a floating-point literal (e.g. the
+// 5 in 1.5). The decimal point (.) has already been consumed.
+func lexFraction(l *lexer) stateFn {
+	l.acceptRun(decimal)
+	if l.accept("eE") {
+		return lexExponent
+	}
+	l.accept("fF")
+	l.emit(token.FloatLit)
+	return lexToken
+}
+
+// lexExponent lexes the exponent part of a floating-point literal (e.g. the -3
+// in 2.0e-3). The exponent character (e or E) has already been consumed.
+//
+//    Exponent = [eE] [+-]? [0-9]+
+func lexExponent(l *lexer) stateFn {
+	l.accept("+-")
+	if !l.acceptRun(decimal) {
+		// Emit error token but continue lexing next token.
+		l.emitErrorf("exponent has no digits")
+		return lexToken
+	}
+	l.accept("fF")
+	l.emit(token.FloatLit)
+	return lexToken
+}
+
 // lexLetter lexes an identifier (e.g. main) or a keyword (e.g. return). An
 // alphabetic character (a-z or A-Z) or an underscore (_) has already been
 // consumed.
@@ -0,0 +1,186 @@
+package parser
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astx"
+	"github.com/mewmew/uc/token"
+)
+
+// isDeclStart reports whether the current token starts a declaration. An
+// identifier followed by another identifier starts a declaration (e.g.
+// `int x;`), as the first identifier is a type name.
+func (p *parser) isDeclStart() bool {
+	switch p.tok.Kind {
+	case token.KwConst, token.KwExtern, token.KwStatic, token.KwTypedef:
+		return true
+	case token.Ident:
+		return p.peek(1).Kind == token.Ident
+	}
+	return false
+}
+
+// parseDecl parses a declaration. The returned value is either an ast.Decl or
+// a []*ast.VarDecl, for declarations with multiple declarators.
+//
+//    Decl         = [ StorageClass ] ( VarDecls ";" | FuncDecl ";" | FuncDef )
+//                 | TypeDef ";" .
+//    StorageClass = "extern" | "static" .
+func (p *parser) parseDecl() interface{} {
+	switch p.tok.Kind {
+	case token.KwTypedef:
+		return p.parseTypeDef()
+	case token.KwExtern, token.KwStatic:
+		storage := goccToken(p.next())
+		switch decl := p.parseVarOrFuncDecl().(type) {
+		case []*ast.VarDecl:
+			decls, err := astx.SetStorageClassList(storage, decl)
+			check(err)
+			return decls
+		default:
+			d, err := astx.SetStorageClass(storage, decl)
+			check(err)
+			return d
+		}
+	}
+	return p.parseVarOrFuncDecl()
+}
+
+// parseVarOrFuncDecl parses a variable declaration, a function declaration or
+// a function definition. The returned value is either a []*ast.VarDecl or an
+// *ast.FuncDecl.
+//
+//    VarDecls   = VarDecl { "," ident [ ArrayLen ] } .
+//    VarDecl    = BasicType ident [ ArrayLen ] .
+//    FuncDecl   = FuncHeader .
+//    FuncDef    = FuncHeader BlockStmt .
+//    FuncHeader = BasicType ident "(" [ ParamList ] ")" .
+func (p *parser) parseVarOrFuncDecl() interface{} {
+	typ := p.parseBasicType()
+	name := goccToken(p.expect(token.Ident, "declaration"))
+	if p.tok.Kind == token.Lparen {
+		lparen := goccToken(p.next())
+		params := p.parseParams()
+		rparen := goccToken(p.expectAfter(token.Rparen, "parameter list"))
+		fn, err := astx.NewFuncDecl(typ, name, lparen, params, rparen)
+		check(err)
+		if p.tok.Kind == token.Lbrace {
+			fn, err = astx.SetFuncBody(fn, p.parseBlockStmt())
+			check(err)
+			return fn
+		}
+		p.expectAfter(token.Semicolon, "function declaration")
+		return fn
+	}
+	decls, err := astx.NewVarDeclList(p.parseVarDeclRest(typ, name))
+	check(err)
+	for p.tok.Kind == token.Comma {
+		p.next()
+		name := goccToken(p.expect(token.Ident, "declaration"))
+		if p.tok.Kind == token.Lbracket {
+			lbracket, length, rbracket := p.parseArrayLen()
+			decls, err = astx.AppendArrayDecl(decls, name, lbracket, length, rbracket)
+		} else {
+			decls, err = astx.AppendScalarDecl(decls, name)
+		}
+		check(err)
+	}
+	p.expectAfter(token.Semicolon, "declaration")
+	return decls
+}
+
+// parseVarDeclRest parses the optional array length of a variable declaration,
+// with the given basic type and name.
+//
+//    VarDecl = BasicType ident [ ArrayLen ] .
+func (p *parser) parseVarDeclRest(typ ast.Type, name interface{}) *ast.VarDecl {
+	if p.tok.Kind == token.Lbracket {
+		lbracket, length, rbracket := p.parseArrayLen()
+		decl, err := astx.NewArrayDecl(typ, name, lbracket, length, rbracket)
+		check(err)
+		return decl
+	}
+	decl, err := astx.NewScalarDecl(typ, name)
+	check(err)
+	return decl
+}
+
+// parseArrayLen parses the length of an array declaration. The length is 0 if
+// omitted.
+//
+//    ArrayLen = "[" [ int_lit | char_lit ] "]" .
+func (p *parser) parseArrayLen() (lbracket interface{}, length int, rbracket interface{}) {
+	lbracket = goccToken(p.next())
+	switch p.tok.Kind {
+	case token.IntLit, token.CharLit:
+		kind := p.tok.Kind
+		n, err := astx.NewIntLit(goccToken(p.next()), kind)
+		check(err)
+		length = n
+	}
+	rbracket = goccToken(p.expect(token.Rbracket, "array declaration"))
+	return lbracket, length, rbracket
+}
+
+// parseTypeDef parses a type definition.
+//
+//    TypeDef = "typedef" BasicType ident .
+func (p *parser) parseTypeDef() *ast.TypeDef {
+	typedef := goccToken(p.next())
+	typ := p.parseBasicType()
+	name := goccToken(p.expect(token.Ident, "type definition"))
+	def, err := astx.NewTypeDef(typedef, typ, name)
+	check(err)
+	p.expectAfter(token.Semicolon, "type definition")
+	return def
+}
+
+// parseBasicType parses a basic type.
+//
+//    BasicType = [ "const" ] ident .
+func (p *parser) parseBasicType() ast.Type {
+	switch p.tok.Kind {
+	case token.Ident:
+		ident, err := astx.NewIdent(goccToken(p.next()))
+		check(err)
+		return ident
+	case token.KwConst:
+		constTok := goccToken(p.next())
+		name := goccToken(p.expect(token.Ident, "qualified type"))
+		typ, err := astx.NewQualType(constTok, name)
+		check(err)
+		return typ
+	}
+	p.errorf(p.tok.Pos, "expected type name")
+	panic("unreachable")
+}
+
+// parseParams parses the parameter list of a function header. The returned
+// parameter list is nil if empty.
+//
+//    ParamList = Param { "," Param } .
+func (p *parser) parseParams() interface{} {
+	if p.tok.Kind == token.Rparen {
+		return nil
+	}
+	params, err := astx.NewParamList(p.parseParam())
+	check(err)
+	for p.tok.Kind == token.Comma {
+		p.next()
+		params, err = astx.AppendParam(params, p.parseParam())
+		check(err)
+	}
+	return params
+}
+
+// parseParam parses a function parameter.
+//
+//    Param = BasicType | VarDecl .
+func (p *parser) parseParam() *ast.VarDecl {
+	typ := p.parseBasicType()
+	if p.tok.Kind == token.Ident {
+		return p.parseVarDeclRest(typ, goccToken(p.next()))
+	}
+	param, err := astx.NewAnonParam(typ)
+	check(err)
+	return param
+}
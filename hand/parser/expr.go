@@ -0,0 +1,148 @@
+package parser
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astx"
+	"github.com/mewmew/uc/token"
+)
+
+// Precedence levels of binary operators, as used by the Expr2R to Expr13L
+// production rules of the Gocc grammar.
+var binaryPrec = map[token.Kind]int{
+	// 2R: =
+	token.Assign: 2,
+	// 5L: &&
+	token.Land: 5,
+	// 9L: == !=
+	token.Eq: 9,
+	token.Ne: 9,
+	// 10L: < > <= >=
+	token.Lt: 10,
+	token.Gt: 10,
+	token.Le: 10,
+	token.Ge: 10,
+	// 12L: + -
+	token.Add: 12,
+	token.Sub: 12,
+	// 13L: * /
+	token.Mul: 13,
+	token.Div: 13,
+}
+
+// parseExpr parses an expression.
+//
+//    Expr = BinaryExpr .
+func (p *parser) parseExpr() ast.Expr {
+	return p.parseBinaryExpr(binaryPrec[token.Assign])
+}
+
+// parseBinaryExpr parses a binary expression, using precedence climbing. Only
+// operators with a precedence of at least minPrec are consumed. Assignments are
+// right-associative, and all other binary operators are left-associative.
+//
+//    BinaryExpr = UnaryExpr { binary_op UnaryExpr } .
+func (p *parser) parseBinaryExpr(minPrec int) ast.Expr {
+	x := p.parseUnaryExpr()
+	for {
+		prec, ok := binaryPrec[p.tok.Kind]
+		if !ok || prec < minPrec {
+			return x
+		}
+		op := p.next()
+		next := prec + 1
+		if op.Kind == token.Assign {
+			next = prec
+		}
+		y := p.parseBinaryExpr(next)
+		expr, err := astx.NewBinaryExpr(x, goccToken(op), y)
+		check(err)
+		x = expr
+	}
+}
+
+// parseUnaryExpr parses a unary expression.
+//
+//    UnaryExpr = ( "-" | "!" ) UnaryExpr | PostfixExpr .
+func (p *parser) parseUnaryExpr() ast.Expr {
+	switch p.tok.Kind {
+	case token.Sub, token.Not:
+		op := goccToken(p.next())
+		expr, err := astx.NewUnaryExpr(op, p.parseUnaryExpr())
+		check(err)
+		return expr
+	}
+	return p.parsePostfixExpr()
+}
+
+// parsePostfixExpr parses an index expression, a call expression or a primary
+// expression.
+//
+//    PostfixExpr = ident "[" Expr "]" | ident "(" [ ExprList ] ")" | PrimaryExpr .
+func (p *parser) parsePostfixExpr() ast.Expr {
+	if p.tok.Kind != token.Ident {
+		return p.parsePrimaryExpr()
+	}
+	switch p.peek(1).Kind {
+	case token.Lbracket:
+		name := goccToken(p.next())
+		lbracket := goccToken(p.next())
+		index := p.parseExpr()
+		rbracket := goccToken(p.expectAfter(token.Rbracket, "array index"))
+		expr, err := astx.NewIndexExpr(name, lbracket, index, rbracket)
+		check(err)
+		return expr
+	case token.Lparen:
+		name := goccToken(p.next())
+		lparen := goccToken(p.next())
+		args := p.parseArgs()
+		rparen := goccToken(p.expectAfter(token.Rparen, "function arguments"))
+		expr, err := astx.NewCallExpr(name, lparen, args, rparen)
+		check(err)
+		return expr
+	}
+	return p.parsePrimaryExpr()
+}
+
+// parseArgs parses the arguments of a call expression. The returned argument
+// list is nil if empty.
+//
+//    ExprList = Expr { "," Expr } .
+func (p *parser) parseArgs() interface{} {
+	if p.tok.Kind == token.Rparen {
+		return nil
+	}
+	args, err := astx.NewExprList(p.parseExpr())
+	check(err)
+	for p.tok.Kind == token.Comma {
+		p.next()
+		args, err = astx.AppendExpr(args, p.parseExpr())
+		check(err)
+	}
+	return args
+}
+
+// parsePrimaryExpr parses a primary expression.
+//
+//    PrimaryExpr = int_lit | float_lit | char_lit | ident | "(" Expr ")" .
+func (p *parser) parsePrimaryExpr() ast.Expr {
+	switch p.tok.Kind {
+	case token.IntLit, token.FloatLit, token.CharLit:
+		kind := p.tok.Kind
+		lit, err := astx.NewBasicLit(goccToken(p.next()), kind)
+		check(err)
+		return lit
+	case token.Ident:
+		ident, err := astx.NewIdent(goccToken(p.next()))
+		check(err)
+		return ident
+	case token.Lparen:
+		lparen := goccToken(p.next())
+		x := p.parseExpr()
+		rparen := goccToken(p.expectAfter(token.Rparen, "expression"))
+		expr, err := astx.NewParenExpr(lparen, x, rparen)
+		check(err)
+		return expr
+	}
+	p.errorf(p.tok.Pos, "expected expression")
+	panic("unreachable")
+}
@@ -0,0 +1,299 @@
+// Package parser implements a recursive descent parser for the µC programming
+// language. Binary expressions are parsed using precedence climbing.
+//
+// The abstract syntax trees produced by the parser are identical to those of
+// the Gocc generated parser, as both are constructed using the production
+// actions of the astx package.
+package parser
+
+import (
+	"fmt"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astx"
+	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/hand/lexer"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// Parse parses the given tokens into a µC source file, recovering from syntax
+// errors at declaration and statement boundaries. The lexical errors (i.e.
+// error tokens) and syntax errors encountered are returned as a semerrors.List,
+// with positional information based on the given input source (which may be
+// nil).
+//
+// Declarations and statements containing syntax errors are omitted from the
+// returned partial file.
+//
+// Comments are added to the returned file, and doc comments are attached to the
+// declarations they precede, based on the source input of src. Comments are
+// not grouped if src is nil.
+func Parse(toks []token.Token, src *semerrors.Source) (*ast.File, error) {
+	input := ""
+	if src != nil {
+		input = src.Input
+	}
+	return parse(toks, input, src)
+}
+
+// ParseString lexes and parses the given input string into a µC source file,
+// with positional information based on the given input source (which may be
+// nil). See Parse for details.
+func ParseString(input string, src *semerrors.Source) (*ast.File, error) {
+	return parse(lexer.ParseString(input), input, src)
+}
+
+// parse parses the given tokens of the source input into a µC source file. See
+// Parse for details.
+func parse(toks []token.Token, input string, src *semerrors.Source) (file *ast.File, err error) {
+	p := &parser{src: src}
+	// Positions of the input source are relative to the base of its file.
+	var base token.Pos
+	if src != nil {
+		base = token.Pos(src.File.Base())
+	}
+	var comments []*ast.Comment
+	for _, tok := range toks {
+		tok.Pos += base
+		switch tok.Kind {
+		case token.Comment:
+			// Record comment.
+			comments = append(comments, &ast.Comment{Slash: tok.Pos, Text: tok.Val})
+		case token.Error:
+			// Record lexical error and skip the error token.
+			p.addError(semerrors.New(tok.Pos, tok.Val))
+		default:
+			p.toks = append(p.toks, tok)
+		}
+	}
+	if len(p.toks) == 0 || p.toks[len(p.toks)-1].Kind != token.EOF {
+		// Terminate the token stream by an EOF token.
+		end := base
+		if len(toks) > 0 {
+			last := toks[len(toks)-1]
+			end += last.Pos + token.Pos(len(last.Val))
+		}
+		p.toks = append(p.toks, token.Token{Kind: token.EOF, Pos: end})
+	}
+	p.tok = p.toks[0]
+
+	// Abort parsing on unexpected errors of the astx production actions.
+	defer func() {
+		if e := recover(); e != nil {
+			ie, ok := e.(internalError)
+			if !ok {
+				panic(e)
+			}
+			file, err = nil, ie.err
+		}
+	}()
+	file = p.parseFile()
+	astx.AddComments(file, comments, input, base)
+	p.errs.Sort()
+	return file, p.errs.Err()
+}
+
+// A parser parses a slice of tokens into an abstract syntax tree.
+type parser struct {
+	// Tokens of the input, excluding comments and error tokens, terminated by
+	// an EOF token.
+	toks []token.Token
+	// Index of the current token.
+	cur int
+	// Current token.
+	tok token.Token
+	// Previous token; used to position errors about missing tokens.
+	prev token.Token
+	// Suppress syntax errors until the parser resyncs on a declaration or
+	// statement boundary, as syntax errors directly following error recovery
+	// tend to be cascading errors.
+	quiet bool
+	// Input source of the diagnostics.
+	src *semerrors.Source
+	// Lexical and syntax errors encountered while parsing.
+	errs semerrors.List
+}
+
+// next consumes and returns the current token, and advances to the next token.
+func (p *parser) next() token.Token {
+	tok := p.tok
+	if p.cur < len(p.toks)-1 {
+		p.cur++
+	}
+	p.prev, p.tok = tok, p.toks[p.cur]
+	return tok
+}
+
+// peek returns the token n tokens ahead of the current token.
+func (p *parser) peek(n int) token.Token {
+	if i := p.cur + n; i < len(p.toks) {
+		return p.toks[i]
+	}
+	return p.toks[len(p.toks)-1]
+}
+
+// expect consumes and returns the current token if of the given kind. A syntax
+// error is reported at the current token otherwise; e.g.
+//
+//    expected identifier in declaration
+func (p *parser) expect(kind token.Kind, context string) token.Token {
+	if p.tok.Kind != kind {
+		p.errorf(p.tok.Pos, "expected %s in %s", kindName(kind), context)
+	}
+	return p.next()
+}
+
+// expectAfter consumes and returns the current token if of the given kind. A
+// syntax error is reported directly after the previous token otherwise; e.g.
+//
+//    expected ';' after return statement
+func (p *parser) expectAfter(kind token.Kind, context string) token.Token {
+	if p.tok.Kind != kind {
+		pos := p.tok.Pos
+		if p.cur > 0 {
+			pos = p.prev.Pos + token.Pos(len(p.prev.Val))
+		}
+		p.errorf(pos, "expected %s after %s", kindName(kind), context)
+	}
+	return p.next()
+}
+
+// kindName returns a user-friendly name of the given token kind; e.g.
+// "identifier" for identifiers, and quoted source text for operators,
+// delimiters and keywords (e.g. `';'`).
+func kindName(kind token.Kind) string {
+	if kind.IsOperator() || kind.IsKeyword() {
+		return fmt.Sprintf("'%v'", kind)
+	}
+	return kind.String()
+}
+
+// --- [ Error handling ] ------------------------------------------------------
+
+// A bailout is raised (using panic) on syntax errors to unwind the parser to
+// the closest declaration or statement boundary, from which parsing resumes.
+type bailout struct{}
+
+// An internalError is raised (using panic) on unexpected errors of the astx
+// production actions, and aborts parsing.
+type internalError struct {
+	err error
+}
+
+// errorf reports a syntax error at the given position and unwinds the parser
+// to the closest declaration or statement boundary.
+func (p *parser) errorf(pos token.Pos, format string, a ...interface{}) {
+	if !p.quiet {
+		p.addError(semerrors.Newf(pos, format, a...))
+	}
+	panic(bailout{})
+}
+
+// addError records the given error, unless an error has already been reported
+// at the same position (to prevent cascading errors).
+func (p *parser) addError(err *semerrors.Diagnostic) {
+	for _, prev := range p.errs {
+		if prev.Pos == err.Pos {
+			return
+		}
+	}
+	err.Src = p.src
+	p.errs.Add(err)
+}
+
+// check aborts parsing if err is non-nil.
+func check(err error) {
+	if err != nil {
+		panic(internalError{err: errutil.Err(err)})
+	}
+}
+
+// sync recovers from syntax errors (i.e. bailouts), by skipping tokens up to
+// and including the next semicolon, or up to the next right-brace. The
+// right-brace is consumed if top-level is set. Blocks opened by the skipped
+// tokens are skipped as a whole, up to and including their right-brace. The
+// value pointed to by v is set to nil on syntax errors, and subsequent syntax
+// errors are suppressed until the parser resyncs on the next declaration or
+// statement.
+//
+// sync must be called by a deferred function call.
+func (p *parser) sync(v *interface{}, topLevel bool) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	if _, ok := e.(bailout); !ok {
+		panic(e)
+	}
+	*v = nil
+	defer func() {
+		p.quiet = true
+	}()
+	// Nesting depth of the blocks opened by the skipped tokens.
+	depth := 0
+	for {
+		switch p.tok.Kind {
+		case token.EOF:
+			return
+		case token.Semicolon:
+			if depth == 0 {
+				p.next()
+				return
+			}
+		case token.Lbrace:
+			depth++
+		case token.Rbrace:
+			if depth == 0 {
+				if topLevel {
+					p.next()
+				}
+				return
+			}
+			depth--
+			if depth == 0 {
+				p.next()
+				return
+			}
+		}
+		p.next()
+	}
+}
+
+// goccToken returns a Gocc token corresponding to the given token, as expected
+// by the astx production actions.
+func goccToken(tok token.Token) *gocctoken.Token {
+	return &gocctoken.Token{Lit: []byte(tok.Val), Pos: gocctoken.Pos{Offset: int(tok.Pos)}}
+}
+
+// --- [ File ] ----------------------------------------------------------------
+
+// parseFile parses a µC source file.
+//
+//    File = { Decl } .
+func (p *parser) parseFile() *ast.File {
+	decls := []ast.Decl(nil)
+	for p.tok.Kind != token.EOF {
+		var err error
+		decls, err = astx.AppendDecl(decls, p.parseTopLevelDecl())
+		check(err)
+	}
+	file, err := astx.NewFile(decls)
+	check(err)
+	return file
+}
+
+// parseTopLevelDecl parses a top-level declaration, recovering from syntax
+// errors. The returned declaration is nil on syntax errors.
+func (p *parser) parseTopLevelDecl() (decl interface{}) {
+	defer p.sync(&decl, true)
+	// Resynced on a declaration boundary.
+	p.quiet = false
+	switch p.tok.Kind {
+	case token.Ident, token.KwConst, token.KwExtern, token.KwStatic, token.KwTypedef:
+		return p.parseDecl()
+	}
+	p.errorf(p.tok.Pos, "expected declaration")
+	panic("unreachable")
+}
@@ -0,0 +1,195 @@
+package parser_test
+
+import (
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kr/pretty"
+	goccparser "github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// TestParserCrossCheck verifies that the hand-written parser produces the same
+// abstract syntax trees as the Gocc generated parser, and that both parsers
+// agree on which test cases contain syntax errors, and for select inputs, on the
+// positions of the syntax errors.
+func TestParserCrossCheck(t *testing.T) {
+	paths, err := filepath.Glob("../../testdata/*/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("unable to locate test cases")
+	}
+	for _, path := range paths {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		want, wantErr := goccparser.NewParser().ParseFile(scanner.NewFromBytes(buf), nil)
+		got, gotErr := parser.ParseString(string(buf), nil)
+		if (wantErr != nil) != (gotErr != nil) {
+			t.Errorf("%q: error mismatch; expected %v, got %v", path, wantErr, gotErr)
+			continue
+		}
+		if wantErr != nil {
+			// Partial abstract syntax trees are not compared, as the parsers
+			// recover from syntax errors differently.
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: AST mismatch; expected %#v, got %#v", path, want, got)
+			log.Println(pretty.Diff(want, got))
+		}
+	}
+
+	// Inputs for which both parsers report syntax errors at the same positions.
+	inputs := []string{
+		// Syntax errors in consecutive statements.
+		"int main(void) { int x; x = 1 +; x = ; return x; }",
+	}
+	for _, input := range inputs {
+		_, wantErr := goccparser.NewParser().ParseFile(scanner.NewFromString(input), nil)
+		_, gotErr := parser.ParseString(input, nil)
+		want, got := errorPositions(wantErr), errorPositions(gotErr)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: syntax error positions mismatch; expected %v, got %v", input, want, got)
+		}
+	}
+}
+
+// errorPositions returns the positions of the diagnostics of the given error.
+func errorPositions(err error) []token.Pos {
+	errs, ok := err.(semerrors.List)
+	if !ok {
+		return nil
+	}
+	var poss []token.Pos
+	for _, e := range errs {
+		poss = append(poss, e.Pos)
+	}
+	return poss
+}
+
+func TestParserError(t *testing.T) {
+	var golden = []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../../testdata/incorrect/parser/pe01.c",
+			want: `(../../testdata/incorrect/parser/pe01.c:5:12) error: expected expression
+  a = (a + ) * a;   //  Unexpected token ')'
+           ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe02.c",
+			want: `(../../testdata/incorrect/parser/pe02.c:3:7) error: expected ';' after expression
+  2001  // Missing semicolon
+      ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe03.c",
+			want: `(../../testdata/incorrect/parser/pe03.c:6:1) error: expected statement
+}
+^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe04.c",
+			want: `(../../testdata/incorrect/parser/pe04.c:5:19) error: expected ';' after expression
+  if (a != 0) then a=1; // Shouldn't be a 'then' here
+                  ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe05.c",
+			want: `(../../testdata/incorrect/parser/pe05.c:3:5) error: expected identifier in declaration
+int else;  // Bad identifier
+    ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe06.c",
+			want: `(../../testdata/incorrect/parser/pe06.c:3:6) error: expected ';' after declaration
+int a b; // Unexpected identifier
+     ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe08.c",
+			want: `(../../testdata/incorrect/parser/pe08.c:2:15) error: expected ';' after function declaration
+int main(void) 
+              ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe09.c",
+			want: `(../../testdata/incorrect/parser/pe09.c:3:6) error: expected declaration
+     ; // '}' missing 
+     ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe10.c",
+			want: `(../../testdata/incorrect/parser/pe10.c:8:13) error: expected expression
+  foo(1, 2, ); // Unexpected token ')'
+            ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe11.c",
+			want: `(../../testdata/incorrect/parser/pe11.c:3:4) error: expected identifier in declaration
+foo(0);
+   ^`,
+		},
+		{
+			path: "../../testdata/incorrect/parser/pe12.c",
+			want: `(../../testdata/incorrect/parser/pe12.c:3:10) error: expected ';' after declaration
+void fred { // Missing parameter list
+         ^`,
+		},
+		{
+			path: "../../testdata/extra/parser/multiple-errors.c",
+			want: `(../../testdata/extra/parser/multiple-errors.c:2:11) error: expected expression
+ a = (a + ) * a;
+          ^
+(../../testdata/extra/parser/multiple-errors.c:7:10) error: expected ';' after return statement
+ return 1
+         ^
+(../../testdata/extra/parser/multiple-errors.c:10:6) error: expected ';' after declaration
+int x y;
+     ^
+(../../testdata/extra/parser/multiple-errors.c:13:9) error: expected expression
+ b = b +;
+        ^`,
+		},
+	}
+
+	semerrors.UseColor = false
+
+	// The test cases are also added to a shared file set, so that the positions
+	// of all but the first test case are offset by the base of their file.
+	fset := token.NewFileSet()
+	for _, g := range golden {
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		srcs := []*semerrors.Source{
+			semerrors.NewSource(g.path, string(buf)),
+			semerrors.AddSource(fset, g.path, string(buf)),
+		}
+		for _, src := range srcs {
+			_, err = parser.ParseString(string(buf), src)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != g.want {
+				t.Errorf("%q (base %d): error mismatch; expected `%v`, got `%v`", g.path, src.File.Base(), g.want, got)
+			}
+		}
+	}
+}
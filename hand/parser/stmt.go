@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astx"
+	"github.com/mewmew/uc/token"
+)
+
+// parseStmt parses a statement.
+//
+//    Stmt = IfStmt | WhileStmt | ReturnStmt | BlockStmt | EmptyStmt | ExprStmt .
+func (p *parser) parseStmt() ast.Stmt {
+	switch p.tok.Kind {
+	case token.KwIf:
+		return p.parseIfStmt()
+	case token.KwWhile:
+		return p.parseWhileStmt()
+	case token.KwReturn:
+		return p.parseReturnStmt()
+	case token.Lbrace:
+		return p.parseBlockStmt()
+	case token.Semicolon:
+		stmt, err := astx.NewEmptyStmt(goccToken(p.next()))
+		check(err)
+		return stmt
+	case token.Rbrace, token.KwElse, token.EOF:
+		p.errorf(p.tok.Pos, "expected statement")
+	}
+	x := p.parseExpr()
+	semicolon := goccToken(p.expectAfter(token.Semicolon, "expression"))
+	stmt, err := astx.NewExprStmt(x, semicolon)
+	check(err)
+	return stmt
+}
+
+// parseIfStmt parses an if statement. An else branch belongs to the innermost
+// if statement.
+//
+//    IfStmt = "if" Condition Stmt [ "else" Stmt ] .
+func (p *parser) parseIfStmt() *ast.IfStmt {
+	ifTok := goccToken(p.next())
+	cond := p.parseCondition("'if'")
+	body := p.parseStmt()
+	var els interface{}
+	if p.tok.Kind == token.KwElse {
+		p.next()
+		els = p.parseStmt()
+	}
+	stmt, err := astx.NewIfStmt(ifTok, cond, body, els)
+	check(err)
+	return stmt
+}
+
+// parseWhileStmt parses a while statement.
+//
+//    WhileStmt = "while" Condition Stmt .
+func (p *parser) parseWhileStmt() *ast.WhileStmt {
+	whileTok := goccToken(p.next())
+	cond := p.parseCondition("'while'")
+	body := p.parseStmt()
+	stmt, err := astx.NewWhileStmt(whileTok, cond, body)
+	check(err)
+	return stmt
+}
+
+// parseCondition parses the condition of an if or while statement, following
+// the given keyword.
+//
+//    Condition = "(" Expr ")" .
+func (p *parser) parseCondition(keyword string) ast.Expr {
+	p.expectAfter(token.Lparen, keyword)
+	cond := p.parseExpr()
+	p.expectAfter(token.Rparen, "condition")
+	return cond
+}
+
+// parseReturnStmt parses a return statement.
+//
+//    ReturnStmt = "return" [ Expr ] ";" .
+func (p *parser) parseReturnStmt() *ast.ReturnStmt {
+	returnTok := goccToken(p.next())
+	var result interface{}
+	if p.tok.Kind != token.Semicolon {
+		result = p.parseExpr()
+	}
+	semicolon := goccToken(p.expectAfter(token.Semicolon, "return statement"))
+	stmt, err := astx.NewReturnStmt(returnTok, result, semicolon)
+	check(err)
+	return stmt
+}
+
+// parseBlockStmt parses a block statement.
+//
+//    BlockStmt = "{" { BlockItem } "}" .
+func (p *parser) parseBlockStmt() *ast.BlockStmt {
+	lbrace := goccToken(p.next())
+	items := []ast.BlockItem(nil)
+	for p.tok.Kind != token.Rbrace && p.tok.Kind != token.EOF {
+		var err error
+		items, err = astx.AppendBlockItem(items, p.parseBlockItem())
+		check(err)
+	}
+	rbrace := goccToken(p.expect(token.Rbrace, "block"))
+	block, err := astx.NewBlockStmt(lbrace, items, rbrace)
+	check(err)
+	return block
+}
+
+// parseBlockItem parses a block item, recovering from syntax errors. The
+// returned block item is nil on syntax errors.
+//
+//    BlockItem = Decl | Stmt .
+func (p *parser) parseBlockItem() (item interface{}) {
+	defer p.sync(&item, false)
+	// Resynced on a declaration or statement boundary.
+	p.quiet = false
+	if p.isDeclStart() {
+		return p.parseDecl()
+	}
+	return p.parseStmt()
+}
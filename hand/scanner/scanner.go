@@ -3,9 +3,12 @@ package scanner
 
 import (
 	"io"
+	"os"
 
+	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/gocc/token"
 	"github.com/mewmew/uc/hand/lexer"
+	semerrors "github.com/mewmew/uc/sem/errors"
 	uctoken "github.com/mewmew/uc/token"
 )
 
@@ -13,21 +16,34 @@ import (
 type Scanner interface {
 	// Scan lexes and returns the next token of the source input.
 	Scan() *token.Token
+	// Errors returns the lexical errors encountered while scanning.
+	Errors() semerrors.List
+	// Comments returns the comments encountered while scanning, in source
+	// order.
+	Comments() []*ast.Comment
+	// Input returns the source input.
+	Input() string
 }
 
 // a scanner is a lexer which implements the Gocc Scanner interface.
 type scanner struct {
+	// Source input.
+	input string
 	// Lexed tokens.
 	toks []uctoken.Token
 	// Current token.
 	cur int
+	// Lexical errors encountered while scanning.
+	errs semerrors.List
+	// Comments encountered while scanning.
+	comments []*ast.Comment
 }
 
 // Ensure that scanner implements the Gocc Scanner interface.
 var _ Scanner = &scanner{}
 
 // eof represents an end-of-file token with unknown source position.
-var eof = &token.Token{Type: token.TokMap.Type("$")}
+var eof = &token.Token{Type: token.EOF}
 
 // Scan lexes and returns the next token of the source input.
 func (s *scanner) Scan() *token.Token {
@@ -39,23 +55,29 @@ func (s *scanner) Scan() *token.Token {
 	var typ token.Type
 	switch tok.Kind {
 	case uctoken.EOF:
-		typ = token.TokMap.Type("$")
+		typ = token.EOF
 	case uctoken.Error:
-		typ = token.TokMap.Type("INVALID")
+		// Record lexical error and skip the error token, so that parsing may
+		// continue.
+		s.errs.Add(semerrors.New(tok.Pos, tok.Val))
+		return s.Scan()
 	case uctoken.Comment:
-		// Skip comments.
+		// Record comment and skip the comment token.
+		s.comments = append(s.comments, &ast.Comment{Slash: tok.Pos, Text: tok.Val})
 		return s.Scan()
 	case uctoken.Ident:
 		typ = token.TokMap.Type("ident")
 	case uctoken.IntLit:
 		typ = token.TokMap.Type("int_lit")
+	case uctoken.FloatLit:
+		typ = token.TokMap.Type("float_lit")
 	case uctoken.CharLit:
 		typ = token.TokMap.Type("char_lit")
 	default:
 		typ = token.TokMap.Type(tok.Val)
 	}
 	lit := []byte(tok.Val)
-	pos := token.Pos{Offset: tok.Pos}
+	pos := token.Pos{Offset: int(tok.Pos)}
 	return &token.Token{
 		Type: typ,
 		Lit:  lit,
@@ -63,32 +85,48 @@ func (s *scanner) Scan() *token.Token {
 	}
 }
 
+// Errors returns the lexical errors encountered while scanning, as diagnostics
+// without input source information.
+func (s *scanner) Errors() semerrors.List {
+	return s.errs
+}
+
+// Comments returns the comments encountered while scanning, in source order.
+func (s *scanner) Comments() []*ast.Comment {
+	return s.comments
+}
+
+// Input returns the source input.
+func (s *scanner) Input() string {
+	return s.input
+}
+
 // New returns a new scanner lexing from r.
 func New(r io.Reader) (Scanner, error) {
-	toks, err := lexer.Parse(r)
+	input, err := lexer.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
-	return &scanner{toks: toks}, nil
+	return NewFromString(input), nil
 }
 
 // Open returns a new scanner lexing from path.
 func Open(path string) (Scanner, error) {
-	toks, err := lexer.ParseFile(path)
+	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
-	return &scanner{toks: toks}, nil
+	defer f.Close()
+	return New(f)
 }
 
 // NewFromString returns a new scanner lexing from input.
 func NewFromString(input string) Scanner {
 	toks := lexer.ParseString(input)
-	return &scanner{toks: toks}
+	return &scanner{input: input, toks: toks}
 }
 
 // NewFromBytes returns a new scanner lexing from input.
 func NewFromBytes(input []byte) Scanner {
-	toks := lexer.ParseString(string(input))
-	return &scanner{toks: toks}
+	return NewFromString(string(input))
 }
@@ -0,0 +1,96 @@
+// Package irdot implements output of control flow graphs of LLVM IR functions
+// in Graphviz DOT format.
+//
+// Each basic block is output as a box, labelled with the name of the basic
+// block followed by its instructions and terminator, one per line. Edges
+// connect basic blocks to the successors of their terminators; the edges of
+// conditional branches are labelled "true" and "false", e.g.
+//
+//    digraph "main" {
+//       node [shape=box];
+//       "%0" [label="0:\l%1 = icmp ne i32 1, 0\lbr i1 %1, label %2, label %3\l"];
+//       "%0" -> "%2" [label="true"];
+//       "%0" -> "%3" [label="false"];
+//       ...
+//    }
+package irdot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/llir/llvm/ir"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// Fprint writes the control flow graphs of the function definitions of the
+// given module in Graphviz DOT format to w, as one directed graph per function.
+// Function declarations, which have no basic blocks, are skipped.
+func Fprint(w io.Writer, m *ir.Module) error {
+	for _, f := range m.Funcs {
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		if err := FprintFunc(w, f); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	return nil
+}
+
+// FprintFunc writes the control flow graph of the given function definition in
+// Graphviz DOT format to w, as a directed graph named after the function.
+func FprintFunc(w io.Writer, f *ir.Func) error {
+	// Assign IDs to unnamed basic blocks and local variables, as done when
+	// printing the LLVM IR assembly of the function.
+	if err := f.AssignIDs(); err != nil {
+		return errutil.Err(err)
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph %s {\n", quote(f.Name()))
+	fmt.Fprintln(bw, "   node [shape=box];")
+	for _, block := range f.Blocks {
+		name := quote(block.Ident())
+		fmt.Fprintf(bw, "   %s [label=%s];\n", name, quote(label(block)))
+		if block.Term == nil {
+			continue
+		}
+		succs := block.Term.Succs()
+		if _, ok := block.Term.(*ir.TermCondBr); ok && len(succs) == 2 {
+			fmt.Fprintf(bw, "   %s -> %s [label=\"true\"];\n", name, quote(succs[0].Ident()))
+			fmt.Fprintf(bw, "   %s -> %s [label=\"false\"];\n", name, quote(succs[1].Ident()))
+			continue
+		}
+		for _, succ := range succs {
+			fmt.Fprintf(bw, "   %s -> %s;\n", name, quote(succ.Ident()))
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	if err := bw.Flush(); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// label returns the label of the given basic block; i.e. its name, followed by
+// its instructions and terminator, each on a separate left-justified line.
+func label(block *ir.Block) string {
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "%s:\n", block.Name())
+	for _, inst := range block.Insts {
+		fmt.Fprintf(buf, "%s\n", inst.LLString())
+	}
+	if block.Term != nil {
+		fmt.Fprintf(buf, "%s\n", block.Term.LLString())
+	}
+	return buf.String()
+}
+
+// quote returns the given string as a double-quoted DOT string, in which
+// newlines are output as left-justified line breaks.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\l`)
+	return `"` + r.Replace(s) + `"`
+}
@@ -0,0 +1,47 @@
+package irdot_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/mewmew/uc/irgen/irdot"
+)
+
+func TestFprint(t *testing.T) {
+	// int abs(int x) { if (x < 0) { x = -x; } return x; }
+	m := ir.NewModule()
+	m.NewFunc("puts", types.I32, ir.NewParam("s", types.I8Ptr))
+	x := ir.NewParam("x", types.I32)
+	f := m.NewFunc("abs", types.I32, x)
+	entry := f.NewBlock("")
+	neg := f.NewBlock("")
+	end := f.NewBlock("end")
+	cond := entry.NewICmp(enum.IPredSLT, x, constant.NewInt(types.I32, 0))
+	entry.NewCondBr(cond, neg, end)
+	y := neg.NewSub(constant.NewInt(types.I32, 0), x)
+	neg.NewBr(end)
+	phi := end.NewPhi(ir.NewIncoming(x, entry), ir.NewIncoming(y, neg))
+	end.NewRet(phi)
+
+	const want = `digraph "abs" {
+   node [shape=box];
+   "%0" [label="0:\l%1 = icmp slt i32 %x, 0\lbr i1 %1, label %2, label %end\l"];
+   "%0" -> "%2" [label="true"];
+   "%0" -> "%end" [label="false"];
+   "%2" [label="2:\l%3 = sub i32 0, %x\lbr label %end\l"];
+   "%2" -> "%end";
+   "%end" [label="end:\l%4 = phi i32 [ %x, %0 ], [ %3, %2 ]\lret i32 %4\l"];
+}
+`
+	buf := &bytes.Buffer{}
+	if err := irdot.Fprint(buf, m); err != nil {
+		t.Fatalf("unable to output control flow graphs; %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected %s, got %s", want, got)
+	}
+}
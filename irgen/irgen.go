@@ -12,6 +12,7 @@ import (
 	"github.com/mewkiz/pkg/term"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/token"
 )
 
 // TODO: Remove debug output.
@@ -26,13 +27,15 @@ type Module struct {
 	// info holds semantic information about the program from the type-checker.
 	info *sem.Info
 	// Maps from identifier source code position to the associated value.
-	idents map[int]value.Value
+	idents map[token.Pos]value.Value
+	// File scope of the program.
+	fileScope *sem.Scope
 }
 
 // NewModule returns a new module generator.
 func NewModule(info *sem.Info) *Module {
 	m := ir.NewModule()
-	return &Module{Module: m, info: info, idents: make(map[int]value.Value)}
+	return &Module{Module: m, info: info, idents: make(map[token.Pos]value.Value)}
 }
 
 // emitFunc emits to m the given function.
@@ -52,7 +55,7 @@ type Func struct {
 	// Current basic block being generated.
 	curBlock *Block
 	// Maps from identifier source code position to the associated value.
-	idents map[int]value.Value
+	idents map[token.Pos]value.Value
 	// Map of existing local variable names.
 	exists map[string]bool
 }
@@ -63,7 +66,7 @@ type Func struct {
 // The caller is responsible for initializing basic blocks.
 func NewFunc(name string, retType irtypes.Type, params ...*ir.Param) *Func {
 	f := ir.NewFunc(name, retType, params...)
-	return &Func{Func: f, idents: make(map[int]value.Value), exists: make(map[string]bool)}
+	return &Func{Func: f, idents: make(map[token.Pos]value.Value), exists: make(map[string]bool)}
 }
 
 // startBody initializes the generation of the function body.
@@ -61,6 +61,42 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/tentative_def.c",
 			want: "../testdata/extra/irgen/tentative_def.ll",
 		},
+		// Storage-class specifiers.
+		{
+			path: "../testdata/extra/irgen/static_def.c",
+			want: "../testdata/extra/irgen/static_def.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/extern_decl.c",
+			want: "../testdata/extra/irgen/extern_decl.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/static_local.c",
+			want: "../testdata/extra/irgen/static_local.ll",
+		},
+		// Type qualifiers.
+		{
+			path: "../testdata/extra/irgen/const_global.c",
+			want: "../testdata/extra/irgen/const_global.ll",
+		},
+		// Floating-point types.
+		{
+			path: "../testdata/extra/irgen/float_arith.c",
+			want: "../testdata/extra/irgen/float_arith.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/float_range.c",
+			want: "../testdata/extra/irgen/float_range.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/float_to_int.c",
+			want: "../testdata/extra/irgen/float_to_int.ll",
+		},
+		// Multiple declarators.
+		{
+			path: "../testdata/extra/irgen/multi_decl.c",
+			want: "../testdata/extra/irgen/multi_decl.ll",
+		},
 		// Return statements.
 		{
 			path: "../testdata/extra/irgen/void_ret.c",
@@ -6,6 +6,7 @@ package irgen
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
@@ -16,6 +17,7 @@ import (
 	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/sem"
 	"github.com/mewmew/uc/token"
+	uctypes "github.com/mewmew/uc/types"
 )
 
 // Gen generates LLVM IR based on the syntax tree of the given file.
@@ -28,6 +30,12 @@ func Gen(file *ast.File, info *sem.Info) *ir.Module {
 // gen generates LLVM IR based on the syntax tree of the given file.
 func gen(file *ast.File, info *sem.Info) *ir.Module {
 	m := NewModule(info)
+	m.fileScope = info.Scopes[file]
+	// Code generation is done in two passes to allow for forward references.
+	// Firstly, the global variables and functions of the file-scope are
+	// created. Secondly, the bodies of the function definitions are lowered.
+	var defs []*ast.FuncDecl
+	var funcs []*Func
 	for _, decl := range file.Decls {
 		// Ignore tentative definitions.
 		if isTentativeDef(decl) {
@@ -36,7 +44,11 @@ func gen(file *ast.File, info *sem.Info) *ir.Module {
 		}
 		switch decl := decl.(type) {
 		case *ast.FuncDecl:
-			m.funcDecl(decl)
+			f := m.funcDecl(decl)
+			if astutil.IsDef(decl) {
+				defs = append(defs, decl)
+				funcs = append(funcs, f)
+			}
 		case *ast.VarDecl:
 			m.globalVarDecl(decl)
 		case *ast.TypeDef:
@@ -45,14 +57,19 @@ func gen(file *ast.File, info *sem.Info) *ir.Module {
 			panic(fmt.Sprintf("support for %T not yet implemented", decl))
 		}
 	}
+	for i, def := range defs {
+		// Generate function body.
+		dbg.Printf("create function definition: %v", def)
+		m.funcBody(funcs[i], def.FuncType.Params, def.Body)
+	}
 	return m.Module
 }
 
 // --- [ Function declaration ] ------------------------------------------------
 
 // funcDecl lowers the given function declaration to LLVM IR, emitting code to
-// m.
-func (m *Module) funcDecl(n *ast.FuncDecl) {
+// m. The body of function definitions is lowered separately by funcBody.
+func (m *Module) funcDecl(n *ast.FuncDecl) *Func {
 	// Generate function signature.
 	ident := n.Name()
 	name := ident.String()
@@ -63,22 +80,24 @@ func (m *Module) funcDecl(n *ast.FuncDecl) {
 	}
 	var params []*ir.Param
 	for i, p := range n.FuncType.Params {
+		if i >= len(sig.Params) {
+			// Skip "void" parameter of functions without parameters.
+			break
+		}
 		paramType := sig.Params[i]
 		param := ir.NewParam(p.Name().String(), paramType)
 		params = append(params, param)
 	}
 	f := NewFunc(name, sig.RetType, params...)
+	m.setIdentValue(ident, f.Func)
 	if !astutil.IsDef(n) {
 		dbg.Printf("create function declaration: %v", n)
-		// Emit function declaration.
-		m.emitFunc(f)
-		return
+	} else if m.linkageOf(ident) == sem.Internal {
+		f.Linkage = enum.LinkageInternal
 	}
-	m.setIdentValue(ident, f.Func)
-
-	// Generate function body.
-	dbg.Printf("create function definition: %v", n)
-	m.funcBody(f, n.FuncType.Params, n.Body)
+	// Emit function declaration or definition.
+	m.emitFunc(f)
+	return f
 }
 
 // funcBody lowers the given function declaration to LLVM IR, emitting code to
@@ -128,9 +147,6 @@ func (m *Module) funcBody(f *Func, params []*ast.VarDecl, body *ast.BlockStmt) {
 	if err := f.endBody(); err != nil {
 		panic(fmt.Sprintf("unable to finalize function body; %v", err))
 	}
-
-	// Emit function definition.
-	m.emitFunc(f)
 }
 
 // funcParam lowers the given function parameter to LLVM IR, emitting code to f.
@@ -156,20 +172,44 @@ func (m *Module) globalVarDecl(n *ast.VarDecl) {
 	// Output:
 	//    @x = global i32 0
 	ident := n.Name()
-	dbg.Printf("create global variable: %v", n)
 	typ := toIrType(n.Type())
+	if !astutil.IsDef(n) {
+		// Input:
+		//    extern int x;
+		// Output:
+		//    @x = external global i32
+		dbg.Printf("create global variable declaration: %v", n)
+		global := ir.NewGlobal(ident.Name, typ)
+		global.Immutable = uctypes.IsConst(n.Type())
+		global.Linkage = enum.LinkageExternal
+		m.setIdentValue(ident, global)
+		// Emit global variable declaration.
+		m.emitGlobal(global)
+		return
+	}
+	dbg.Printf("create global variable: %v", n)
 	var val constant.Constant
 	switch {
 	case n.Val != nil:
 		panic("support for global variable initializer not yet implemented")
 	default:
-		if intType, ok := typ.(*irtypes.IntType); ok {
-			val = constant.NewInt(intType, 0)
-		} else {
-			val = constant.NewZeroInitializer(typ)
-		}
+		val = zeroInit(typ)
 	}
 	global := ir.NewGlobalDef(ident.Name, val)
+	if uctypes.IsConst(n.Type()) {
+		// Input:
+		//    const int x;
+		// Output:
+		//    @x = constant i32 0
+		global.Immutable = true
+	}
+	if m.linkageOf(ident) == sem.Internal {
+		// Input:
+		//    static int x;
+		// Output:
+		//    @x = internal global i32 0
+		global.Linkage = enum.LinkageInternal
+	}
 	m.setIdentValue(ident, global)
 	// Emit global variable definition.
 	m.emitGlobal(global)
@@ -206,6 +246,70 @@ func (m *Module) localVarDef(f *Func, n *ast.VarDecl) {
 	}
 }
 
+// --- [ Local extern variable declaration ] -----------------------------------
+
+// externVarDecl lowers the given block-scope extern variable declaration to
+// LLVM IR, emitting code to m.
+func (m *Module) externVarDecl(f *Func, n *ast.VarDecl) {
+	// Input:
+	//    void f() {
+	//       extern int x;    // <-- relevant line
+	//    }
+	// Output:
+	//    @x = external global i32
+	ident := n.Name()
+	if isTentativeDef(n) {
+		// Redeclaration of variable within the same scope.
+		return
+	}
+	dbg.Printf("create local extern variable declaration: %v", n)
+	// Refer to the global variable of the file-scope, if present.
+	if decl, ok := m.fileScope.Decls[ident.Name]; ok {
+		if _, ok := decl.(*ast.VarDecl); ok {
+			f.setIdentValue(ident, m.valueFromIdent(nil, decl.Name()))
+			return
+		}
+	}
+	for _, global := range m.Globals {
+		if global.Name() == ident.Name {
+			f.setIdentValue(ident, global)
+			return
+		}
+	}
+	global := ir.NewGlobal(ident.Name, toIrType(n.Type()))
+	global.Immutable = uctypes.IsConst(n.Type())
+	global.Linkage = enum.LinkageExternal
+	f.setIdentValue(ident, global)
+	// Emit global variable declaration.
+	m.emitGlobal(global)
+}
+
+// --- [ Local static variable definition ] ------------------------------------
+
+// staticVarDef lowers the given block-scope static variable definition to LLVM
+// IR, emitting code to m.
+func (m *Module) staticVarDef(f *Func, n *ast.VarDecl) {
+	// Input:
+	//    void f() {
+	//       static int a;    // <-- relevant line
+	//    }
+	// Output:
+	//    @f.a = internal global i32 0
+	ident := n.Name()
+	dbg.Printf("create local static variable: %v", n)
+	typ := toIrType(n.Type())
+	if n.Val != nil {
+		panic("support for local variable definition initializer not yet implemented")
+	}
+	name := m.genUnique(fmt.Sprintf("%s.%s", f.Name(), ident.Name))
+	global := ir.NewGlobalDef(name, zeroInit(typ))
+	global.Immutable = uctypes.IsConst(n.Type())
+	global.Linkage = enum.LinkageInternal
+	f.setIdentValue(ident, global)
+	// Emit global variable definition.
+	m.emitGlobal(global)
+}
+
 // --- [ Statements ] ----------------------------------------------------------
 
 // stmt lowers the given statement to LLVM IR, emitting code to f.
@@ -243,7 +347,14 @@ func (m *Module) blockStmt(f *Func, stmt *ast.BlockStmt) {
 			case *ast.FuncDecl:
 				panic(fmt.Sprintf("support for nested function declarations not yet implemented: %v", decl))
 			case *ast.VarDecl:
-				m.localVarDef(f, decl)
+				switch decl.Storage {
+				case ast.Extern:
+					m.externVarDecl(f, decl)
+				case ast.Static:
+					m.staticVarDef(f, decl)
+				default:
+					m.localVarDef(f, decl)
+				}
 			case *ast.TypeDef:
 				panic(fmt.Sprintf("support for scoped type definitions not yet implemented: %v", decl))
 			}
@@ -347,8 +458,11 @@ func (m *Module) cond(f *Func, expr ast.Expr) value.Value {
 	// Create boolean expression if cond is not already of boolean type.
 	//
 	//    cond != 0
-	// zero is the integer constant 0.
+	// zero is the integer or floating-point constant 0.
 	zero := constZero(cond.Type())
+	if irtypes.IsFloat(cond.Type()) {
+		return f.curBlock.NewFCmp(enum.FPredUNE, cond, zero)
+	}
 	return f.curBlock.NewICmp(enum.IPredNE, cond, zero)
 }
 
@@ -398,6 +512,17 @@ func (m *Module) basicLit(f *Func, n *ast.BasicLit) value.Value {
 			panic(fmt.Errorf("unable to parse integer literal %q; %v", n.Val, err))
 		}
 		return c
+	case token.FloatLit:
+		floatType, ok := typ.(*irtypes.FloatType)
+		if !ok {
+			panic(fmt.Errorf("invalid floating-point literal type; expected *types.FloatType, got %T", typ))
+		}
+		// Literals out of the range of double evaluate to ±Inf (e.g. 1e400).
+		x, err := strconv.ParseFloat(strings.TrimRight(n.Val, "fF"), 64)
+		if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+			panic(fmt.Errorf("unable to parse floating-point literal %q; %v", n.Val, err))
+		}
+		return newFloat(floatType, x)
 	default:
 		panic(fmt.Sprintf("support for basic literal kind %v not yet implemented", n.Kind))
 	}
@@ -410,24 +535,36 @@ func (m *Module) binaryExpr(f *Func, n *ast.BinaryExpr) value.Value {
 	case token.Add:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFAdd(x, y)
+		}
 		return f.curBlock.NewAdd(x, y)
 
 	// -
 	case token.Sub:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFSub(x, y)
+		}
 		return f.curBlock.NewSub(x, y)
 
 	// *
 	case token.Mul:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFMul(x, y)
+		}
 		return f.curBlock.NewMul(x, y)
 
 	// /
 	case token.Div:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFDiv(x, y)
+		}
 		// TODO: Add support for unsigned division.
 		return f.curBlock.NewSDiv(x, y)
 
@@ -435,36 +572,54 @@ func (m *Module) binaryExpr(f *Func, n *ast.BinaryExpr) value.Value {
 	case token.Lt:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFCmp(enum.FPredOLT, x, y)
+		}
 		return f.curBlock.NewICmp(enum.IPredSLT, x, y)
 
 	// >
 	case token.Gt:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFCmp(enum.FPredOGT, x, y)
+		}
 		return f.curBlock.NewICmp(enum.IPredSGT, x, y)
 
 	// <=
 	case token.Le:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFCmp(enum.FPredOLE, x, y)
+		}
 		return f.curBlock.NewICmp(enum.IPredSLE, x, y)
 
 	// >=
 	case token.Ge:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFCmp(enum.FPredOGE, x, y)
+		}
 		return f.curBlock.NewICmp(enum.IPredSGE, x, y)
 
 	// !=
 	case token.Ne:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFCmp(enum.FPredUNE, x, y)
+		}
 		return f.curBlock.NewICmp(enum.IPredNE, x, y)
 
 	// ==
 	case token.Eq:
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
+		if irtypes.IsFloat(x.Type()) {
+			return f.curBlock.NewFCmp(enum.FPredOEQ, x, y)
+		}
 		return f.curBlock.NewICmp(enum.IPredEQ, x, y)
 
 	// &&
@@ -545,7 +700,7 @@ func (m *Module) ident(f *Func, ident *ast.Ident) value.Value {
 		indices := []value.Value{zero, zero}
 
 		// Emit getelementptr instruction.
-		if m.isGlobal(ident) {
+		if m.isGlobal(f, ident) {
 			var is []constant.Constant
 			for _, index := range indices {
 				i, ok := index.(constant.Constant)
@@ -628,7 +783,7 @@ func (m *Module) indexExpr(f *Func, n *ast.IndexExpr) value.Value {
 
 	// Emit getelementptr instruction.
 	addrElemType := addr.Type().(*irtypes.PointerType).ElemType
-	if m.isGlobal(n.Name) {
+	if m.isGlobal(f, n.Name) {
 		var is []constant.Constant
 		for _, index := range indices {
 			i, ok := index.(constant.Constant)
@@ -690,6 +845,9 @@ func (m *Module) unaryExpr(f *Func, n *ast.UnaryExpr) value.Value {
 		// Output:
 		//    %2 = sub i32 0, %1
 		expr := m.expr(f, n.X)
+		if irtypes.IsFloat(expr.Type()) {
+			return f.curBlock.NewFNeg(expr)
+		}
 		zero := constZero(expr.Type())
 		return f.curBlock.NewSub(zero, expr)
 	// !expr
@@ -705,10 +863,22 @@ func (m *Module) unaryExpr(f *Func, n *ast.UnaryExpr) value.Value {
 		// Output:
 		//    %2 = icmp ne i32 %1, 0
 		//    %3 = xor i1 %2, true
-		cond := m.cond(f, n.X)
-		one := constOne(cond.Type())
-		notCond := f.curBlock.NewXor(cond, one)
-		return f.curBlock.NewZExt(notCond, m.typeOf(n.X))
+		//    %4 = zext i1 %3 to i32
+		//
+		// Floating-point operands are compared against zero directly.
+		//
+		//    %2 = fcmp oeq float %1, 0.0
+		//    %3 = zext i1 %2 to i32
+		var notCond value.Value
+		if irtypes.IsFloat(m.typeOf(n.X)) {
+			x := m.expr(f, n.X)
+			notCond = f.curBlock.NewFCmp(enum.FPredOEQ, x, constZero(x.Type()))
+		} else {
+			cond := m.cond(f, n.X)
+			one := constOne(cond.Type())
+			notCond = f.curBlock.NewXor(cond, one)
+		}
+		return m.convert(f, notCond, m.typeOf(n))
 	default:
 		panic(fmt.Sprintf("support for unary operator %v not yet implemented", n.Op))
 	}
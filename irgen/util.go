@@ -2,12 +2,15 @@ package irgen
 
 import (
 	"fmt"
+	"math/big"
 
+	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
 	irtypes "github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem"
 	uctypes "github.com/mewmew/uc/types"
 )
 
@@ -33,60 +36,129 @@ func (m *Module) convert(f *Func, v value.Value, to irtypes.Type) value.Value {
 	if irtypes.Equal(from, to) {
 		return v
 	}
-	fromType, ok := from.(*irtypes.IntType)
-	if !ok {
-		panic(fmt.Sprintf("support for converting from type %T not yet implemented", from))
-	}
-	toType, ok := to.(*irtypes.IntType)
-	if !ok {
-		panic(fmt.Sprintf("support for converting to type %T not yet implemented", to))
-	}
 
 	// Convert constant values.
 	switch v := v.(type) {
 	case *constant.Int:
-		c, err := constant.NewIntFromString(toType, v.Ident())
-		if err != nil {
-			panic(fmt.Errorf("unable to parse integer literal %q; %v", v.Ident(), err))
+		switch to := to.(type) {
+		case *irtypes.IntType:
+			c, err := constant.NewIntFromString(to, v.Ident())
+			if err != nil {
+				panic(fmt.Errorf("unable to parse integer literal %q; %v", v.Ident(), err))
+			}
+			return c
+		case *irtypes.FloatType:
+			x, _ := new(big.Float).SetInt(v.X).Float64()
+			return newFloat(to, x)
 		}
-		return c
 	case *constant.Float:
-		panic(fmt.Sprintf("support for converting type %T not yet implemented", v))
+		switch to := to.(type) {
+		case *irtypes.IntType:
+			return floatToInt(to, v.X)
+		case *irtypes.FloatType:
+			x, _ := v.X.Float64()
+			return newFloat(to, x)
+		}
 	}
 
-	// TODO: Add proper support for converting signed and unsigned values, using
-	// sext and zext, respectively.
+	switch fromType := from.(type) {
+	case *irtypes.IntType:
+		switch toType := to.(type) {
+		case *irtypes.IntType:
+			// TODO: Add proper support for converting signed and unsigned values,
+			// using sext and zext, respectively.
 
-	// Convert unsigned values.
-	if fromType.Equal(irtypes.I1) {
-		// Zero extend boolean values.
-		return f.curBlock.NewZExt(v, toType)
-	}
+			// Convert unsigned values.
+			if fromType.Equal(irtypes.I1) {
+				// Zero extend boolean values.
+				return f.curBlock.NewZExt(v, toType)
+			}
 
-	// Convert signed values.
-	if toType.BitSize > fromType.BitSize {
-		// Sign extend.
-		return f.curBlock.NewSExt(v, toType)
+			// Convert signed values.
+			if toType.BitSize > fromType.BitSize {
+				// Sign extend.
+				return f.curBlock.NewSExt(v, toType)
+			}
+			// Truncate.
+			return f.curBlock.NewTrunc(v, toType)
+		case *irtypes.FloatType:
+			if fromType.Equal(irtypes.I1) {
+				// Convert unsigned boolean values.
+				return f.curBlock.NewUIToFP(v, toType)
+			}
+			return f.curBlock.NewSIToFP(v, toType)
+		}
+	case *irtypes.FloatType:
+		switch toType := to.(type) {
+		case *irtypes.IntType:
+			return f.curBlock.NewFPToSI(v, toType)
+		case *irtypes.FloatType:
+			if isLarger(toType, fromType) {
+				// Extend.
+				return f.curBlock.NewFPExt(v, toType)
+			}
+			// Truncate.
+			return f.curBlock.NewFPTrunc(v, toType)
+		}
 	}
-	// Truncate.
-	return f.curBlock.NewTrunc(v, toType)
+	panic(fmt.Sprintf("support for converting from type %T to type %T not yet implemented", from, to))
 }
 
-// isLarger reports whether t has higher precision than u.
+// isLarger reports whether t has higher precision than u. Floating-point types
+// have higher precision than integer types (see §6.3.1.8).
 func isLarger(t, u irtypes.Type) bool {
-	// A Sizer is a type with a size in number of bits.
-	type Sizer interface {
-		// Size returns the size of t in number of bits.
-		Size() int
-	}
-	if t, ok := t.(Sizer); ok {
-		if u, ok := u.(Sizer); ok {
-			return t.Size() > u.Size()
+	switch t := t.(type) {
+	case *irtypes.IntType:
+		if u, ok := u.(*irtypes.IntType); ok {
+			return t.BitSize > u.BitSize
+		}
+	case *irtypes.FloatType:
+		switch u := u.(type) {
+		case *irtypes.IntType:
+			return true
+		case *irtypes.FloatType:
+			// Floating-point kinds of higher precision are listed after those of
+			// lower precision (i.e. half, float, double).
+			return t.Kind > u.Kind
 		}
 	}
 	return false
 }
 
+// newFloat returns a new floating-point constant of the given type and value,
+// rounded to the precision of the type.
+func newFloat(typ *irtypes.FloatType, x float64) *constant.Float {
+	if typ.Kind == irtypes.FloatKindFloat {
+		x = float64(float32(x))
+	}
+	return constant.NewFloat(typ, x)
+}
+
+// floatToInt returns the integer constant of the given type, converted from the
+// floating-point value x by truncation toward zero (see §6.3.1.4.1). The
+// behaviour is undefined if the integral part of x cannot be represented by the
+// integer type; finite values are wrapped around modulo 2^n as for integer
+// conversions, and infinities are clamped to the range of the integer type.
+func floatToInt(typ *irtypes.IntType, x *big.Float) *constant.Int {
+	// Range of the n-bit signed integer type.
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(typ.BitSize))
+	max := new(big.Int).Rsh(mod, 1)
+	min := new(big.Int).Neg(max)
+	max.Sub(max, big.NewInt(1))
+	if x.IsInf() {
+		if x.Signbit() {
+			return &constant.Int{Typ: typ, X: min}
+		}
+		return &constant.Int{Typ: typ, X: max}
+	}
+	i, _ := x.Int(nil)
+	i.Mod(i, mod)
+	if i.Cmp(max) > 0 {
+		i.Sub(i, mod)
+	}
+	return &constant.Int{Typ: typ, X: i}
+}
+
 // isRef reports whether the given type is a reference type; e.g. pointer or
 // array.
 func isRef(typ irtypes.Type) bool {
@@ -100,8 +172,12 @@ func isRef(typ irtypes.Type) bool {
 	}
 }
 
-// constZero returns the integer constant zero of the given type.
+// constZero returns the integer or floating-point constant zero of the given
+// type.
 func constZero(typ irtypes.Type) constant.Constant {
+	if floatType, ok := typ.(*irtypes.FloatType); ok {
+		return constant.NewFloat(floatType, 0)
+	}
 	intType, ok := typ.(*irtypes.IntType)
 	if !ok {
 		panic(fmt.Errorf("invalid integer literal type; expected *types.IntType, got %T", typ))
@@ -109,6 +185,17 @@ func constZero(typ irtypes.Type) constant.Constant {
 	return constant.NewInt(intType, 0)
 }
 
+// zeroInit returns the zero initializer of the given type.
+func zeroInit(typ irtypes.Type) constant.Constant {
+	switch typ := typ.(type) {
+	case *irtypes.IntType:
+		return constant.NewInt(typ, 0)
+	case *irtypes.FloatType:
+		return constant.NewFloat(typ, 0)
+	}
+	return constant.NewZeroInitializer(typ)
+}
+
 // constOne returns the integer constant one of the given type.
 func constOne(typ irtypes.Type) constant.Constant {
 	intType, ok := typ.(*irtypes.IntType)
@@ -143,11 +230,42 @@ func (f *Func) genUnique(ident *ast.Ident) string {
 	}
 }
 
-// isGlobal reports whether the given identifier is a global definition.
-func (m *Module) isGlobal(ident *ast.Ident) bool {
-	pos := ident.Decl.Name().Start()
-	_, exists := m.idents[pos]
-	return exists
+// isGlobal reports whether the given identifier refers to a global variable
+// (e.g. a file-scope variable or a static local variable).
+func (m *Module) isGlobal(f *Func, ident *ast.Ident) bool {
+	_, ok := m.valueFromIdent(f, ident).(*ir.Global)
+	return ok
+}
+
+// linkageOf returns the linkage of the given file-scope identifier.
+func (m *Module) linkageOf(ident *ast.Ident) sem.Linkage {
+	return m.fileScope.Linkages[ident.Name]
+}
+
+// genUnique generates a unique global name based on the given name.
+func (m *Module) genUnique(name string) string {
+	exists := func(name string) bool {
+		for _, global := range m.Globals {
+			if global.Name() == name {
+				return true
+			}
+		}
+		for _, f := range m.Funcs {
+			if f.Name() == name {
+				return true
+			}
+		}
+		return false
+	}
+	if !exists(name) {
+		return name
+	}
+	for i := 1; ; i++ {
+		unique := fmt.Sprintf("%s.%d", name, i)
+		if !exists(unique) {
+			return unique
+		}
+	}
 }
 
 // valueFromIdent returns the LLVM IR value associated with the given
@@ -204,6 +322,10 @@ func toIrType(n uctypes.Type) irtypes.Type {
 			t = irtypes.NewInt(32)
 		case uctypes.Char:
 			t = irtypes.NewInt(8)
+		case uctypes.Float:
+			t = irtypes.Float
+		case uctypes.Double:
+			t = irtypes.Double
 		case uctypes.Void:
 			t = irtypes.Void
 		}
@@ -0,0 +1,224 @@
+package lint
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// ConstantCondition reports if and while statements with constant conditions.
+var ConstantCondition = &Analyzer{
+	Name: "constant-condition",
+	Doc:  "report if and while statements whose condition is a constant expression",
+	Run:  runConstantCondition,
+}
+
+// runConstantCondition reports the if and while statements of the file whose
+// condition is a constant expression. The infinite loop idiom of while
+// statements with a non-zero integer literal condition (e.g. while (1)) is
+// exempt.
+func runConstantCondition(pass *Pass) ([]*errors.Diagnostic, error) {
+	var diags []*errors.Diagnostic
+	report := func(cond ast.Expr) {
+		c, ok := eval(cond)
+		if !ok {
+			return
+		}
+		diags = append(diags, pass.Warningf(cond.Start(), "condition is always %v", c.isTrue()).Range(cond.Start(), cond.End()))
+	}
+	check := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.IfStmt:
+			report(n.Cond)
+		case *ast.WhileStmt:
+			if lit, ok := n.Cond.(*ast.BasicLit); ok && lit.Kind == token.IntLit {
+				if c, ok := eval(lit); ok && c.isTrue() {
+					break
+				}
+			}
+			report(n.Cond)
+		}
+		return true, nil
+	}
+	if err := astutil.Inspect(pass.File, check); err != nil {
+		return nil, err
+	}
+	return diags, nil
+}
+
+// A constant is the value of a constant expression.
+type constant struct {
+	// Floating-point constant.
+	isFloat bool
+	// Value of integer constant.
+	i int64
+	// Value of floating-point constant.
+	f float64
+}
+
+// isTrue reports whether the constant is non-zero.
+func (c constant) isTrue() bool {
+	if c.isFloat {
+		return c.f != 0
+	}
+	return c.i != 0
+}
+
+// float returns the value of the constant converted to floating-point.
+func (c constant) float() float64 {
+	if c.isFloat {
+		return c.f
+	}
+	return float64(c.i)
+}
+
+// intConst returns an integer constant of the given value.
+func intConst(i int64) constant {
+	return constant{i: i}
+}
+
+// boolConst returns the integer constant of the given truth value.
+func boolConst(b bool) constant {
+	if b {
+		return intConst(1)
+	}
+	return intConst(0)
+}
+
+// eval returns the value of the given expression, and reports whether it is a
+// constant expression; i.e. an expression of literals, operators and
+// parentheses.
+func eval(x ast.Expr) (constant, bool) {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		return evalLit(x)
+	case *ast.ParenExpr:
+		return eval(x.X)
+	case *ast.UnaryExpr:
+		c, ok := eval(x.X)
+		if !ok {
+			return constant{}, false
+		}
+		switch x.Op {
+		case token.Sub:
+			if c.isFloat {
+				return constant{isFloat: true, f: -c.f}, true
+			}
+			return intConst(-c.i), true
+		case token.Not:
+			return boolConst(!c.isTrue()), true
+		}
+		return constant{}, false
+	case *ast.BinaryExpr:
+		return evalBinary(x)
+	default:
+		// Identifiers, calls and index expressions are not constant expressions.
+		return constant{}, false
+	}
+}
+
+// evalLit returns the value of the given basic literal.
+func evalLit(lit *ast.BasicLit) (constant, bool) {
+	switch lit.Kind {
+	case token.IntLit:
+		i, err := strconv.ParseInt(lit.Val, 10, 64)
+		if err != nil {
+			return constant{}, false
+		}
+		return intConst(i), true
+	case token.CharLit:
+		s, err := strconv.Unquote(lit.Val)
+		if err != nil || len(s) == 0 {
+			return constant{}, false
+		}
+		return intConst(int64(s[0])), true
+	case token.FloatLit:
+		f, err := strconv.ParseFloat(strings.TrimRight(lit.Val, "fF"), 64)
+		if err != nil {
+			return constant{}, false
+		}
+		return constant{isFloat: true, f: f}, true
+	default:
+		return constant{}, false
+	}
+}
+
+// evalBinary returns the value of the given binary expression, and reports
+// whether it is a constant expression.
+func evalBinary(x *ast.BinaryExpr) (constant, bool) {
+	if x.Op == token.Assign {
+		return constant{}, false
+	}
+	a, ok := eval(x.X)
+	if !ok {
+		return constant{}, false
+	}
+	if x.Op == token.Land && !a.isTrue() {
+		// Short-circuit evaluation; the second operand is never evaluated.
+		return boolConst(false), true
+	}
+	b, ok := eval(x.Y)
+	if !ok {
+		return constant{}, false
+	}
+	if x.Op == token.Land {
+		return boolConst(b.isTrue()), true
+	}
+	if a.isFloat || b.isFloat {
+		fa, fb := a.float(), b.float()
+		switch x.Op {
+		case token.Add:
+			return constant{isFloat: true, f: fa + fb}, true
+		case token.Sub:
+			return constant{isFloat: true, f: fa - fb}, true
+		case token.Mul:
+			return constant{isFloat: true, f: fa * fb}, true
+		case token.Div:
+			return constant{isFloat: true, f: fa / fb}, true
+		case token.Eq:
+			return boolConst(fa == fb), true
+		case token.Ne:
+			return boolConst(fa != fb), true
+		case token.Lt:
+			return boolConst(fa < fb), true
+		case token.Le:
+			return boolConst(fa <= fb), true
+		case token.Gt:
+			return boolConst(fa > fb), true
+		case token.Ge:
+			return boolConst(fa >= fb), true
+		}
+		return constant{}, false
+	}
+	switch x.Op {
+	case token.Add:
+		return intConst(a.i + b.i), true
+	case token.Sub:
+		return intConst(a.i - b.i), true
+	case token.Mul:
+		return intConst(a.i * b.i), true
+	case token.Div:
+		if b.i == 0 {
+			// Division by zero is undefined.
+			return constant{}, false
+		}
+		return intConst(a.i / b.i), true
+	case token.Eq:
+		return boolConst(a.i == b.i), true
+	case token.Ne:
+		return boolConst(a.i != b.i), true
+	case token.Lt:
+		return boolConst(a.i < b.i), true
+	case token.Le:
+		return boolConst(a.i <= b.i), true
+	case token.Gt:
+		return boolConst(a.i > b.i), true
+	case token.Ge:
+		return boolConst(a.i >= b.i), true
+	}
+	return constant{}, false
+}
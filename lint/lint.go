@@ -0,0 +1,162 @@
+// Package lint implements a registry of lint checks, which report valid but
+// suspicious code of resolved and type-checked parse trees.
+//
+// Each check is described by an Analyzer, which declares the name of the check,
+// its documentation, the semantic information it depends on and a function
+// reporting its diagnostics. The diagnostics of a check are warnings named
+// after the check, and may thus be disabled or promoted to errors using the
+// warning configuration returned by Warnings (e.g. through -W command line
+// flags).
+//
+// The following checks are registered by default.
+//
+//    constant-condition  constant conditions of if and while statements
+//    self-assign         assignments of variables to themselves
+//    self-compare        comparisons of expressions to themselves
+//    shadow              declarations shadowing declarations of outer scopes
+//    unused-parameter    unused function parameters
+//    unused-variable     unused local variables
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// An Analyzer describes a lint check.
+type Analyzer struct {
+	// Name of the check (e.g. "unused-variable"); also used as the warning
+	// name of its diagnostics.
+	Name string
+	// Documentation of the check.
+	Doc string
+	// Semantic information required by the check.
+	Requires Requirement
+	// Run reports the diagnostics of the check for the given pass.
+	Run func(pass *Pass) ([]*errors.Diagnostic, error)
+}
+
+// Requirement specifies the semantic information required by an analyzer, as
+// a bitfield of fields of sem.Info. The identifiers of the parse tree are
+// always resolved.
+type Requirement uint8
+
+// Semantic information requirements.
+const (
+	// NeedTypes specifies that the analyzer requires the types of expressions
+	// (sem.Info.Types).
+	NeedTypes Requirement = 1 << iota
+	// NeedScopes specifies that the analyzer requires the scopes of the parse
+	// tree (sem.Info.Scopes).
+	NeedScopes
+)
+
+// A Pass holds the input of an analyzer run on a single file.
+type Pass struct {
+	// Analyzer being run.
+	Analyzer *Analyzer
+	// Resolved parse tree of the file.
+	File *ast.File
+	// Semantic information of the file; only the fields required by the
+	// analyzer are present, the others are nil.
+	Info *sem.Info
+}
+
+// Warningf returns a new formatted warning of the analyzer based on the given
+// positional information.
+func (pass *Pass) Warningf(pos token.Pos, format string, a ...interface{}) *errors.Diagnostic {
+	return errors.Warningf(pos, pass.Analyzer.Name, format, a...)
+}
+
+// registry maps from check names to registered analyzers.
+var registry = make(map[string]*Analyzer)
+
+func init() {
+	Register(ConstantCondition)
+	Register(SelfAssign)
+	Register(SelfCompare)
+	Register(Shadow)
+	Register(UnusedParameter)
+	Register(UnusedVariable)
+}
+
+// Register registers the given analyzer. Register panics if the name of the
+// analyzer is already in use by a check or a warning of the semantic analysis
+// passes.
+func Register(a *Analyzer) {
+	if _, ok := registry[a.Name]; ok {
+		panic(fmt.Sprintf("check %q already registered", a.Name))
+	}
+	if _, ok := errors.NewWarningConfig()[a.Name]; ok {
+		panic(fmt.Sprintf("unable to register check %q; warning %q already in use", a.Name, a.Name))
+	}
+	registry[a.Name] = a
+}
+
+// Warnings returns a new warning configuration, holding the default state of
+// the warnings of the semantic analysis passes and of the registered checks
+// (enabled by default).
+func Warnings() errors.WarningConfig {
+	warnings := errors.NewWarningConfig()
+	for name := range registry {
+		warnings[name] = errors.WarningOn
+	}
+	return warnings
+}
+
+// Lookup returns the registered analyzer of the given check name; or nil if not
+// found.
+func Lookup(name string) *Analyzer {
+	return registry[name]
+}
+
+// Analyzers returns the registered analyzers, sorted by name.
+func Analyzers() []*Analyzer {
+	var as []*Analyzer
+	for _, a := range registry {
+		as = append(as, a)
+	}
+	sort.Slice(as, func(i, j int) bool {
+		return as[i].Name < as[j].Name
+	})
+	return as
+}
+
+// Run runs the given analyzers on the resolved and type-checked parse tree of
+// a file, and returns their diagnostics sorted by position. Warnings are
+// disabled, kept or promoted to errors based on the given warning configuration
+// (see Warnings).
+func Run(file *ast.File, info *sem.Info, analyzers []*Analyzer, warnings errors.WarningConfig) (errors.List, error) {
+	var diags errors.List
+	for _, a := range analyzers {
+		pass := &Pass{Analyzer: a, File: file, Info: &sem.Info{}}
+		if a.Requires&NeedTypes != 0 {
+			if info.Types == nil {
+				return nil, errutil.Newf("check %q requires type information", a.Name)
+			}
+			pass.Info.Types = info.Types
+		}
+		if a.Requires&NeedScopes != 0 {
+			if info.Scopes == nil {
+				return nil, errutil.Newf("check %q requires scope information", a.Name)
+			}
+			pass.Info.Scopes = info.Scopes
+		}
+		ds, err := a.Run(pass)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		for _, d := range ds {
+			diags.Add(d)
+		}
+	}
+	diags = warnings.Apply(diags)
+	diags.Sort()
+	return diags, nil
+}
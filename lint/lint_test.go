@@ -0,0 +1,179 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/lint"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func TestRun(t *testing.T) {
+	golden := []struct {
+		input string
+		check string
+		want  []string
+	}{
+		// Unused variables.
+		{
+			input: "int g; int f(int a) { int x; int y; extern int z; y = a; return y; }",
+			check: "unused-variable",
+			want: []string{
+				`(byte offset 26) warning: variable "x" declared but not used [-W unused-variable]`,
+			},
+		},
+		{
+			input: "int f(void) { int x; { int y; x = 1; } return x; }",
+			check: "unused-variable",
+			want: []string{
+				`(byte offset 27) warning: variable "y" declared but not used [-W unused-variable]`,
+			},
+		},
+		// Unused parameters.
+		{
+			input: "int g(int b); int h(int); int f(int a, int b) { return b; }",
+			check: "unused-parameter",
+			want: []string{
+				`(byte offset 36) warning: parameter "a" declared but not used [-W unused-parameter]`,
+			},
+		},
+		// Shadowed declarations.
+		{
+			input: "int x; int f(int x) { int y; { int y; int x; y = x; } return y; }",
+			check: "shadow",
+			want: []string{
+				`(byte offset 35) warning: declaration of "y" shadows declaration of outer scope [-W shadow]`,
+				`(byte offset 42) warning: declaration of "x" shadows declaration of outer scope [-W shadow]`,
+			},
+		},
+		{
+			input: "int x; int f(void) { int x; extern int y; x = 1; return x; } int y;",
+			check: "shadow",
+			want: []string{
+				`(byte offset 25) warning: declaration of "x" shadows declaration of outer scope [-W shadow]`,
+			},
+		},
+		{
+			input: "int f(void) { { int x; x = 1; } int x; x = 2; return x; }",
+			check: "shadow",
+			want:  nil,
+		},
+		// Self-assignments.
+		{
+			input: "int f(int a[]) { int x; x = x; a[x] = a[x]; a[f(a)] = a[f(a)]; x = (x = 1); return x; }",
+			check: "self-assign",
+			want: []string{
+				`(byte offset 26) warning: self-assignment of "x" has no effect [-W self-assign]`,
+				`(byte offset 36) warning: self-assignment of "a[x]" has no effect [-W self-assign]`,
+			},
+		},
+		// Self-comparisons.
+		{
+			input: "int f(int x, double d) { if (x == x) return 1; if (x+1 < x+1) return 2; if (d != d) return 3; return x != 1; }",
+			check: "self-compare",
+			want: []string{
+				`(byte offset 31) warning: self-comparison always evaluates to true [-W self-compare]`,
+				`(byte offset 55) warning: self-comparison always evaluates to false [-W self-compare]`,
+			},
+		},
+		// Constant conditions.
+		{
+			input: "int f(int x) { if (1) x = 1; if (2 < 1 && x) x = 2; while (1) x = 3; while (0) x = 4; if ('a' - 97) x = 5; if (1.5 * 2.0) x = 6; if (x) x = 7; return x; }",
+			check: "constant-condition",
+			want: []string{
+				`(byte offset 19) warning: condition is always true [-W constant-condition]`,
+				`(byte offset 33) warning: condition is always false [-W constant-condition]`,
+				`(byte offset 76) warning: condition is always false [-W constant-condition]`,
+				`(byte offset 90) warning: condition is always false [-W constant-condition]`,
+				`(byte offset 111) warning: condition is always true [-W constant-condition]`,
+			},
+		},
+		{
+			input: "int f(int x) { if (1 / 0) x = 1; while ((1)) x = 2; return x; }",
+			check: "constant-condition",
+			want: []string{
+				`(byte offset 40) warning: condition is always true [-W constant-condition]`,
+			},
+		},
+	}
+	defer func(useColor bool) {
+		semerrors.UseColor = useColor
+	}(semerrors.UseColor)
+	semerrors.UseColor = false
+	for _, g := range golden {
+		file, err := parser.ParseString(g.input, nil)
+		if err != nil {
+			t.Errorf("%q: unable to parse input; %v", g.input, err)
+			continue
+		}
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: unable to check input; %v", g.input, err)
+			continue
+		}
+		a := lint.Lookup(g.check)
+		if a == nil {
+			t.Errorf("%q: unable to locate check %q", g.input, g.check)
+			continue
+		}
+		diags, err := lint.Run(file, info, []*lint.Analyzer{a}, lint.Warnings())
+		if err != nil {
+			t.Errorf("%q: unable to run check %q; %v", g.input, g.check, err)
+			continue
+		}
+		var got []string
+		for _, diag := range diags {
+			// Omit notes.
+			diag.Notes = nil
+			got = append(got, diag.Error())
+		}
+		if len(got) != len(g.want) {
+			t.Errorf("%q: diagnostics mismatch; expected %q, got %q", g.input, g.want, got)
+			continue
+		}
+		for i := range g.want {
+			if got[i] != g.want[i] {
+				t.Errorf("%q: diagnostic mismatch; expected %q, got %q", g.input, g.want[i], got[i])
+			}
+		}
+	}
+}
+
+func TestRunWarningState(t *testing.T) {
+	const input = "int f(int a) { return 0; }"
+	file, err := parser.ParseString(input, nil)
+	if err != nil {
+		t.Fatalf("unable to parse input; %v", err)
+	}
+	info, err := sem.Check(file)
+	if err != nil {
+		t.Fatalf("unable to check input; %v", err)
+	}
+	golden := []struct {
+		state semerrors.WarningState
+		want  []semerrors.Severity
+	}{
+		{state: semerrors.WarningOn, want: []semerrors.Severity{semerrors.SeverityWarning}},
+		{state: semerrors.WarningOff, want: nil},
+		{state: semerrors.WarningError, want: []semerrors.Severity{semerrors.SeverityError}},
+	}
+	for _, g := range golden {
+		warnings := lint.Warnings()
+		warnings[lint.UnusedParameter.Name] = g.state
+		diags, err := lint.Run(file, info, lint.Analyzers(), warnings)
+		if err != nil {
+			t.Errorf("state %d: unable to run checks; %v", g.state, err)
+			continue
+		}
+		if len(diags) != len(g.want) {
+			t.Errorf("state %d: diagnostics mismatch; expected %d diagnostics, got %d", g.state, len(g.want), len(diags))
+			continue
+		}
+		for i, diag := range diags {
+			if diag.Severity != g.want[i] {
+				t.Errorf("state %d: severity mismatch; expected %v, got %v", g.state, g.want[i], diag.Severity)
+			}
+		}
+	}
+}
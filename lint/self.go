@@ -0,0 +1,93 @@
+package lint
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// SelfAssign reports assignments of expressions to themselves.
+var SelfAssign = &Analyzer{
+	Name: "self-assign",
+	Doc:  "report assignments of expressions to themselves (e.g. x = x)",
+	Run:  runSelfAssign,
+}
+
+// SelfCompare reports comparisons of expressions to themselves.
+var SelfCompare = &Analyzer{
+	Name:     "self-compare",
+	Doc:      "report comparisons of expressions to themselves (e.g. x == x)",
+	Requires: NeedTypes,
+	Run:      runSelfCompare,
+}
+
+// runSelfAssign reports the assignments of the file whose left- and right-hand
+// operands are the same expression without side effects.
+func runSelfAssign(pass *Pass) ([]*errors.Diagnostic, error) {
+	var diags []*errors.Diagnostic
+	check := func(n ast.Node) (bool, error) {
+		if n, ok := n.(*ast.BinaryExpr); ok && n.Op == token.Assign && isSame(n.X, n.Y) {
+			diags = append(diags, pass.Warningf(n.OpPos, "self-assignment of %q has no effect", n.X).Range(n.Start(), n.End()))
+		}
+		return true, nil
+	}
+	if err := astutil.Inspect(pass.File, check); err != nil {
+		return nil, err
+	}
+	return diags, nil
+}
+
+// runSelfCompare reports the comparisons of the file whose operands are the
+// same expression without side effects. Comparisons of floating-point operands
+// are exempt, as they are used to check for NaN values (e.g. x != x).
+func runSelfCompare(pass *Pass) ([]*errors.Diagnostic, error) {
+	var diags []*errors.Diagnostic
+	check := func(n ast.Node) (bool, error) {
+		expr, ok := n.(*ast.BinaryExpr)
+		if !ok || !isSame(expr.X, expr.Y) || types.IsFloat(pass.Info.Types[expr.X]) {
+			return true, nil
+		}
+		var val bool
+		switch expr.Op {
+		case token.Eq, token.Le, token.Ge:
+			val = true
+		case token.Ne, token.Lt, token.Gt:
+			val = false
+		default:
+			return true, nil
+		}
+		diags = append(diags, pass.Warningf(expr.OpPos, "self-comparison always evaluates to %v", val).Range(expr.Start(), expr.End()))
+		return true, nil
+	}
+	if err := astutil.Inspect(pass.File, check); err != nil {
+		return nil, err
+	}
+	return diags, nil
+}
+
+// isSame reports whether the given expressions are structurally equal, and
+// free of side effects (i.e. contain no calls or assignments).
+func isSame(x, y ast.Expr) bool {
+	return astutil.Equal(x, y, astutil.IgnorePos) && isPure(x)
+}
+
+// isPure reports whether the given expression is free of side effects.
+func isPure(x ast.Expr) bool {
+	pure := true
+	check := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			pure = false
+		case *ast.BinaryExpr:
+			pure = n.Op != token.Assign
+		}
+		if !pure {
+			return false, astutil.Stop
+		}
+		return true, nil
+	}
+	astutil.Inspect(x, check)
+	return pure
+}
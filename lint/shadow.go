@@ -0,0 +1,95 @@
+package lint
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/sem/errors"
+)
+
+// Shadow reports local declarations which shadow declarations of outer scopes.
+var Shadow = &Analyzer{
+	Name:     "shadow",
+	Doc:      "report local declarations which shadow declarations of enclosing scopes",
+	Requires: NeedScopes,
+	Run:      runShadow,
+}
+
+// runShadow reports the local declarations of the file which shadow a
+// declaration of an enclosing scope, visible at the point of declaration.
+// Function parameters, and block scope declarations referring to the entity of
+// the shadowed declaration (e.g. extern declarations of global variables), are
+// exempt.
+func runShadow(pass *Pass) ([]*errors.Diagnostic, error) {
+	params := make(map[*ast.VarDecl]bool)
+	var diags []*errors.Diagnostic
+	scopes := pass.Info.EnclosingScopes(pass.File)
+	check := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.FuncType:
+			for _, param := range n.Params {
+				params[param] = true
+			}
+		case ast.Decl:
+			scope := scopes[n]
+			name := n.Name()
+			if scope == nil || scope.IsFile || name == nil {
+				break
+			}
+			if n, ok := n.(*ast.VarDecl); ok && params[n] {
+				break
+			}
+			if prev := shadowed(scope, n); prev != nil {
+				diag := pass.Warningf(name.Start(), "declaration of %q shadows declaration of outer scope", name).Range(name.Start(), name.End())
+				diag.Notef(prev.Name().Start(), "shadowed declaration of %q", name)
+				diags = append(diags, diag)
+			}
+		case ast.Expr:
+			// Expressions contain no declarations.
+			return false, nil
+		}
+		return true, nil
+	}
+	if err := astutil.Inspect(pass.File, check); err != nil {
+		return nil, err
+	}
+	return diags, nil
+}
+
+// shadowed returns the declaration of an enclosing scope (excluding the
+// universe scope) shadowed by the given declaration of the given scope; or nil
+// if none.
+func shadowed(scope *sem.Scope, decl ast.Decl) ast.Decl {
+	name := decl.Name()
+	if hasLinkage(decl) || name.Decl != nil && name.Decl != decl {
+		// The declaration refers to an entity declared elsewhere (e.g. extern
+		// declarations of global variables).
+		return nil
+	}
+	for s := scope.Outer; s != nil && s.Outer != nil; s = s.Outer {
+		prev, ok := s.Decls[name.Name]
+		if !ok {
+			continue
+		}
+		// Declarations of function scopes are only visible after their point of
+		// declaration.
+		if !s.IsFile && prev.Name().Start() > name.Start() {
+			continue
+		}
+		return prev
+	}
+	return nil
+}
+
+// hasLinkage reports whether the given block scope declaration has linkage;
+// i.e. whether it is an extern variable declaration or a function declaration.
+func hasLinkage(decl ast.Decl) bool {
+	switch decl := decl.(type) {
+	case *ast.VarDecl:
+		return decl.Storage == ast.Extern
+	case *ast.FuncDecl:
+		return !astutil.IsDef(decl)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,110 @@
+package lint
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem/errors"
+)
+
+// UnusedVariable reports local variables which are never referred to.
+var UnusedVariable = &Analyzer{
+	Name: "unused-variable",
+	Doc:  "report local variables which are declared but never used",
+	Run:  runUnusedVariable,
+}
+
+// UnusedParameter reports function parameters which are never referred to.
+var UnusedParameter = &Analyzer{
+	Name: "unused-parameter",
+	Doc:  "report parameters of function definitions which are never used",
+	Run:  runUnusedParameter,
+}
+
+// runUnusedVariable reports the local variable declarations of the file which
+// are not referred to by any identifier. Block scope extern declarations are
+// exempt, as they refer to variables declared elsewhere.
+func runUnusedVariable(pass *Pass) ([]*errors.Diagnostic, error) {
+	used := usedDecls(pass.File)
+	globals := make(map[ast.Decl]bool)
+	for _, decl := range pass.File.Decls {
+		globals[decl] = true
+	}
+	params := make(map[*ast.VarDecl]bool)
+	var diags []*errors.Diagnostic
+	check := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.FuncType:
+			for _, param := range n.Params {
+				params[param] = true
+			}
+		case *ast.VarDecl:
+			if globals[n] || params[n] || n.Storage == ast.Extern || used[n] {
+				return true, nil
+			}
+			name := n.VarName
+			diags = append(diags, pass.Warningf(name.Start(), "variable %q declared but not used", name).Range(name.Start(), name.End()))
+		case ast.Expr:
+			// Expressions contain no declarations.
+			return false, nil
+		}
+		return true, nil
+	}
+	if err := astutil.Inspect(pass.File, check); err != nil {
+		return nil, err
+	}
+	return diags, nil
+}
+
+// runUnusedParameter reports the named parameters of function definitions
+// which are not referred to by any identifier.
+func runUnusedParameter(pass *Pass) ([]*errors.Diagnostic, error) {
+	used := usedDecls(pass.File)
+	var diags []*errors.Diagnostic
+	check := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if !astutil.IsDef(n) {
+				return true, nil
+			}
+			for _, param := range n.FuncType.Params {
+				name := param.VarName
+				if name == nil || used[param] {
+					continue
+				}
+				diags = append(diags, pass.Warningf(name.Start(), "parameter %q declared but not used", name).Range(name.Start(), name.End()))
+			}
+		case ast.Expr:
+			// Expressions contain no declarations.
+			return false, nil
+		}
+		return true, nil
+	}
+	if err := astutil.Inspect(pass.File, check); err != nil {
+		return nil, err
+	}
+	return diags, nil
+}
+
+// usedDecls returns the set of declarations referred to by identifiers of the
+// file, other than the declared identifiers of declarations.
+func usedDecls(file *ast.File) map[ast.Decl]bool {
+	names := make(map[*ast.Ident]bool)
+	var idents []*ast.Ident
+	find := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case ast.Decl:
+			names[n.Name()] = true
+		case *ast.Ident:
+			idents = append(idents, n)
+		}
+		return true, nil
+	}
+	astutil.Inspect(file, find)
+	used := make(map[ast.Decl]bool)
+	for _, ident := range idents {
+		if !names[ident] && ident.Decl != nil {
+			used[ident.Decl] = true
+		}
+	}
+	return used
+}
@@ -0,0 +1,128 @@
+package lsp
+
+import (
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// A document is an analyzed version of an open text document.
+type document struct {
+	// Text document URI.
+	uri string
+	// Input source of the text document.
+	src *semerrors.Source
+	// Parse tree of the text document; partial if the text document contains
+	// syntax errors.
+	file *ast.File
+	// Semantic information of the parse tree.
+	info *sem.Info
+	// Diagnostics of the text document; syntax errors if present, and semantic
+	// analysis diagnostics otherwise.
+	diags semerrors.List
+}
+
+// newDocument parses and checks the given content of a text document.
+//
+// The partial parse tree of documents containing syntax errors is checked as
+// well, so that the semantic information is available while editing; its
+// semantic analysis diagnostics are not reported, as they are often caused by
+// the declarations and statements omitted from the partial parse tree.
+func newDocument(uri, text string) (*document, error) {
+	d := &document{uri: uri, src: semerrors.NewSource(uriPath(uri), text)}
+	file, err := parser.NewParser().ParseFile(scanner.NewFromString(text), d.src)
+	if err != nil {
+		errs, ok := err.(semerrors.List)
+		if !ok {
+			return nil, errutil.Err(err)
+		}
+		d.diags = errs
+	}
+	info, err := sem.Check(file)
+	if err != nil {
+		if _, ok := err.(semerrors.List); !ok {
+			return nil, errutil.Err(err)
+		}
+	}
+	d.file, d.info = file, info
+	if len(d.diags) == 0 {
+		d.diags = info.Diagnostics
+	}
+	return d, nil
+}
+
+// uriPath returns the file path of the given text document URI, for use in
+// diagnostics; or the URI itself if not a file URI.
+func uriPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return u.Path
+}
+
+// pos returns the source position of the given text document position, clamped
+// to the content of the text document.
+func (d *document) pos(p Position) token.Pos {
+	input := d.src.Input
+	lines := d.src.File.Lines()
+	if p.Line < 0 {
+		return d.src.File.Pos(0)
+	}
+	if p.Line >= len(lines) {
+		return d.src.File.Pos(len(input))
+	}
+	offset := lines[p.Line]
+	// Advance by the given number of UTF-16 code units, without crossing the
+	// end of the line.
+	for n := 0; n < p.Character && offset < len(input) && input[offset] != '\n'; {
+		r, size := utf8.DecodeRuneInString(input[offset:])
+		offset += size
+		n += utf16Len(r)
+	}
+	return d.src.File.Pos(offset)
+}
+
+// position returns the text document position of the given source position.
+// Positions outside of the content of the text document are clamped.
+func (d *document) position(pos token.Pos) Position {
+	offset := int(pos) - d.src.File.Base()
+	switch {
+	case offset < 0:
+		offset = 0
+	case offset > len(d.src.Input):
+		offset = len(d.src.Input)
+	}
+	p := d.src.File.Position(d.src.File.Pos(offset))
+	line := d.src.Input[offset-(p.Column-1) : offset]
+	n := 0
+	for _, r := range line {
+		n += utf16Len(r)
+	}
+	return Position{Line: p.Line - 1, Character: n}
+}
+
+// rangeOf returns the text document range of the given node.
+func (d *document) rangeOf(n ast.Node) Range {
+	return Range{Start: d.position(n.Start()), End: d.position(n.End())}
+}
+
+// location returns the text document location of the given node.
+func (d *document) location(n ast.Node) Location {
+	return Location{URI: d.uri, Range: d.rangeOf(n)}
+}
+
+// utf16Len returns the number of UTF-16 code units of the given rune.
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,219 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/refactor"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// diagnostics returns the diagnostics of the text document. The notes of each
+// diagnostic are returned as related information.
+func (d *document) diagnostics() []Diagnostic {
+	diags := make([]Diagnostic, 0, len(d.diags))
+	for _, diag := range d.diags {
+		start, end := diag.Pos, diag.Pos
+		if diag.End > diag.Start {
+			start, end = diag.Start, diag.End
+		}
+		severity := SeverityError
+		if diag.Severity == semerrors.SeverityWarning {
+			severity = SeverityWarning
+		}
+		lspDiag := Diagnostic{
+			Range:    Range{Start: d.position(start), End: d.position(end)},
+			Severity: severity,
+			Code:     diag.Warning,
+			Source:   "ucls",
+			Message:  diag.Text,
+		}
+		for _, note := range diag.Notes {
+			p := d.position(note.Pos)
+			info := DiagnosticRelatedInformation{
+				Location: Location{URI: d.uri, Range: Range{Start: p, End: p}},
+				Message:  note.Text,
+			}
+			lspDiag.RelatedInformation = append(lspDiag.RelatedInformation, info)
+		}
+		diags = append(diags, lspDiag)
+	}
+	return diags
+}
+
+// hover returns the type of the innermost expression at the given position, as
+// recorded in the semantic information of the text document; or nil if not
+// found. Identifiers are presented together with their name.
+func (d *document) hover(p Position) *Hover {
+	pos := d.pos(p)
+	var expr ast.Expr
+	find := func(n ast.Node) (bool, error) {
+		if _, ok := n.(*ast.File); !ok && (pos < n.Start() || n.End() <= pos) {
+			return false, nil
+		}
+		if n, ok := n.(ast.Expr); ok {
+			if _, ok := d.info.Types[n]; ok {
+				expr = n
+			}
+		}
+		return true, nil
+	}
+	astutil.Inspect(d.file, find)
+	if expr == nil {
+		return nil
+	}
+	val := d.info.Types[expr].String()
+	if ident, ok := expr.(*ast.Ident); ok {
+		val = fmt.Sprintf("%s: %s", ident, val)
+	}
+	return &Hover{
+		Contents: MarkupContent{Kind: "plaintext", Value: val},
+		Range:    d.rangeOf(expr),
+	}
+}
+
+// definition returns the location of the declaration referred to by the
+// identifier at the given position; or nil if not found.
+func (d *document) definition(p Position) *Location {
+	ident := refactor.IdentAt(d.file, d.pos(p))
+	if ident == nil || ident.Decl == nil {
+		return nil
+	}
+	name := ident.Decl.Name()
+	if name == nil || !name.Start().IsValid() {
+		// Predeclared or undeclared identifier.
+		return nil
+	}
+	loc := d.location(name)
+	return &loc
+}
+
+// references returns the locations of the identifiers referring to the entity
+// declared by the identifier at the given position, optionally including the
+// declared identifiers of its declarations; or nil if not found.
+func (d *document) references(p Position, includeDecl bool) []Location {
+	ident := refactor.IdentAt(d.file, d.pos(p))
+	if ident == nil || ident.Decl == nil || sem.IsUndeclared(ident.Decl) {
+		return nil
+	}
+	names := make(map[*ast.Ident]bool)
+	if !includeDecl {
+		find := func(n ast.Node) (bool, error) {
+			if decl, ok := n.(ast.Decl); ok {
+				names[decl.Name()] = true
+			}
+			return true, nil
+		}
+		astutil.Inspect(d.file, find)
+	}
+	locs := []Location{}
+	for _, ref := range refactor.Refs(d.file, d.info, ident.Decl) {
+		if !names[ref] {
+			locs = append(locs, d.location(ref))
+		}
+	}
+	return locs
+}
+
+// symbols returns the declarations of the text document, in source order. The
+// parameters and local declarations of functions are nested within the
+// function declarations.
+func (d *document) symbols() []DocumentSymbol {
+	syms := []DocumentSymbol{}
+	for _, decl := range d.file.Decls {
+		syms = append(syms, d.symbolsOf(decl)...)
+	}
+	return syms
+}
+
+// symbolsOf returns the declarations of the given node, pruning the traversal
+// at declarations.
+func (d *document) symbolsOf(n ast.Node) []DocumentSymbol {
+	var syms []DocumentSymbol
+	find := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case ast.Decl:
+			if n.Name() == nil {
+				// Anonymous function parameter declaration.
+				return false, nil
+			}
+			sym := DocumentSymbol{
+				Name:           n.Name().Name,
+				Detail:         n.Type().String(),
+				Range:          d.rangeOf(n),
+				SelectionRange: d.rangeOf(n.Name()),
+			}
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				sym.Kind = SymbolKindFunction
+				sym.Children = d.symbolsOf(n.FuncType)
+				if n.Body != nil {
+					sym.Children = append(sym.Children, d.symbolsOf(n.Body)...)
+				}
+			case *ast.VarDecl:
+				sym.Kind = SymbolKindVariable
+			case *ast.TypeDef:
+				sym.Kind = SymbolKindClass
+			}
+			syms = append(syms, sym)
+			return false, nil
+		case ast.Expr:
+			// Expressions contain no declarations.
+			return false, nil
+		}
+		return true, nil
+	}
+	astutil.Inspect(n, find)
+	return syms
+}
+
+// completion returns the identifiers visible at the given position, as
+// determined by the scope chain of the innermost scope enclosing the position.
+func (d *document) completion(p Position) []CompletionItem {
+	items := []CompletionItem{}
+	pos := d.pos(p)
+	scope := d.info.Scopes[d.file]
+	size := token.Pos(-1)
+	for n, s := range d.info.Scopes {
+		if _, ok := n.(*ast.File); ok || pos <= n.Start() || n.End() <= pos {
+			continue
+		}
+		if size == -1 || n.End()-n.Start() < size {
+			scope, size = s, n.End()-n.Start()
+		}
+	}
+	seen := make(map[string]bool)
+	for s := scope; s != nil; s = s.Outer {
+		for name, decl := range s.Decls {
+			if seen[name] || !visible(s, decl, pos) {
+				continue
+			}
+			seen[name] = true
+			item := CompletionItem{Label: name, Detail: decl.Type().String()}
+			switch decl.(type) {
+			case *ast.FuncDecl:
+				item.Kind = CompletionItemKindFunction
+			case *ast.VarDecl:
+				item.Kind = CompletionItemKindVariable
+			case *ast.TypeDef:
+				item.Kind = CompletionItemKindClass
+			}
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+	return items
+}
+
+// visible reports whether the given declaration of the given scope is visible
+// at the given position. File scope and universe scope declarations are
+// visible throughout the file.
+func visible(scope *sem.Scope, decl ast.Decl, pos token.Pos) bool {
+	return scope.IsFile || scope.Outer == nil || decl.Name().End() < pos
+}
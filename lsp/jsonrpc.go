@@ -0,0 +1,131 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// A request is a JSON-RPC 2.0 request or notification message.
+type request struct {
+	// JSON-RPC version; always "2.0".
+	JSONRPC string `json:"jsonrpc"`
+	// Request ID; or nil if notification.
+	ID *json.RawMessage `json:"id,omitempty"`
+	// Method name.
+	Method string `json:"method"`
+	// Method parameters.
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// A response is a JSON-RPC 2.0 response message.
+type response struct {
+	// JSON-RPC version; always "2.0".
+	JSONRPC string `json:"jsonrpc"`
+	// Request ID.
+	ID *json.RawMessage `json:"id"`
+	// Result of the request; or nil if error.
+	Result *json.RawMessage `json:"result,omitempty"`
+	// Error of the request; or nil if successful.
+	Error *ResponseError `json:"error,omitempty"`
+}
+
+// A notification is a JSON-RPC 2.0 notification message sent by the server.
+type notification struct {
+	// JSON-RPC version; always "2.0".
+	JSONRPC string `json:"jsonrpc"`
+	// Method name.
+	Method string `json:"method"`
+	// Method parameters.
+	Params interface{} `json:"params"`
+}
+
+// ResponseError is the error of an unsuccessful request.
+type ResponseError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// Error codes.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+)
+
+// Error returns the error message of the response error.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// readMessage reads the content of a message from r. Each message is preceded
+// by a header, which is separated from the content by an empty line, e.g.
+//
+//    Content-Length: 44\r\n
+//    \r\n
+//    {"jsonrpc":"2.0","id":1,"method":"shutdown"}
+//
+// The io.EOF error is returned unwrapped at the end of input.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && len(line) == 0 && length == -1 {
+				return nil, io.EOF
+			}
+			return nil, errutil.Err(err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			break
+		}
+		i := strings.Index(line, ":")
+		if i == -1 {
+			return nil, errutil.Newf("invalid header line %q", line)
+		}
+		name, val := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		if strings.EqualFold(name, "Content-Length") {
+			length, err = strconv.Atoi(val)
+			if err != nil || length < 0 {
+				return nil, errutil.Newf("invalid content length %q", val)
+			}
+		}
+	}
+	if length == -1 {
+		return nil, errutil.New("missing Content-Length header")
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, errutil.Err(err)
+	}
+	return buf, nil
+}
+
+// writeMessage writes the JSON encoding of the given message to w, preceded by
+// a header.
+func writeMessage(w io.Writer, v interface{}) error {
+	// Operators of diagnostic messages (e.g. '<') are not escaped, as the
+	// content is not embedded in HTML.
+	content := &bytes.Buffer{}
+	enc := json.NewEncoder(content)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return errutil.Err(err)
+	}
+	buf := bytes.TrimSuffix(content.Bytes(), []byte("\n"))
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(buf), buf); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
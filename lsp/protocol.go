@@ -0,0 +1,281 @@
+package lsp
+
+// The subset of the Language Server Protocol types used by the server.
+//
+// ref: https://microsoft.github.io/language-server-protocol/specification
+
+// Position is a zero-based line and character offset within a text document.
+// The character offset is measured in UTF-16 code units.
+type Position struct {
+	// Line number, starting at 0.
+	Line int `json:"line"`
+	// Character offset within the line, starting at 0.
+	Character int `json:"character"`
+}
+
+// Range is a text document range [Start, End).
+type Range struct {
+	// Start position of the range.
+	Start Position `json:"start"`
+	// End position of the range, exclusive.
+	End Position `json:"end"`
+}
+
+// Location is a range within a text document.
+type Location struct {
+	// Text document URI.
+	URI string `json:"uri"`
+	// Range within the text document.
+	Range Range `json:"range"`
+}
+
+// TextDocumentIdentifier identifies a text document.
+type TextDocumentIdentifier struct {
+	// Text document URI.
+	URI string `json:"uri"`
+}
+
+// TextDocumentItem is a text document transferred from the client.
+type TextDocumentItem struct {
+	// Text document URI.
+	URI string `json:"uri"`
+	// Language identifier of the text document.
+	LanguageID string `json:"languageId"`
+	// Version number of the text document.
+	Version int `json:"version"`
+	// Content of the text document.
+	Text string `json:"text"`
+}
+
+// VersionedTextDocumentIdentifier identifies a version of a text document.
+type VersionedTextDocumentIdentifier struct {
+	// Text document URI.
+	URI string `json:"uri"`
+	// Version number of the text document.
+	Version int `json:"version"`
+}
+
+// TextDocumentContentChangeEvent is a change of a text document. As the server
+// uses full text document synchronization, the change contains the full
+// content of the text document.
+type TextDocumentContentChangeEvent struct {
+	// Content of the text document.
+	Text string `json:"text"`
+}
+
+// TextDocumentPositionParams are the parameters of requests at a position of a
+// text document.
+type TextDocumentPositionParams struct {
+	// Text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	// Position within the text document.
+	Position Position `json:"position"`
+}
+
+// InitializeResult is the result of the initialize request.
+type InitializeResult struct {
+	// Capabilities of the server.
+	Capabilities ServerCapabilities `json:"capabilities"`
+	// Information about the server.
+	ServerInfo ServerInfo `json:"serverInfo"`
+}
+
+// ServerCapabilities are the capabilities of the server.
+type ServerCapabilities struct {
+	// Text document synchronization kind.
+	TextDocumentSync TextDocumentSyncKind `json:"textDocumentSync"`
+	// Server provides hover support.
+	HoverProvider bool `json:"hoverProvider"`
+	// Server provides go-to-definition support.
+	DefinitionProvider bool `json:"definitionProvider"`
+	// Server provides find-references support.
+	ReferencesProvider bool `json:"referencesProvider"`
+	// Server provides document symbol support.
+	DocumentSymbolProvider bool `json:"documentSymbolProvider"`
+	// Server provides completion support.
+	CompletionProvider *CompletionOptions `json:"completionProvider,omitempty"`
+}
+
+// ServerInfo holds information about the server.
+type ServerInfo struct {
+	// Name of the server.
+	Name string `json:"name"`
+}
+
+// TextDocumentSyncKind specifies how text documents are synchronized.
+type TextDocumentSyncKind int
+
+// Text document synchronization kinds.
+const (
+	// SyncFull specifies that the full content of text documents is sent on
+	// each change.
+	SyncFull TextDocumentSyncKind = 1
+)
+
+// CompletionOptions are the options of completion support.
+type CompletionOptions struct {
+}
+
+// DidOpenTextDocumentParams are the parameters of the textDocument/didOpen
+// notification.
+type DidOpenTextDocumentParams struct {
+	// Opened text document.
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// DidChangeTextDocumentParams are the parameters of the textDocument/didChange
+// notification.
+type DidChangeTextDocumentParams struct {
+	// Changed text document.
+	TextDocument VersionedTextDocumentIdentifier `json:"textDocument"`
+	// Content changes of the text document.
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// DidCloseTextDocumentParams are the parameters of the textDocument/didClose
+// notification.
+type DidCloseTextDocumentParams struct {
+	// Closed text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// PublishDiagnosticsParams are the parameters of the
+// textDocument/publishDiagnostics notification.
+type PublishDiagnosticsParams struct {
+	// Text document URI.
+	URI string `json:"uri"`
+	// Diagnostics of the text document.
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// Diagnostic is an error or warning of a text document.
+type Diagnostic struct {
+	// Range of the diagnostic.
+	Range Range `json:"range"`
+	// Severity of the diagnostic.
+	Severity DiagnosticSeverity `json:"severity"`
+	// Name of the warning; or empty if not a warning.
+	Code string `json:"code,omitempty"`
+	// Source of the diagnostic.
+	Source string `json:"source"`
+	// Diagnostic message.
+	Message string `json:"message"`
+	// Notes related to the diagnostic.
+	RelatedInformation []DiagnosticRelatedInformation `json:"relatedInformation,omitempty"`
+}
+
+// DiagnosticSeverity is the severity of a diagnostic.
+type DiagnosticSeverity int
+
+// Diagnostic severities.
+const (
+	SeverityError       DiagnosticSeverity = 1
+	SeverityWarning     DiagnosticSeverity = 2
+	SeverityInformation DiagnosticSeverity = 3
+)
+
+// DiagnosticRelatedInformation is a note related to a diagnostic.
+type DiagnosticRelatedInformation struct {
+	// Location of the note.
+	Location Location `json:"location"`
+	// Note message.
+	Message string `json:"message"`
+}
+
+// Hover is the result of the textDocument/hover request.
+type Hover struct {
+	// Hover contents.
+	Contents MarkupContent `json:"contents"`
+	// Range of the hovered node.
+	Range Range `json:"range"`
+}
+
+// MarkupContent is text content of a given kind.
+type MarkupContent struct {
+	// Kind of content (e.g. "plaintext" or "markdown").
+	Kind string `json:"kind"`
+	// Content.
+	Value string `json:"value"`
+}
+
+// ReferenceParams are the parameters of the textDocument/references request.
+type ReferenceParams struct {
+	TextDocumentPositionParams
+	// Context of the request.
+	Context ReferenceContext `json:"context"`
+}
+
+// ReferenceContext is the context of the textDocument/references request.
+type ReferenceContext struct {
+	// Include the declarations of the referenced entity.
+	IncludeDeclaration bool `json:"includeDeclaration"`
+}
+
+// DocumentSymbolParams are the parameters of the textDocument/documentSymbol
+// request.
+type DocumentSymbolParams struct {
+	// Text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// DocumentSymbol is a declaration of a text document.
+type DocumentSymbol struct {
+	// Name of the declared identifier.
+	Name string `json:"name"`
+	// Type of the declared identifier.
+	Detail string `json:"detail,omitempty"`
+	// Kind of the declaration.
+	Kind SymbolKind `json:"kind"`
+	// Range of the declaration.
+	Range Range `json:"range"`
+	// Range of the declared identifier.
+	SelectionRange Range `json:"selectionRange"`
+	// Nested declarations (e.g. parameters and local variables of functions).
+	Children []DocumentSymbol `json:"children,omitempty"`
+}
+
+// SymbolKind is the kind of a document symbol.
+type SymbolKind int
+
+// Symbol kinds.
+const (
+	SymbolKindClass    SymbolKind = 5
+	SymbolKindFunction SymbolKind = 12
+	SymbolKindVariable SymbolKind = 13
+)
+
+// CompletionItem is a completion candidate.
+type CompletionItem struct {
+	// Name of the candidate identifier.
+	Label string `json:"label"`
+	// Kind of the candidate.
+	Kind CompletionItemKind `json:"kind"`
+	// Type of the candidate identifier.
+	Detail string `json:"detail,omitempty"`
+}
+
+// CompletionItemKind is the kind of a completion candidate.
+type CompletionItemKind int
+
+// Completion candidate kinds.
+const (
+	CompletionItemKindFunction CompletionItemKind = 3
+	CompletionItemKindVariable CompletionItemKind = 6
+	CompletionItemKindClass    CompletionItemKind = 7
+)
+
+// LogMessageParams are the parameters of the window/logMessage notification.
+type LogMessageParams struct {
+	// Message type.
+	Type MessageType `json:"type"`
+	// Log message.
+	Message string `json:"message"`
+}
+
+// MessageType is the type of a log message.
+type MessageType int
+
+// Message types.
+const (
+	MessageTypeError MessageType = 1
+)
@@ -0,0 +1,234 @@
+// Package lsp implements a Language Server Protocol server for the µC language.
+//
+// The server communicates using JSON-RPC 2.0 messages, and supports the
+// following requests and notifications.
+//
+//    initialize, initialized, shutdown, exit
+//    textDocument/didOpen, textDocument/didChange, textDocument/didClose
+//    textDocument/hover
+//    textDocument/definition
+//    textDocument/references
+//    textDocument/documentSymbol
+//    textDocument/completion
+//
+// Text documents are parsed and checked on open and on each change, after which
+// the diagnostics of the text document are published using the
+// textDocument/publishDiagnostics notification.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// A Server is a Language Server Protocol server, which reads requests from an
+// input stream and writes responses and notifications to an output stream.
+type Server struct {
+	// Input stream.
+	r *bufio.Reader
+	// Output stream.
+	w io.Writer
+	// Map from text document URIs to open text documents.
+	docs map[string]*document
+	// Shutdown requested.
+	shutdown bool
+}
+
+// NewServer returns a new server communicating over the given input and output
+// streams (e.g. standard input and standard output).
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{
+		r:    bufio.NewReader(r),
+		w:    w,
+		docs: make(map[string]*document),
+	}
+}
+
+// Serve reads and handles messages until the exit notification is received or
+// the end of the input stream is reached. An error is returned if the exit
+// notification is received before the shutdown request.
+func (s *Server) Serve() error {
+	for {
+		buf, err := readMessage(s.r)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errutil.Err(err)
+		}
+		req := &request{}
+		if err := json.Unmarshal(buf, req); err != nil {
+			resp := &response{JSONRPC: "2.0", Error: &ResponseError{Code: CodeParseError, Message: err.Error()}}
+			if err := writeMessage(s.w, resp); err != nil {
+				return errutil.Err(err)
+			}
+			continue
+		}
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return errutil.New("exit notification received before shutdown request")
+			}
+			return nil
+		}
+		if err := s.handle(req); err != nil {
+			return errutil.Err(err)
+		}
+	}
+}
+
+// handle handles the given request or notification. Only errors of the output
+// stream are returned; other errors are reported to the client.
+func (s *Server) handle(req *request) error {
+	var (
+		// Result of the request.
+		result interface{}
+		// Diagnostics to publish; or nil if unchanged.
+		publish *PublishDiagnosticsParams
+		err     error
+	)
+	switch req.Method {
+	case "initialize":
+		result = s.initialize()
+	case "initialized":
+	case "shutdown":
+		s.shutdown = true
+	case "textDocument/didOpen":
+		params := &DidOpenTextDocumentParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			publish, err = s.update(params.TextDocument.URI, params.TextDocument.Text)
+		}
+	case "textDocument/didChange":
+		params := &DidChangeTextDocumentParams{}
+		if err = unmarshalParams(req.Params, params); err == nil && len(params.ContentChanges) > 0 {
+			// Full text document synchronization; the last change holds the
+			// current content.
+			text := params.ContentChanges[len(params.ContentChanges)-1].Text
+			publish, err = s.update(params.TextDocument.URI, text)
+		}
+	case "textDocument/didClose":
+		params := &DidCloseTextDocumentParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			publish = s.close(params.TextDocument.URI)
+		}
+	case "textDocument/hover":
+		params := &TextDocumentPositionParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			if d, ok := s.docs[params.TextDocument.URI]; ok {
+				result = d.hover(params.Position)
+			}
+		}
+	case "textDocument/definition":
+		params := &TextDocumentPositionParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			if d, ok := s.docs[params.TextDocument.URI]; ok {
+				result = d.definition(params.Position)
+			}
+		}
+	case "textDocument/references":
+		params := &ReferenceParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			if d, ok := s.docs[params.TextDocument.URI]; ok {
+				result = d.references(params.Position, params.Context.IncludeDeclaration)
+			}
+		}
+	case "textDocument/documentSymbol":
+		params := &DocumentSymbolParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			if d, ok := s.docs[params.TextDocument.URI]; ok {
+				result = d.symbols()
+			}
+		}
+	case "textDocument/completion":
+		params := &TextDocumentPositionParams{}
+		if err = unmarshalParams(req.Params, params); err == nil {
+			if d, ok := s.docs[params.TextDocument.URI]; ok {
+				result = d.completion(params.Position)
+			}
+		}
+	default:
+		if req.ID == nil {
+			// Ignore unsupported notifications.
+			return nil
+		}
+		err = &ResponseError{Code: CodeMethodNotFound, Message: "method not found: " + req.Method}
+	}
+	if publish != nil {
+		if err := s.notify("textDocument/publishDiagnostics", publish); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	if req.ID == nil {
+		if err != nil {
+			// Notifications have no response; report the error as a log message
+			// instead.
+			return s.notify("window/logMessage", &LogMessageParams{Type: MessageTypeError, Message: err.Error()})
+		}
+		return nil
+	}
+	resp := &response{JSONRPC: "2.0", ID: req.ID}
+	if err != nil {
+		e, ok := err.(*ResponseError)
+		if !ok {
+			e = &ResponseError{Code: CodeInternalError, Message: err.Error()}
+		}
+		resp.Error = e
+	} else {
+		buf, err := json.Marshal(result)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		raw := json.RawMessage(buf)
+		resp.Result = &raw
+	}
+	return writeMessage(s.w, resp)
+}
+
+// unmarshalParams decodes the given request parameters into v.
+func unmarshalParams(params json.RawMessage, v interface{}) error {
+	if err := json.Unmarshal(params, v); err != nil {
+		return &ResponseError{Code: CodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// notify sends a notification of the given method and parameters to the
+// client.
+func (s *Server) notify(method string, params interface{}) error {
+	return writeMessage(s.w, &notification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+// initialize returns the capabilities of the server.
+func (s *Server) initialize() *InitializeResult {
+	return &InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync:       SyncFull,
+			HoverProvider:          true,
+			DefinitionProvider:     true,
+			ReferencesProvider:     true,
+			DocumentSymbolProvider: true,
+			CompletionProvider:     &CompletionOptions{},
+		},
+		ServerInfo: ServerInfo{Name: "ucls"},
+	}
+}
+
+// update parses and checks the given content of a text document, and returns
+// its diagnostics.
+func (s *Server) update(uri, text string) (*PublishDiagnosticsParams, error) {
+	d, err := newDocument(uri, text)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	s.docs[uri] = d
+	return &PublishDiagnosticsParams{URI: uri, Diagnostics: d.diagnostics()}, nil
+}
+
+// close forgets the given text document, and returns the diagnostics clearing
+// its previously published diagnostics.
+func (s *Server) close(uri string) *PublishDiagnosticsParams {
+	delete(s.docs, uri)
+	return &PublishDiagnosticsParams{URI: uri, Diagnostics: []Diagnostic{}}
+}
@@ -0,0 +1,166 @@
+package lsp_test
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/lsp"
+)
+
+// input is the content of the text document used by the scripted client.
+const input = `int x;
+int f(int a) {
+	int y;
+	y = a + x;
+	return y;
+}
+int g(void) { return f(x) + z; }
+`
+
+func TestServer(t *testing.T) {
+	// Script of client messages, and the expected server messages in response.
+	golden := []struct {
+		send string
+		want []string
+	}{
+		{
+			send: `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"capabilities":{}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":1,"result":{"capabilities":{"textDocumentSync":1,"hoverProvider":true,"definitionProvider":true,"referencesProvider":true,"documentSymbolProvider":true,"completionProvider":{}},"serverInfo":{"name":"ucls"}}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+		},
+		// Diagnostics on open.
+		{
+			send: fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///a.c","languageId":"c","version":1,"text":%s}}}`, strconv.Quote(input)),
+			want: []string{`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///a.c","diagnostics":[{"range":{"start":{"line":6,"character":28},"end":{"line":6,"character":29}},"severity":1,"source":"ucls","message":"undeclared identifier \"z\""}]}}`},
+		},
+		// Hover.
+		{
+			send: `{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":3,"character":5}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":2,"result":{"contents":{"kind":"plaintext","value":"a: int"},"range":{"start":{"line":3,"character":5},"end":{"line":3,"character":6}}}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","id":3,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":3,"character":3}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":3,"result":{"contents":{"kind":"plaintext","value":"int"},"range":{"start":{"line":3,"character":1},"end":{"line":3,"character":10}}}}`},
+		},
+		// Go-to-definition.
+		{
+			send: `{"jsonrpc":"2.0","id":4,"method":"textDocument/definition","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":3,"character":9}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":4,"result":{"uri":"file:///a.c","range":{"start":{"line":0,"character":4},"end":{"line":0,"character":5}}}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","id":5,"method":"textDocument/definition","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":0,"character":1}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":5,"result":null}`},
+		},
+		// Find-references.
+		{
+			send: `{"jsonrpc":"2.0","id":6,"method":"textDocument/references","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":0,"character":4},"context":{"includeDeclaration":true}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":6,"result":[{"uri":"file:///a.c","range":{"start":{"line":0,"character":4},"end":{"line":0,"character":5}}},{"uri":"file:///a.c","range":{"start":{"line":3,"character":9},"end":{"line":3,"character":10}}},{"uri":"file:///a.c","range":{"start":{"line":6,"character":23},"end":{"line":6,"character":24}}}]}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","id":7,"method":"textDocument/references","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":1,"character":10},"context":{"includeDeclaration":false}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":7,"result":[{"uri":"file:///a.c","range":{"start":{"line":3,"character":5},"end":{"line":3,"character":6}}}]}`},
+		},
+		// Document symbols.
+		{
+			send: `{"jsonrpc":"2.0","id":8,"method":"textDocument/documentSymbol","params":{"textDocument":{"uri":"file:///a.c"}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":8,"result":[{"name":"x","detail":"int","kind":13,"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":5}},"selectionRange":{"start":{"line":0,"character":4},"end":{"line":0,"character":5}}},{"name":"f","detail":"int(int a)","kind":12,"range":{"start":{"line":1,"character":0},"end":{"line":5,"character":1}},"selectionRange":{"start":{"line":1,"character":4},"end":{"line":1,"character":5}},"children":[{"name":"a","detail":"int","kind":13,"range":{"start":{"line":1,"character":6},"end":{"line":1,"character":11}},"selectionRange":{"start":{"line":1,"character":10},"end":{"line":1,"character":11}}},{"name":"y","detail":"int","kind":13,"range":{"start":{"line":2,"character":1},"end":{"line":2,"character":6}},"selectionRange":{"start":{"line":2,"character":5},"end":{"line":2,"character":6}}}]},{"name":"g","detail":"int(void)","kind":12,"range":{"start":{"line":6,"character":0},"end":{"line":6,"character":32}},"selectionRange":{"start":{"line":6,"character":4},"end":{"line":6,"character":5}}}]}`},
+		},
+		// Completion.
+		{
+			send: `{"jsonrpc":"2.0","id":9,"method":"textDocument/completion","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":4,"character":1}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":9,"result":[{"label":"a","kind":6,"detail":"int"},{"label":"char","kind":7,"detail":"char"},{"label":"double","kind":7,"detail":"double"},{"label":"f","kind":3,"detail":"int(int a)"},{"label":"float","kind":7,"detail":"float"},{"label":"g","kind":3,"detail":"int(void)"},{"label":"int","kind":7,"detail":"int"},{"label":"void","kind":7,"detail":"void"},{"label":"x","kind":6,"detail":"int"},{"label":"y","kind":6,"detail":"int"}]}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","id":10,"method":"textDocument/completion","params":{"textDocument":{"uri":"file:///a.c"},"position":{"line":2,"character":1}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":10,"result":[{"label":"a","kind":6,"detail":"int"},{"label":"char","kind":7,"detail":"char"},{"label":"double","kind":7,"detail":"double"},{"label":"f","kind":3,"detail":"int(int a)"},{"label":"float","kind":7,"detail":"float"},{"label":"g","kind":3,"detail":"int(void)"},{"label":"int","kind":7,"detail":"int"},{"label":"void","kind":7,"detail":"void"},{"label":"x","kind":6,"detail":"int"}]}`},
+		},
+		// Unsupported methods.
+		{
+			send: `{"jsonrpc":"2.0","id":"foo","method":"textDocument/rename","params":{}}`,
+			want: []string{`{"jsonrpc":"2.0","id":"foo","error":{"code":-32601,"message":"method not found: textDocument/rename"}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":1}}`,
+		},
+		// Diagnostics on change and close.
+		{
+			send: `{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///a.c","version":2},"contentChanges":[{"text":"int x;\nint f(void) { return x }\n"}]}}`,
+			want: []string{`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///a.c","diagnostics":[{"range":{"start":{"line":1,"character":23},"end":{"line":1,"character":24}},"severity":1,"source":"ucls","message":"unexpected '}', expected one of '!=', '&&', '(', '*', '+', '-', '/', ';', '<', '<=', '=', '==', '>', '>=' or '['"}]}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///a.c","version":3},"contentChanges":[{"text":"int x;\nint f(void) { return x; }\n"}]}}`,
+			want: []string{`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///a.c","diagnostics":[]}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///a.c"}}}`,
+			want: []string{`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///a.c","diagnostics":[]}}`},
+		},
+		// Positions are measured in UTF-16 code units.
+		{
+			send: `{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///b.c","languageId":"c","version":1,"text":"/* 𝄞 */ int x; int f(void) { return x; }\n"}}}`,
+			want: []string{`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///b.c","diagnostics":[]}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","id":11,"method":"textDocument/definition","params":{"textDocument":{"uri":"file:///b.c"},"position":{"line":0,"character":37}}}`,
+			want: []string{`{"jsonrpc":"2.0","id":11,"result":{"uri":"file:///b.c","range":{"start":{"line":0,"character":13},"end":{"line":0,"character":14}}}}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","id":12,"method":"shutdown"}`,
+			want: []string{`{"jsonrpc":"2.0","id":12,"result":null}`},
+		},
+		{
+			send: `{"jsonrpc":"2.0","method":"exit"}`,
+		},
+	}
+	script := &bytes.Buffer{}
+	for _, g := range golden {
+		fmt.Fprintf(script, "Content-Length: %d\r\n\r\n%s", len(g.send), g.send)
+	}
+	out := &bytes.Buffer{}
+	s := lsp.NewServer(script, out)
+	if err := s.Serve(); err != nil {
+		t.Fatalf("unable to serve requests; %v", err)
+	}
+	r := bufio.NewReader(out)
+	for _, g := range golden {
+		for _, want := range g.want {
+			got, err := readMessage(r)
+			if err != nil {
+				t.Fatalf("unable to read message; %v", err)
+			}
+			if got != want {
+				t.Errorf("%s: message mismatch; expected %s, got %s", g.send, want, got)
+			}
+		}
+	}
+	if _, err := readMessage(r); err != io.EOF {
+		t.Errorf("expected end of output, got %v", err)
+	}
+}
+
+// readMessage reads the content of a message from r.
+func readMessage(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+	if err != nil {
+		return "", err
+	}
+	if _, err := r.ReadString('\n'); err != nil {
+		return "", err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
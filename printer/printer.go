@@ -0,0 +1,544 @@
+// Package printer implements pretty-printing of µC abstract syntax trees.
+//
+// The printer produces µC source code with canonical indentation, spacing and
+// brace style; e.g.
+//
+//    int x, buf[128];
+//
+//    int main(void) {
+//    	if (x < 10) {
+//    		x = x + 1;
+//    	} else
+//    		return 0;
+//    	return x;
+//    }
+//
+// Statements are indented using tabs, and binary operators are surrounded by
+// spaces. The left-brace of a block statement is placed on the same line as
+// the preceding function header, condition or else keyword.
+//
+// The comments of source files are preserved. Comments on the same line as a
+// preceding declaration or statement remain on that line, and other comments
+// are placed on lines of their own, before the declaration or statement which
+// follows them. Single blank lines between declarations and statements are
+// preserved.
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// Fprint pretty-prints the given node to w. The node is either a source file,
+// a declaration, a statement, an expression or a type. Line breaks of the
+// input source (which may be nil) are used to position comments and blank
+// lines.
+func Fprint(w io.Writer, src *semerrors.Source, node ast.Node) error {
+	p := &printer{src: src}
+	switch n := node.(type) {
+	case *ast.File:
+		p.file(n)
+	case ast.Decl:
+		p.decls([]ast.Decl{n})
+	case ast.Stmt:
+		p.stmt(n)
+	case ast.Expr:
+		p.expr(n)
+	case ast.Type:
+		p.typ(n)
+	default:
+		return errutil.Newf("support for node type %T not yet implemented", node)
+	}
+	if _, err := w.Write(p.buf.Bytes()); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// Source pretty-prints the given source file and returns the corresponding µC
+// source code, based on the line breaks of the input source (which may be
+// nil).
+func Source(src *semerrors.Source, file *ast.File) []byte {
+	p := &printer{src: src}
+	p.file(file)
+	return p.buf.Bytes()
+}
+
+// A printer pretty-prints abstract syntax trees.
+type printer struct {
+	// Output buffer.
+	buf bytes.Buffer
+	// Current indentation level.
+	indent int
+	// Input source; or nil if unknown.
+	src *semerrors.Source
+	// Comment groups of the source file.
+	comments []*ast.CommentGroup
+	// Index of the next comment group to print.
+	cur int
+	// Source position directly after the last printed node or comment.
+	last token.Pos
+}
+
+// print prints the given values to the output buffer.
+func (p *printer) print(a ...interface{}) {
+	for _, v := range a {
+		fmt.Fprint(&p.buf, v)
+	}
+}
+
+// newline terminates the current line, and indents the next line based on the
+// current indentation level.
+func (p *printer) newline() {
+	p.buf.WriteByte('\n')
+	for i := 0; i < p.indent; i++ {
+		p.buf.WriteByte('\t')
+	}
+}
+
+// A blankMode specifies whether a line break is preceded by a blank line.
+type blankMode uint8
+
+// Blank line modes.
+const (
+	// keepBlank specifies a blank line if the input source contains a blank
+	// line at the same location.
+	keepBlank blankMode = iota
+	// forceBlank specifies a blank line.
+	forceBlank
+	// noBlank specifies no blank line.
+	noBlank
+)
+
+// lineBreak prints the comments located before the given source position,
+// and starts a new line for the node at the given position. The blank line
+// mode applies to the first line break.
+func (p *printer) lineBreak(pos token.Pos, mode blankMode) {
+	last := p.last
+	p.flushComments(pos, &mode)
+	if p.last != last && p.src != nil && p.line(p.last) == p.line(pos) {
+		// Keep comments on the same line as the node which follows them; e.g.
+		//
+		//    /* comment */ int x;
+		p.print(" ")
+		return
+	}
+	p.lineBreakOnly(pos, mode)
+}
+
+// lineBreakOnly starts a new line for the node at the given position, without
+// printing comments. No line break is printed at the start of the output.
+func (p *printer) lineBreakOnly(pos token.Pos, mode blankMode) {
+	if p.buf.Len() == 0 {
+		return
+	}
+	if mode == forceBlank || (mode == keepBlank && p.line(pos)-p.line(p.last) > 1) {
+		// Blank line without trailing whitespace.
+		p.buf.WriteByte('\n')
+	}
+	p.newline()
+}
+
+// flushComments prints the comment groups located before the given source
+// position. Comment groups starting on the same line as the last printed node
+// are printed on that line. Other comment groups are printed on lines of their
+// own, the first of which uses the given blank line mode; in which case the
+// mode is reset to keepBlank.
+func (p *printer) flushComments(pos token.Pos, mode *blankMode) {
+	for ; p.cur < len(p.comments); p.cur++ {
+		group := p.comments[p.cur]
+		if group.Start() >= pos {
+			return
+		}
+		if p.buf.Len() > 0 && p.src != nil && p.line(group.Start()) == p.line(p.last) {
+			p.print(" ")
+		} else {
+			p.lineBreakOnly(group.Start(), *mode)
+			*mode = keepBlank
+		}
+		for i, c := range group.List {
+			if i > 0 {
+				if p.line(c.Start()) == p.line(group.List[i-1].End()) {
+					p.print(" ")
+				} else {
+					p.newline()
+				}
+			}
+			p.print(c.Text)
+		}
+		p.last = group.End()
+	}
+}
+
+// flushTrailingComments prints the comment groups located before the given
+// source position, which start on the same line as the last printed node.
+func (p *printer) flushTrailingComments(pos token.Pos) {
+	if p.cur < len(p.comments) && p.src != nil {
+		group := p.comments[p.cur]
+		if group.Start() < pos && p.line(group.Start()) == p.line(p.last) {
+			mode := keepBlank
+			p.flushComments(group.End(), &mode)
+		}
+	}
+}
+
+// line returns the line number of the given source position, or 0 if the input
+// source is unknown.
+func (p *printer) line(pos token.Pos) int {
+	if p.src == nil || len(p.src.Lines) == 0 {
+		return 0
+	}
+	line, _ := p.src.Position(pos)
+	return line
+}
+
+// --- [ File ] ----------------------------------------------------------------
+
+// file prints the given source file. Function definitions are separated from
+// surrounding declarations by blank lines.
+func (p *printer) file(file *ast.File) {
+	p.comments = file.Comments
+	groups := groupDecls(file.Decls)
+	for i, group := range groups {
+		mode := keepBlank
+		if i > 0 && (isFuncDef(group[0]) || isFuncDef(groups[i-1][0])) {
+			mode = forceBlank
+		}
+		p.lineBreak(group[0].Start(), mode)
+		p.decls(group)
+		p.last = group[len(group)-1].End()
+	}
+	// Print trailing comments.
+	mode := keepBlank
+	p.flushComments(token.Pos(int(^uint(0)>>1)), &mode)
+	if p.buf.Len() > 0 {
+		p.buf.WriteByte('\n')
+	}
+}
+
+// isFuncDef reports whether the given declaration is a function definition.
+func isFuncDef(decl ast.Decl) bool {
+	fn, ok := decl.(*ast.FuncDecl)
+	return ok && fn.Body != nil
+}
+
+// --- [ Declarations ] --------------------------------------------------------
+
+// groupDecls splits the given declarations into groups, where each group is
+// printed as a single declaration. Consecutive variable declarations are
+// grouped if they originate from the same declaration with multiple
+// declarators (e.g. `int a, b[10];`), in which case they share the source
+// position of their basic type.
+func groupDecls(decls []ast.Decl) [][]ast.Decl {
+	var groups [][]ast.Decl
+	for i, decl := range decls {
+		if i > 0 && sameDeclaration(decls[i-1], decl) {
+			last := len(groups) - 1
+			groups[last] = append(groups[last], decl)
+			continue
+		}
+		groups = append(groups, []ast.Decl{decl})
+	}
+	return groups
+}
+
+// sameDeclaration reports whether the given declarations originate from the
+// same declaration with multiple declarators.
+func sameDeclaration(a, b ast.Decl) bool {
+	x, ok := a.(*ast.VarDecl)
+	if !ok {
+		return false
+	}
+	y, ok := b.(*ast.VarDecl)
+	if !ok {
+		return false
+	}
+	if x.Storage != y.Storage || x.StoragePos != y.StoragePos || x.Val != nil {
+		return false
+	}
+	xt, yt := basicType(x.VarType), basicType(y.VarType)
+	return xt.Start() == yt.Start() && xt.String() == yt.String()
+}
+
+// basicType returns the basic type of the given variable type; i.e. the element
+// type of array types.
+func basicType(typ ast.Type) ast.Type {
+	if arr, ok := typ.(*ast.ArrayType); ok {
+		return arr.Elem
+	}
+	return typ
+}
+
+// decls prints the given group of declarations as a single declaration.
+func (p *printer) decls(group []ast.Decl) {
+	switch decl := group[0].(type) {
+	case *ast.FuncDecl:
+		p.funcDecl(decl)
+	case *ast.VarDecl:
+		p.storage(decl.Storage)
+		p.typ(basicType(decl.VarType))
+		for i, d := range group {
+			if i > 0 {
+				p.print(",")
+			}
+			v := d.(*ast.VarDecl)
+			p.print(" ")
+			p.declarator(v.VarName, v.VarType)
+			if v.Val != nil {
+				p.print(" = ")
+				p.expr(v.Val)
+			}
+		}
+		p.print(";")
+	case *ast.TypeDef:
+		p.print("typedef ")
+		p.typ(basicType(decl.DeclType))
+		p.print(" ")
+		p.declarator(decl.TypeName, decl.DeclType)
+		p.print(";")
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", decl))
+	}
+}
+
+// storage prints the given storage-class specifier, if present.
+func (p *printer) storage(storage ast.StorageClass) {
+	if storage != ast.NoStorage {
+		p.print(storage, " ")
+	}
+}
+
+// funcDecl prints the given function declaration or function definition.
+func (p *printer) funcDecl(fn *ast.FuncDecl) {
+	p.storage(fn.Storage)
+	p.typ(fn.FuncType.Result)
+	p.print(" ", fn.FuncName)
+	p.params(fn.FuncType.Params)
+	if fn.Body == nil {
+		p.print(";")
+		return
+	}
+	p.print(" ")
+	p.blockStmt(fn.Body)
+}
+
+// params prints the given parenthesised parameter list.
+func (p *printer) params(params []*ast.VarDecl) {
+	p.print("(")
+	for i, param := range params {
+		if i > 0 {
+			p.print(", ")
+		}
+		p.typ(basicType(param.VarType))
+		if param.VarName != nil {
+			p.print(" ")
+			p.declarator(param.VarName, param.VarType)
+		}
+	}
+	p.print(")")
+}
+
+// declarator prints the given declared identifier, followed by the array
+// length of array types.
+func (p *printer) declarator(name *ast.Ident, typ ast.Type) {
+	p.print(name)
+	if arr, ok := typ.(*ast.ArrayType); ok {
+		p.arrayLen(arr)
+	}
+}
+
+// arrayLen prints the bracketed length of the given array type.
+func (p *printer) arrayLen(arr *ast.ArrayType) {
+	if arr.Len > 0 {
+		p.print("[", arr.Len, "]")
+		return
+	}
+	p.print("[]")
+}
+
+// --- [ Statements ] ----------------------------------------------------------
+
+// stmt prints the given statement.
+func (p *printer) stmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		p.blockStmt(stmt)
+	case *ast.EmptyStmt:
+		p.print(";")
+	case *ast.ExprStmt:
+		p.expr(stmt.X)
+		p.print(";")
+	case *ast.IfStmt:
+		p.print("if (")
+		p.expr(stmt.Cond)
+		p.print(")")
+		p.last = stmt.Cond.End()
+		p.body(stmt.Body)
+		if stmt.Else == nil {
+			return
+		}
+		if _, ok := stmt.Body.(*ast.BlockStmt); ok {
+			p.print(" ")
+		} else {
+			p.flushTrailingComments(stmt.Else.Start())
+			p.newline()
+		}
+		p.print("else")
+		if elseIf, ok := stmt.Else.(*ast.IfStmt); ok {
+			// Keep else-if chains at the same indentation level.
+			p.print(" ")
+			p.stmt(elseIf)
+			return
+		}
+		p.body(stmt.Else)
+	case *ast.ReturnStmt:
+		p.print("return")
+		if stmt.Result != nil {
+			p.print(" ")
+			p.expr(stmt.Result)
+		}
+		p.print(";")
+	case *ast.WhileStmt:
+		p.print("while (")
+		p.expr(stmt.Cond)
+		p.print(")")
+		p.last = stmt.Cond.End()
+		p.body(stmt.Body)
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", stmt))
+	}
+}
+
+// body prints the body of an if or while statement. Block statements are
+// placed on the same line, and other statements are placed on a separate
+// indented line.
+func (p *printer) body(body ast.Stmt) {
+	if block, ok := body.(*ast.BlockStmt); ok {
+		p.print(" ")
+		p.blockStmt(block)
+		return
+	}
+	p.indent++
+	p.lineBreak(body.Start(), noBlank)
+	p.stmt(body)
+	p.last = body.End()
+	p.indent--
+}
+
+// blockStmt prints the given block statement, with one block item per line.
+func (p *printer) blockStmt(block *ast.BlockStmt) {
+	if len(block.Items) == 0 && !p.hasComments(block.Rbrace) {
+		p.print("{}")
+		return
+	}
+	p.print("{")
+	p.last = block.Lbrace + 1
+	p.indent++
+	// The first line of the block is not preceded by a blank line.
+	mode := noBlank
+	var decls []ast.Decl
+	flush := func() {
+		for _, group := range groupDecls(decls) {
+			p.lineBreak(group[0].Start(), mode)
+			mode = keepBlank
+			p.decls(group)
+			p.last = group[len(group)-1].End()
+		}
+		decls = nil
+	}
+	for _, item := range block.Items {
+		switch item := item.(type) {
+		case ast.Decl:
+			decls = append(decls, item)
+		case ast.Stmt:
+			flush()
+			p.lineBreak(item.Start(), mode)
+			mode = keepBlank
+			p.stmt(item)
+			p.last = item.End()
+		default:
+			panic(fmt.Sprintf("support for %T not yet implemented", item))
+		}
+	}
+	flush()
+	p.flushComments(block.Rbrace, &mode)
+	p.indent--
+	p.newline()
+	p.print("}")
+	p.last = block.End()
+}
+
+// hasComments reports whether there are comments left to print before the
+// given source position.
+func (p *printer) hasComments(pos token.Pos) bool {
+	return p.cur < len(p.comments) && p.comments[p.cur].Start() < pos
+}
+
+// --- [ Expressions ] ---------------------------------------------------------
+
+// expr prints the given expression.
+func (p *printer) expr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		p.print(expr.Val)
+	case *ast.BinaryExpr:
+		p.expr(expr.X)
+		p.print(" ", expr.Op, " ")
+		p.expr(expr.Y)
+	case *ast.CallExpr:
+		p.print(expr.Name, "(")
+		for i, arg := range expr.Args {
+			if i > 0 {
+				p.print(", ")
+			}
+			p.expr(arg)
+		}
+		p.print(")")
+	case *ast.Ident:
+		p.print(expr)
+	case *ast.IndexExpr:
+		p.print(expr.Name, "[")
+		p.expr(expr.Index)
+		p.print("]")
+	case *ast.ParenExpr:
+		p.print("(")
+		p.expr(expr.X)
+		p.print(")")
+	case *ast.UnaryExpr:
+		p.print(expr.Op)
+		if x, ok := expr.X.(*ast.UnaryExpr); ok && x.Op == token.Sub && expr.Op == token.Sub {
+			// Separate consecutive minus signs for readability; e.g. `- -x`.
+			p.print(" ")
+		}
+		p.expr(expr.X)
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", expr))
+	}
+}
+
+// --- [ Types ] ---------------------------------------------------------------
+
+// typ prints the given type.
+func (p *printer) typ(typ ast.Type) {
+	switch typ := typ.(type) {
+	case *ast.ArrayType:
+		p.typ(typ.Elem)
+		p.arrayLen(typ)
+	case *ast.FuncType:
+		p.typ(typ.Result)
+		p.params(typ.Params)
+	case *ast.Ident:
+		p.print(typ)
+	case *ast.QualType:
+		p.print("const ")
+		p.typ(typ.Type)
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", typ))
+	}
+}
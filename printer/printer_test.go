@@ -0,0 +1,135 @@
+package printer_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"testing"
+
+	"github.com/kr/pretty"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/printer"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func TestFprint(t *testing.T) {
+	var golden = []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/extra/printer/format.c",
+			want: `// Irregular indentation, spacing and brace style.
+typedef int myint;
+extern int a, b[10], c[];
+static char buf[128]; // buffer
+int putchar(int c);
+
+// add returns the sum of x and y.
+int add(int x, int y) {
+	return x + y;
+}
+
+static int f(const int n, char s[]) {
+	int i;
+	int j, k[3];
+
+	i = 0;
+	while (i < n) {
+		if (s[i] == 'a') {
+			j = - -n;
+		} else if (!(s[i] == 0) && i >= 2)
+			k[0] = add(i, j); // k[0] is updated.
+		else
+			return -1;
+		i = i + 1;
+		/* Trailing block comment. */
+	}
+	while (i)
+		;
+	if (i)
+		if (j)
+			i = 1;
+		else
+			i = 2;
+	{}
+	{ /* Empty block. */
+	}
+	return;
+}
+
+int main(void) {
+	f(3, buf);
+}
+// End of file.
+`,
+		},
+	}
+
+	for _, g := range golden {
+		file, src, err := parseFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		buf := new(bytes.Buffer)
+		if err := printer.Fprint(buf, src, file); err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		got := buf.String()
+		if got != g.want {
+			t.Errorf("%q: output mismatch; expected `%v`, got `%v`", g.path, g.want, got)
+		}
+	}
+}
+
+// TestRoundTrip verifies that parsing the pretty-printed source code of each
+// syntactically correct test case produces an abstract syntax tree identical
+// to the original, apart from source positions, and that pretty-printing is
+// idempotent.
+func TestRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob("../testdata/*/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("unable to locate test cases")
+	}
+	for _, path := range paths {
+		want, src, err := parseFile(path)
+		if err != nil {
+			// Skip test cases containing syntax errors.
+			continue
+		}
+		buf := printer.Source(src, want)
+		out := semerrors.NewSource(path, string(buf))
+		got, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), out)
+		if err != nil {
+			t.Errorf("%q: unable to parse pretty-printed source; %v\n%s", path, err, buf)
+			continue
+		}
+		if again := printer.Source(out, got); !bytes.Equal(again, buf) {
+			t.Errorf("%q: pretty-printing not idempotent; expected `%s`, got `%s`", path, buf, again)
+		}
+		if !astutil.Equal(want, got, astutil.IgnorePos) {
+			t.Errorf("%q: AST mismatch after round-trip", path)
+			log.Println(pretty.Diff(want, got))
+		}
+	}
+}
+
+// parseFile parses the given µC source file.
+func parseFile(path string) (*ast.File, *semerrors.Source, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	src := semerrors.NewSource(path, string(buf))
+	file, err := parser.NewParser().ParseFile(scanner.NewFromBytes(buf), src)
+	return file, src, err
+}
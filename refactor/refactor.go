@@ -0,0 +1,167 @@
+// Package refactor implements source code refactorings of resolved parse trees.
+package refactor
+
+import (
+	"sort"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/token"
+)
+
+// IdentAt returns the identifier at the given position of the parse tree; or
+// nil if not found. The end position of an identifier is considered part of
+// the identifier, to locate identifiers directly preceding the cursor.
+func IdentAt(file *ast.File, pos token.Pos) *ast.Ident {
+	var ident *ast.Ident
+	find := func(n ast.Node) (bool, error) {
+		if n, ok := n.(*ast.Ident); ok && n.Start() <= pos && pos <= n.End() {
+			ident = n
+			return false, astutil.Stop
+		}
+		return true, nil
+	}
+	astutil.Inspect(file, find)
+	return ident
+}
+
+// Refs returns the identifiers of the parse tree referring to the entity
+// declared by the given declaration, in source order, including the declared
+// identifiers of all declarations of the entity.
+//
+// The declarations of an entity are the declarations referred to by the
+// identifiers of one another (e.g. a function prototype and the subsequent
+// function definition), and the declarations of the identifier with linkage
+// (e.g. a global variable and block scope extern declarations of the
+// variable).
+func Refs(file *ast.File, info *sem.Info, decl ast.Decl) []*ast.Ident {
+	x := newIndex(file, info)
+	return x.refs(x.entity(decl))
+}
+
+// An index maps the identifiers and declarations of a resolved parse tree to
+// their enclosing scopes.
+type index struct {
+	// Identifiers of the parse tree, in source order.
+	idents []*ast.Ident
+	// Map from identifiers to their innermost enclosing scope.
+	identScopes map[*ast.Ident]*sem.Scope
+	// Map from declarations to the scope in which they are declared.
+	declScopes map[ast.Decl]*sem.Scope
+	// Map from declared identifiers to their declarations.
+	names map[*ast.Ident]ast.Decl
+	// Union-find forest of declarations of the same entity.
+	parents map[ast.Decl]ast.Decl
+}
+
+// newIndex returns a new index of the given resolved parse tree.
+func newIndex(file *ast.File, info *sem.Info) *index {
+	x := &index{
+		identScopes: make(map[*ast.Ident]*sem.Scope),
+		declScopes:  make(map[ast.Decl]*sem.Scope),
+		names:       make(map[*ast.Ident]ast.Decl),
+		parents:     make(map[ast.Decl]ast.Decl),
+	}
+	scopes := info.EnclosingScopes(file)
+	collect := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case ast.Decl:
+			x.declScopes[n] = scopes[n]
+			if name := n.Name(); name != nil {
+				x.names[name] = n
+			}
+		case *ast.Ident:
+			x.idents = append(x.idents, n)
+			x.identScopes[n] = scopes[n]
+		}
+		return true, nil
+	}
+	astutil.Inspect(file, collect)
+	sort.SliceStable(x.idents, func(i, j int) bool {
+		return x.idents[i].Start() < x.idents[j].Start()
+	})
+
+	// Join the declarations of the same entity.
+	for _, ident := range x.idents {
+		if decl, ok := x.names[ident]; ok && ident.Decl != nil {
+			x.union(decl, ident.Decl)
+		}
+	}
+	linked := make(map[string]ast.Decl)
+	for decl, scope := range x.declScopes {
+		if x.linkage(decl, scope) == sem.NoLinkage {
+			continue
+		}
+		name := decl.Name().Name
+		if prev, ok := linked[name]; ok {
+			x.union(prev, decl)
+		} else {
+			linked[name] = decl
+		}
+	}
+	return x
+}
+
+// find returns the representative declaration of the entity of the given
+// declaration.
+func (x *index) find(decl ast.Decl) ast.Decl {
+	for {
+		parent, ok := x.parents[decl]
+		if !ok {
+			return decl
+		}
+		decl = parent
+	}
+}
+
+// union joins the entities of the given declarations.
+func (x *index) union(a, b ast.Decl) {
+	a, b = x.find(a), x.find(b)
+	if a != b {
+		x.parents[b] = a
+	}
+}
+
+// entity returns the set of declarations of the entity of the given
+// declaration.
+func (x *index) entity(decl ast.Decl) map[ast.Decl]bool {
+	root := x.find(decl)
+	decls := map[ast.Decl]bool{decl: true}
+	for d := range x.declScopes {
+		if x.find(d) == root {
+			decls[d] = true
+		}
+	}
+	return decls
+}
+
+// refs returns the identifiers referring to the given entity, in source order.
+func (x *index) refs(entity map[ast.Decl]bool) []*ast.Ident {
+	var refs []*ast.Ident
+	for _, ident := range x.idents {
+		if entity[ident.Decl] || entity[x.names[ident]] {
+			refs = append(refs, ident)
+		}
+	}
+	return refs
+}
+
+// linkage returns the linkage of the given declaration, declared in the given
+// scope.
+func (x *index) linkage(decl ast.Decl, scope *sem.Scope) sem.Linkage {
+	name := decl.Name()
+	if name == nil {
+		// Anonymous function parameter declaration.
+		return sem.NoLinkage
+	}
+	return scope.Linkages[name.Name]
+}
+
+// visible reports whether the given declaration of the given scope is visible
+// at the position of the identifier. File scope declarations are visible
+// throughout the file, as they are added to the file scope before resolving
+// identifiers.
+func visible(decl ast.Decl, scope *sem.Scope, ident *ast.Ident) bool {
+	return scope.IsFile || decl.Name().Start() < ident.Start()
+}
@@ -0,0 +1,187 @@
+package refactor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/refactor"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+func TestRename(t *testing.T) {
+	golden := []struct {
+		input string
+		// Position of identifier to rename, as the first occurrence of "@"
+		// within the input, which is removed before parsing.
+		to   string
+		want string
+		err  string
+	}{
+		// Local variables.
+		{
+			input: "int x; int f(int a) { int x; x = a; return @x; } int g(void) { return x; }",
+			to:    "y",
+			want:  "int x; int f(int a) { int y; y = a; return y; } int g(void) { return x; }",
+		},
+		// Global variables, including references of nested scopes.
+		{
+			input: "int @x; int f(int a) { int y; y = a + x; { y = x; } return y; } int g(void) { return x; }",
+			to:    "z",
+			want:  "int z; int f(int a) { int y; y = a + z; { y = z; } return y; } int g(void) { return z; }",
+		},
+		// Function prototypes and definitions.
+		{
+			input: "int f(int a); int g(void) { return f(1); } int @f(int a) { return a; }",
+			to:    "h",
+			want:  "int h(int a); int g(void) { return h(1); } int h(int a) { return a; }",
+		},
+		// Block scope extern declarations.
+		{
+			input: "int @x; int f(void) { extern int x; return x; }",
+			to:    "y",
+			want:  "int y; int f(void) { extern int y; return y; }",
+		},
+		// Type definitions.
+		{
+			input: "typedef int @foo; foo x;",
+			to:    "bar",
+			want:  "typedef int bar; bar x;",
+		},
+		// Unchanged name.
+		{
+			input: "int @x;",
+			to:    "x",
+			want:  "int x;",
+		},
+		// Declarations of later statements do not capture references.
+		{
+			input: "int @x; int f(void) { x = 1; { x = 2; } int y; return y; }",
+			to:    "y",
+			want:  "int y; int f(void) { y = 1; { y = 2; } int y; return y; }",
+		},
+		{
+			input: "int @x; int f(void) { { x = 2; int y; } return 0; }",
+			to:    "y",
+			want:  "int y; int f(void) { { y = 2; int y; } return 0; }",
+		},
+		// Conflicts.
+		{
+			input: "int @x; int y;",
+			to:    "y",
+			err:   `cannot rename "x" to "y"; conflicts with declaration in the same scope`,
+		},
+		{
+			input: "int f(void) { extern int @x; return x; } int g(void) { extern int y; return y; }",
+			to:    "y",
+			err:   `cannot rename "x" to "y"; conflicts with declaration having linkage`,
+		},
+		{
+			input: "int x; int f(int a) { return @a + x; }",
+			to:    "x",
+			err:   `cannot rename "a" to "x"; would capture reference to declaration in outer scope`,
+		},
+		{
+			input: "int f(int @a) { return f(a); }",
+			to:    "f",
+			err:   `cannot rename "a" to "f"; would capture reference to declaration in outer scope`,
+		},
+		{
+			input: "int @x; int f(int a) { return a + x; }",
+			to:    "a",
+			err:   `cannot rename "x" to "a"; reference would be captured by declaration in nested scope`,
+		},
+		{
+			input: "int f(int @a) { int b; return a; }",
+			to:    "int",
+			err:   `cannot rename "a" to "int"; would capture reference to declaration in outer scope`,
+		},
+		// Invalid renames.
+		{
+			input: "int @x;",
+			to:    "while",
+			err:   `cannot rename "x" to "while"; invalid identifier`,
+		},
+		{
+			input: "int @x;",
+			to:    "1x",
+			err:   `cannot rename "x" to "1x"; invalid identifier`,
+		},
+		{
+			input: "@int x;",
+			to:    "y",
+			err:   `cannot rename "int"; not declared within the file`,
+		},
+		{
+			input: "int x;@",
+			to:    "y",
+			err:   `no identifier found at position`,
+		},
+	}
+	for _, g := range golden {
+		offset := strings.Index(g.input, "@")
+		input := strings.Replace(g.input, "@", "", 1)
+		src := semerrors.NewSource("input.c", input)
+		file, err := parser.ParseString(input, src)
+		if err != nil {
+			t.Errorf("%q: unable to parse input; %v", input, err)
+			continue
+		}
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: unable to check input; %v", input, err)
+			continue
+		}
+		res, err := refactor.Rename(src, file, info, token.Pos(offset), g.to)
+		if err != nil {
+			errs, ok := err.(semerrors.List)
+			if !ok || len(errs) != 1 {
+				t.Errorf("%q: unexpected error type %T; %v", input, err, err)
+				continue
+			}
+			if got := errs[0].Text; got != g.err {
+				t.Errorf("%q: error mismatch; expected %q, got %q", input, g.err, got)
+			}
+			continue
+		}
+		if len(g.err) > 0 {
+			t.Errorf("%q: expected error %q, got nil", input, g.err)
+			continue
+		}
+		if got := string(res); got != g.want {
+			t.Errorf("%q: output mismatch; expected %q, got %q", input, g.want, got)
+		}
+	}
+}
+
+func TestRefs(t *testing.T) {
+	const input = "int f(int a); int g(int f) { return f; } int f(int b) { return f(b); }"
+	file, err := parser.ParseString(input, nil)
+	if err != nil {
+		t.Fatalf("unable to parse input; %v", err)
+	}
+	info, err := sem.Check(file)
+	if err != nil {
+		t.Fatalf("unable to check input; %v", err)
+	}
+	ident := refactor.IdentAt(file, token.Pos(strings.LastIndex(input, "f")))
+	if ident == nil || ident.Name != "f" {
+		t.Fatalf("identifier mismatch; expected f, got %v", ident)
+	}
+	var got []int
+	for _, ref := range refactor.Refs(file, info, ident.Decl) {
+		got = append(got, int(ref.Start()))
+	}
+	want := []int{4, 45, 63}
+	if len(got) != len(want) {
+		t.Fatalf("references mismatch; expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("references mismatch; expected %v, got %v", want, got)
+			break
+		}
+	}
+}
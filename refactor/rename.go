@@ -0,0 +1,153 @@
+package refactor
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// Rename renames the entity declared by the identifier at the given position of
+// the resolved parse tree of the given source, and returns the rewritten
+// source. Only the identifiers referring to the entity are rewritten, leaving
+// unrelated text unchanged.
+//
+// The rename is refused if the new name is not a valid identifier, or if it
+// would change the meaning of the program; i.e. if the renamed entity would
+// conflict with a declaration of the same scope, or with an entity of the same
+// name having linkage, if a reference to the renamed entity would be captured
+// by a declaration of a nested scope, or if the renamed entity would capture a
+// reference to another entity. Refusals are reported as an errors.List.
+func Rename(src *errors.Source, file *ast.File, info *sem.Info, pos token.Pos, name string) ([]byte, error) {
+	ident := IdentAt(file, pos)
+	if ident == nil {
+		return nil, errors.List{errors.New(pos, "no identifier found at position")}
+	}
+	if !isIdent(name) {
+		return nil, errors.List{errors.Newf(ident.Start(), "cannot rename %q to %q; invalid identifier", ident, name).Range(ident.Start(), ident.End())}
+	}
+	x := newIndex(file, info)
+	if _, ok := x.declScopes[ident.Decl]; ident.Decl == nil || !ok {
+		return nil, errors.List{errors.Newf(ident.Start(), "cannot rename %q; not declared within the file", ident).Range(ident.Start(), ident.End())}
+	}
+	entity := x.entity(ident.Decl)
+	refs := x.refs(entity)
+	if err := x.checkRename(ident, entity, refs, name); err != nil {
+		return nil, errors.List{err}
+	}
+
+	// Rewrite the references to the entity.
+	buf := &bytes.Buffer{}
+	prev := 0
+	for _, ref := range refs {
+		start := src.File.Offset(ref.Start())
+		buf.WriteString(src.Input[prev:start])
+		buf.WriteString(name)
+		prev = src.File.Offset(ref.End())
+	}
+	buf.WriteString(src.Input[prev:])
+	return buf.Bytes(), nil
+}
+
+// checkRename verifies that the given entity, referred to by the identifiers
+// refs, may be renamed to name without changing the meaning of the program.
+// The returned error is reported at the identifier being renamed.
+func (x *index) checkRename(ident *ast.Ident, entity map[ast.Decl]bool, refs []*ast.Ident, name string) *errors.Diagnostic {
+	old := ident.Name
+	if name == old {
+		return nil
+	}
+	// conflict returns an error of the given conflicting declaration.
+	conflict := func(decl ast.Decl, format string) *errors.Diagnostic {
+		err := errors.Newf(ident.Start(), "cannot rename %q to %q; %s", old, name, format).Range(ident.Start(), ident.End())
+		if pos := decl.Name().Start(); pos.IsValid() {
+			err.Notef(pos, "declaration of %q", name)
+		}
+		return err
+	}
+
+	// Sort the declarations of the entity, to report conflicts in a
+	// deterministic order.
+	var decls []ast.Decl
+	for decl := range entity {
+		if _, ok := x.declScopes[decl]; ok {
+			decls = append(decls, decl)
+		}
+	}
+	sort.Slice(decls, func(i, j int) bool {
+		return decls[i].Name().Start() < decls[j].Name().Start()
+	})
+
+	// Redeclaration within the same scope, or in any scope for entities with
+	// linkage.
+	for _, decl := range decls {
+		scope := x.declScopes[decl]
+		if other, ok := scope.Decls[name]; ok && !entity[other] {
+			return conflict(other, "conflicts with declaration in the same scope")
+		}
+		if x.linkage(decl, scope) == sem.NoLinkage {
+			continue
+		}
+		for other, otherScope := range x.declScopes {
+			if other.Name() != nil && other.Name().Name == name && x.linkage(other, otherScope) != sem.NoLinkage {
+				return conflict(other, "conflicts with declaration having linkage")
+			}
+		}
+	}
+
+	// References to the entity captured by declarations of nested scopes.
+	isName := func(ident *ast.Ident) bool {
+		_, ok := x.names[ident]
+		return ok
+	}
+	for _, ref := range refs {
+		if isName(ref) {
+			continue
+		}
+		for scope := x.identScopes[ref]; scope != nil; scope = scope.Outer {
+			if decl, ok := scope.Decls[old]; ok && entity[decl] {
+				break
+			}
+			if other, ok := scope.Decls[name]; ok && visible(other, scope, ref) {
+				return conflict(other, "reference would be captured by declaration in nested scope")
+			}
+		}
+	}
+
+	// References to other entities captured by the renamed entity.
+	for _, ref := range x.idents {
+		if ref.Name != name || isName(ref) {
+			continue
+		}
+		for scope := x.identScopes[ref]; scope != nil; scope = scope.Outer {
+			if other, ok := scope.Decls[name]; ok && visible(other, scope, ref) {
+				break
+			}
+			if decl, ok := scope.Decls[old]; ok && entity[decl] && visible(decl, scope, ref) {
+				return conflict(ref.Decl, "would capture reference to declaration in outer scope")
+			}
+		}
+	}
+	return nil
+}
+
+// isIdent reports whether the given name is a valid identifier, which is not a
+// keyword.
+func isIdent(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	_, ok := token.Keywords[name]
+	return !ok
+}
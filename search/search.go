@@ -0,0 +1,327 @@
+// Package search implements structural search of resolved parse trees, using
+// patterns of µC expressions and statements with metavariables.
+//
+// A metavariable is an identifier prefixed by a dollar sign (e.g. $x), which
+// matches any expression. Each occurrence of a named metavariable within a
+// pattern must match structurally equal expressions, except for the wildcard
+// metavariable $_ which matches independently. A metavariable may be
+// constrained by the type of the matched expression, as recorded by semantic
+// analysis, by appending the type after a colon (e.g. $x:int or $buf:char[]).
+// Metavariables in statement position (e.g. $s;) match any statement.
+//
+//    putint($x / $y)           calls to putint with a division argument
+//    $x = $x                   self-assignments
+//    $f($a:double)             calls with a single double argument
+//    while ($c) $s;            while statements
+//
+// Other identifiers of patterns match identifiers of the same name.
+// Parentheses are ignored, and if statements without an else branch match if
+// statements regardless of their else branch.
+package search
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/types"
+)
+
+// A Pattern is a compiled search pattern.
+type Pattern struct {
+	// Expression or statement of the pattern.
+	node ast.Node
+	// Map from placeholder identifier names to metavariables.
+	metas map[string]*meta
+}
+
+// A meta is a metavariable of a pattern.
+type meta struct {
+	// Metavariable name, without the dollar sign; "_" for wildcards.
+	name string
+	// Type constraint; or empty if unconstrained.
+	typ string
+}
+
+// A Match is a node matching a pattern.
+type Match struct {
+	// Matching expression or statement.
+	Node ast.Node
+	// Map from metavariable names to the matched nodes, excluding wildcards.
+	Binds map[string]ast.Node
+}
+
+// metaPrefix is the prefix of placeholder identifiers, which replace the
+// metavariables of patterns before parsing.
+const metaPrefix = "__meta_"
+
+// metaRegexp matches metavariables and their optional type constraints.
+var metaRegexp = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)(:(const )?[a-z]+(\[[0-9]*\])?)?`)
+
+// Compile parses the given pattern of a µC expression or statement. Patterns
+// ending with a semicolon or a right brace are statements, and other patterns
+// are expressions.
+func Compile(pattern string) (*Pattern, error) {
+	p := &Pattern{metas: make(map[string]*meta)}
+	// Map from metavariable names to type constraints.
+	constraints := make(map[string]string)
+	var err error
+	nwildcards := 0
+	src := metaRegexp.ReplaceAllStringFunc(pattern, func(s string) string {
+		m := metaRegexp.FindStringSubmatch(s)
+		name, typ := m[1], strings.TrimPrefix(m[2], ":")
+		if name == "_" {
+			// Each wildcard is distinct, and constrained independently.
+			placeholder := fmt.Sprintf("%s_%d", metaPrefix, nwildcards)
+			nwildcards++
+			p.metas[placeholder] = &meta{name: name, typ: typ}
+			return placeholder
+		}
+		if prev, ok := constraints[name]; ok && len(typ) > 0 && len(prev) > 0 && prev != typ {
+			err = errutil.Newf("conflicting type constraints %q and %q of metavariable $%s", prev, typ, name)
+		}
+		if len(typ) > 0 {
+			constraints[name] = typ
+		}
+		placeholder := metaPrefix + name
+		p.metas[placeholder] = &meta{name: name}
+		return placeholder
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range p.metas {
+		if m.name != "_" {
+			m.typ = constraints[m.name]
+		}
+	}
+	stmt := strings.HasSuffix(strings.TrimSpace(src), ";") || strings.HasSuffix(strings.TrimSpace(src), "}")
+	body := src
+	if !stmt {
+		body = src + ";"
+	}
+	input := fmt.Sprintf("void %spattern(void) {\n%s\n}\n", metaPrefix, body)
+	file, err := parser.NewParser().ParseFile(scanner.NewFromString(input), nil)
+	if err != nil {
+		return nil, errutil.Newf("invalid pattern %q; %v", pattern, err)
+	}
+	if len(file.Decls) != 1 {
+		return nil, errutil.Newf("invalid pattern %q; expected a single expression or statement", pattern)
+	}
+	fn, ok := file.Decls[0].(*ast.FuncDecl)
+	if !ok || fn.Body == nil || len(fn.Body.Items) != 1 {
+		return nil, errutil.Newf("invalid pattern %q; expected a single expression or statement", pattern)
+	}
+	item := fn.Body.Items[0]
+	if !stmt {
+		exprStmt, ok := item.(*ast.ExprStmt)
+		if !ok {
+			return nil, errutil.Newf("invalid pattern %q; expected expression", pattern)
+		}
+		p.node = exprStmt.X
+		return p, nil
+	}
+	if _, ok := item.(ast.Stmt); !ok {
+		return nil, errutil.Newf("invalid pattern %q; expected statement", pattern)
+	}
+	p.node = item
+	return p, nil
+}
+
+// Find returns the expressions and statements of the given resolved parse tree
+// matching the pattern, in source order. The type constraints of metavariables are checked against
+// the types of the given semantic information; expressions without type
+// information never match constrained metavariables.
+func (p *Pattern) Find(file *ast.File, info *sem.Info) []*Match {
+	var matches []*Match
+	var find func(n ast.Node) (bool, error)
+	find = func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.ParenExpr:
+			// Parentheses are ignored; match the enclosed expression instead.
+			return true, nil
+		case ast.Decl:
+			// Only match nodes in expression or statement position; i.e. skip the
+			// declared identifiers and type specifiers of declarations.
+			if _, ok := n.(*ast.TypeDef); !ok {
+				if val := n.Value(); val != nil {
+					astutil.Inspect(val, find)
+				}
+			}
+			return false, nil
+		}
+		m := &matcher{p: p, info: info, binds: make(map[string]ast.Node)}
+		if m.match(p.node, n) {
+			matches = append(matches, &Match{Node: n, Binds: m.binds})
+		}
+		return true, nil
+	}
+	astutil.Inspect(file, find)
+	return matches
+}
+
+// A matcher keeps track of the state of matching a pattern against a node.
+type matcher struct {
+	// Pattern being matched.
+	p *Pattern
+	// Semantic information of the parse tree.
+	info *sem.Info
+	// Map from metavariable names to matched nodes.
+	binds map[string]ast.Node
+}
+
+// match reports whether the given node matches the given node of the pattern.
+func (m *matcher) match(pat, n ast.Node) bool {
+	if pat, ok := pat.(ast.Expr); ok {
+		pat = unparen(pat)
+		if n, ok := n.(ast.Expr); ok {
+			return m.matchExpr(pat, unparen(n))
+		}
+		return false
+	}
+	// Metavariables in statement position.
+	if pat, ok := pat.(*ast.ExprStmt); ok {
+		if v, ok := m.metaOf(pat.X); ok {
+			if _, ok := n.(ast.Stmt); ok {
+				return m.bind(v, n)
+			}
+			return false
+		}
+	}
+	switch pat := pat.(type) {
+	case *ast.BlockStmt:
+		n, ok := n.(*ast.BlockStmt)
+		if !ok || len(pat.Items) != len(n.Items) {
+			return false
+		}
+		for i := range pat.Items {
+			if !m.match(pat.Items[i], n.Items[i]) {
+				return false
+			}
+		}
+		return true
+	case *ast.EmptyStmt:
+		_, ok := n.(*ast.EmptyStmt)
+		return ok
+	case *ast.ExprStmt:
+		n, ok := n.(*ast.ExprStmt)
+		return ok && m.match(pat.X, n.X)
+	case *ast.IfStmt:
+		n, ok := n.(*ast.IfStmt)
+		if !ok || !m.match(pat.Cond, n.Cond) || !m.match(pat.Body, n.Body) {
+			return false
+		}
+		if pat.Else == nil {
+			return true
+		}
+		return n.Else != nil && m.match(pat.Else, n.Else)
+	case *ast.ReturnStmt:
+		n, ok := n.(*ast.ReturnStmt)
+		if !ok {
+			return false
+		}
+		if pat.Result == nil || n.Result == nil {
+			return pat.Result == nil && n.Result == nil
+		}
+		return m.match(pat.Result, n.Result)
+	case *ast.WhileStmt:
+		n, ok := n.(*ast.WhileStmt)
+		return ok && m.match(pat.Cond, n.Cond) && m.match(pat.Body, n.Body)
+	case *ast.VarDecl:
+		// Variable declarations of patterns match variable declarations with the
+		// same source representation.
+		n, ok := n.(*ast.VarDecl)
+		return ok && pat.String() == n.String()
+	case *ast.FuncDecl, *ast.TypeDef:
+		// Function declarations and type definitions are not supported within
+		// patterns.
+		return false
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", pat))
+	}
+}
+
+// matchExpr reports whether the given expression matches the given expression
+// of the pattern, both without enclosing parentheses.
+func (m *matcher) matchExpr(pat, n ast.Expr) bool {
+	if v, ok := m.metaOf(pat); ok {
+		if len(v.typ) > 0 {
+			t, ok := m.info.Types[n]
+			if !ok || (t.String() != v.typ && types.Unqualified(t).String() != v.typ) {
+				return false
+			}
+		}
+		return m.bind(v, n)
+	}
+	switch pat := pat.(type) {
+	case *ast.BasicLit:
+		n, ok := n.(*ast.BasicLit)
+		return ok && pat.Kind == n.Kind && pat.Val == n.Val
+	case *ast.BinaryExpr:
+		n, ok := n.(*ast.BinaryExpr)
+		return ok && pat.Op == n.Op && m.match(pat.X, n.X) && m.match(pat.Y, n.Y)
+	case *ast.CallExpr:
+		n, ok := n.(*ast.CallExpr)
+		if !ok || len(pat.Args) != len(n.Args) || !m.matchExpr(pat.Name, n.Name) {
+			return false
+		}
+		for i := range pat.Args {
+			if !m.match(pat.Args[i], n.Args[i]) {
+				return false
+			}
+		}
+		return true
+	case *ast.Ident:
+		n, ok := n.(*ast.Ident)
+		return ok && pat.Name == n.Name
+	case *ast.IndexExpr:
+		n, ok := n.(*ast.IndexExpr)
+		return ok && m.matchExpr(pat.Name, n.Name) && m.match(pat.Index, n.Index)
+	case *ast.UnaryExpr:
+		n, ok := n.(*ast.UnaryExpr)
+		return ok && pat.Op == n.Op && m.match(pat.X, n.X)
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", pat))
+	}
+}
+
+// metaOf returns the metavariable of the given pattern node; or false if not a
+// metavariable.
+func (m *matcher) metaOf(pat ast.Node) (*meta, bool) {
+	ident, ok := pat.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	v, ok := m.p.metas[ident.Name]
+	return v, ok
+}
+
+// bind binds the given metavariable to the given node, and reports whether the
+// node is structurally equal to any previous binding of the metavariable.
+func (m *matcher) bind(v *meta, n ast.Node) bool {
+	if v.name == "_" {
+		return true
+	}
+	if prev, ok := m.binds[v.name]; ok {
+		return astutil.Equal(prev, n, astutil.IgnorePos)
+	}
+	m.binds[v.name] = n
+	return true
+}
+
+// unparen returns the given expression without enclosing parentheses.
+func unparen(x ast.Expr) ast.Expr {
+	for {
+		paren, ok := x.(*ast.ParenExpr)
+		if !ok {
+			return x
+		}
+		x = paren.X
+	}
+}
@@ -0,0 +1,105 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/search"
+	"github.com/mewmew/uc/sem"
+)
+
+const input = `int g;
+int putint(int x);
+int f(int a, int b) {
+	double d;
+	putint(a / b);
+	putint((a + 1) / 2);
+	putint(a);
+	g = 1;
+	while (a < b) {
+		g = a;
+		a = a;
+	}
+	if (a) return 1; else return 2;
+	d = d / 2.0;
+	return 0;
+}
+`
+
+func TestFind(t *testing.T) {
+	golden := []struct {
+		pattern string
+		// Matched nodes, and the bindings of metavariable $x (if any).
+		want []string
+		x    []string
+	}{
+		{pattern: "putint($x / $y)", want: []string{"putint(a / b)", "putint((a + 1) / 2)"}, x: []string{"a", "a + 1"}},
+		{pattern: "$x + 1", want: []string{"a + 1"}, x: []string{"a"}},
+		{pattern: "b", want: []string{"b", "b"}},
+		{pattern: "$x:double", want: []string{"d = d / 2.0", "d", "d / 2.0", "d", "2.0"}, x: []string{"d = d / 2.0", "d", "d / 2.0", "d", "2.0"}},
+		{pattern: "$x = $x", want: []string{"a = a"}, x: []string{"a"}},
+		{pattern: "$_ = $_", want: []string{"g = 1", "g = a", "a = a", "d = d / 2.0"}},
+		{pattern: "g = $x", want: []string{"g = 1", "g = a"}, x: []string{"1", "a"}},
+		{pattern: "$x / $y:double", want: []string{"d / 2.0"}, x: []string{"d"}},
+		{pattern: "$x:double / $y:int", want: nil},
+		{pattern: "$f($x:int)", want: []string{"putint(a / b)", "putint((a + 1) / 2)", "putint(a)"}, x: []string{"a / b", "(a + 1) / 2", "a"}},
+		{pattern: "while ($x) $s;", want: []string{"while (a < b) {\n\t\tg = a;\n\t\ta = a;\n\t}"}, x: []string{"a < b"}},
+		{pattern: "while ($x) { $_; }", want: nil},
+		{pattern: "if ($x) return 1;", want: []string{"if (a) return 1; else return 2;"}, x: []string{"a"}},
+		{pattern: "return $_;", want: []string{"return 1;", "return 2;", "return 0;"}},
+	}
+	file, err := parser.ParseString(input, nil)
+	if err != nil {
+		t.Fatalf("unable to parse input; %v", err)
+	}
+	info, err := sem.Check(file)
+	if err != nil {
+		t.Fatalf("unable to check input; %v", err)
+	}
+	for _, g := range golden {
+		p, err := search.Compile(g.pattern)
+		if err != nil {
+			t.Errorf("%q: unable to compile pattern; %v", g.pattern, err)
+			continue
+		}
+		matches := p.Find(file, info)
+		if len(matches) != len(g.want) {
+			t.Errorf("%q: matches mismatch; expected %d matches, got %d", g.pattern, len(g.want), len(matches))
+			continue
+		}
+		for i, m := range matches {
+			got := input[m.Node.Start():m.Node.End()]
+			if got != g.want[i] {
+				t.Errorf("%q: match mismatch; expected %q, got %q", g.pattern, g.want[i], got)
+			}
+			if g.x == nil {
+				if _, ok := m.Binds["x"]; ok {
+					t.Errorf("%q: unexpected binding of $x", g.pattern)
+				}
+				continue
+			}
+			x, ok := m.Binds["x"]
+			if !ok {
+				t.Errorf("%q: missing binding of $x", g.pattern)
+				continue
+			}
+			if got := input[x.Start():x.End()]; got != g.x[i] {
+				t.Errorf("%q: binding mismatch of $x; expected %q, got %q", g.pattern, g.x[i], got)
+			}
+		}
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	golden := []string{
+		"putint(",
+		"$x:int / $x:char",
+		"x = 1; y = 2;",
+		"int x;",
+	}
+	for _, pattern := range golden {
+		if _, err := search.Compile(pattern); err == nil {
+			t.Errorf("%q: expected error, got nil", pattern)
+		}
+	}
+}
@@ -1,75 +1,251 @@
-// Package errors provides pretty-printing of semantic analysis errors.
+// Package errors provides pretty-printing of semantic analysis errors, warnings
+// and notes.
 package errors
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/mewkiz/pkg/term"
+	"github.com/mewmew/uc/token"
 )
 
 // UseColor indicates if error messages should use colors.
 var UseColor = true
 
-// An Error represents a semantic analysis error.
-type Error struct {
-	// Input source position (in bytes).
-	Pos int
-	// Error message.
+// Severity specifies the severity of a diagnostic.
+type Severity uint8
+
+// Diagnostic severities.
+const (
+	// SeverityError specifies that the diagnostic is an error, which prevents
+	// compilation.
+	SeverityError Severity = iota
+	// SeverityWarning specifies that the diagnostic is a warning about valid but
+	// suspicious code.
+	SeverityWarning
+	// SeverityNote specifies that the diagnostic is a note, which provides
+	// additional information about a related diagnostic.
+	SeverityNote
+)
+
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityNote:
+		return "note"
+	default:
+		return fmt.Sprintf("unknown severity (%d)", uint8(severity))
+	}
+}
+
+// A Diagnostic represents a semantic analysis error, warning or note.
+type Diagnostic struct {
+	// Severity of the diagnostic.
+	Severity Severity
+	// Name of the warning (e.g. "narrowing"); or empty if not a warning or a
+	// warning promoted to an error.
+	Warning string
+	// Input source position.
+	Pos token.Pos
+	// Source range [Start, End) underlined by the diagnostic, in addition to the
+	// caret at Pos; only valid if End > Start.
+	Start, End token.Pos
+	// Diagnostic message.
 	Text string
 	// Input source.
 	Src *Source
+	// Notes related to the diagnostic (e.g. the position of a previous
+	// definition); or nil.
+	Notes []*Diagnostic
 }
 
-// New returns a new error based on the given positional information (offset in
-// bytes).
-func New(pos int, text string) *Error {
-	err := &Error{
-		Pos:  pos,
-		Text: text,
+// New returns a new error based on the given positional information.
+func New(pos token.Pos, text string) *Diagnostic {
+	err := &Diagnostic{
+		Severity: SeverityError,
+		Pos:      pos,
+		Text:     text,
 	}
 	return err
 }
 
-// Newf returns a new formatted error based on the given positional information
-// (offset in bytes).
-func Newf(pos int, format string, a ...interface{}) *Error {
-	err := &Error{
-		Pos:  pos,
-		Text: fmt.Sprintf(format, a...),
+// Newf returns a new formatted error based on the given positional
+// information.
+func Newf(pos token.Pos, format string, a ...interface{}) *Diagnostic {
+	err := &Diagnostic{
+		Severity: SeverityError,
+		Pos:      pos,
+		Text:     fmt.Sprintf(format, a...),
 	}
 	return err
 }
 
-// Error returns an error string with position information.
+// Warningf returns a new formatted warning of the given name based on the given
+// positional information.
+func Warningf(pos token.Pos, warning, format string, a ...interface{}) *Diagnostic {
+	warn := &Diagnostic{
+		Severity: SeverityWarning,
+		Warning:  warning,
+		Pos:      pos,
+		Text:     fmt.Sprintf(format, a...),
+	}
+	return warn
+}
+
+// Notef attaches a formatted note based on the given positional information to
+// the diagnostic. The diagnostic is returned to allow for chaining.
+func (d *Diagnostic) Notef(pos token.Pos, format string, a ...interface{}) *Diagnostic {
+	note := &Diagnostic{
+		Severity: SeverityNote,
+		Pos:      pos,
+		Text:     fmt.Sprintf(format, a...),
+	}
+	d.Notes = append(d.Notes, note)
+	return d
+}
+
+// Range sets the source range [start, end) underlined by the diagnostic. The
+// diagnostic is returned to allow for chaining.
+func (d *Diagnostic) Range(start, end token.Pos) *Diagnostic {
+	d.Start, d.End = start, end
+	return d
+}
+
+// Error returns a diagnostic string with position information, followed by
+// the related notes.
 //
-// The error format is as follows.
+// The diagnostic format is as follows.
 //
-//    (file:line:column): error: text
-func (e *Error) Error() string {
+//    (file:line:column) error: text
+//    (file:line:column) warning: text [-W name]
+//    (file:line:column) note: text
+func (d *Diagnostic) Error() string {
+	buf := &bytes.Buffer{}
+	text := d.Text
+	if len(d.Warning) > 0 {
+		flag := d.Warning
+		if d.Severity == SeverityError {
+			flag = "error=" + flag
+		}
+		text = fmt.Sprintf("%s [-W %s]", text, flag)
+	}
+	buf.WriteString(d.Src.format(d, text))
+	for _, note := range d.Notes {
+		buf.WriteString("\n")
+		buf.WriteString(d.Src.format(note, note.Text))
+	}
+	return buf.String()
+}
+
+// A List is a list of semantic analysis diagnostics.
+type List []*Diagnostic
+
+// Add appends the given diagnostic to the list. Warnings are added regardless of
+// their state; see WarningConfig.Apply.
+func (list *List) Add(d *Diagnostic) {
+	*list = append(*list, d)
+}
+
+// Len returns the number of diagnostics in the list.
+func (list List) Len() int {
+	return len(list)
+}
+
+// Swap swaps the diagnostics with indices i and j.
+func (list List) Swap(i, j int) {
+	list[i], list[j] = list[j], list[i]
+}
+
+// Less reports whether the diagnostic with index i is positioned before the
+// diagnostic with index j.
+func (list List) Less(i, j int) bool {
+	return list[i].Pos < list[j].Pos
+}
+
+// Sort sorts the diagnostics of the list by position. The relative order of
+// diagnostics at the same position is preserved.
+func (list List) Sort() {
+	sort.Stable(list)
+}
+
+// SetSource sets the input source of each diagnostic in the list.
+func (list List) SetSource(src *Source) {
+	for _, d := range list {
+		d.Src = src
+	}
+}
+
+// HasErrors reports whether the list contains any errors.
+func (list List) HasErrors() bool {
+	for _, d := range list {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Error returns a string containing each diagnostic of the list, separated by
+// new lines.
+func (list List) Error() string {
+	buf := &bytes.Buffer{}
+	for i, d := range list {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(d.Error())
+	}
+	return buf.String()
+}
+
+// Err returns an error equivalent to the diagnostic list if it contains any
+// errors; or nil otherwise.
+func (list List) Err() error {
+	if !list.HasErrors() {
+		return nil
+	}
+	return list
+}
+
+// format returns a message string with position information, based on the
+// position, source range and severity of the given diagnostic, and the given
+// text.
+func (src *Source) format(d *Diagnostic, text string) string {
+	pos := d.Pos
 	// Use colors.
-	pos := fmt.Sprintf("(byte offset %d)", e.Pos)
-	prefix := "error:"
-	text := e.Text
+	posStr := fmt.Sprintf("(byte offset %d)", pos)
+	prefix := d.Severity.String() + ":"
 	if UseColor {
-		pos = term.Color(pos, term.Bold)
-		prefix = term.RedBold(prefix)
+		posStr = term.Color(posStr, term.Bold)
+		switch d.Severity {
+		case SeverityError:
+			prefix = term.RedBold(prefix)
+		case SeverityWarning:
+			prefix = term.MagentaBold(prefix)
+		default:
+			prefix = term.Color(prefix, term.Bold)
+		}
 		text = term.Color(text, term.Bold)
 	}
-	src := e.Src
 	if src == nil {
 		// If Src is nil, the error format is as follows.
 		//
 		//    (byte offset %d) error: text
-		return fmt.Sprintf("%s %s %s", pos, prefix, text)
+		return fmt.Sprintf("%s %s %s", posStr, prefix, text)
 	}
-	// The error format is as follows.
+	// The error format is as follows, where the source range of the diagnostic
+	// (if any) is underlined.
 	//
-	//    (file:line) error: text
-	//       1 = y
-	//         ^
-	line, col := src.Position(e.Pos)
+	//    (file:line:column) error: text
+	//       x = y + 1
+	//           ^~~~~
+	line, col := src.Position(pos)
 	end := len(src.Input)
 	if len(src.Lines) > line {
 		end = src.Lines[line]
@@ -77,13 +253,39 @@ func (e *Error) Error() string {
 	srcLine := src.Input[src.Lines[line-1]:end]
 	srcLine = strings.Replace(srcLine, "\t", " ", -1)
 	srcLine = strings.TrimRight(srcLine, "\n\r")
-	arrow := fmt.Sprintf("%*s", col, "^")
-	pos = fmt.Sprintf("(%s:%d)", src.Path, line)
+	arrow := src.underline(d, line, col, len(srcLine))
+	posStr = fmt.Sprintf("(%s:%d:%d)", src.Path, line, col)
 	if UseColor {
-		pos = term.Color(pos, term.Bold)
+		posStr = term.Color(posStr, term.Bold)
 		arrow = term.Color(arrow, term.Bold)
 	}
-	return fmt.Sprintf("%s %s %s\n%s\n%s", pos, prefix, text, srcLine, arrow)
+	return fmt.Sprintf("%s %s %s\n%s\n%s", posStr, prefix, text, srcLine, arrow)
+}
+
+// underline returns a caret pointing to the given column, with the source
+// range of the diagnostic underlined using tildes (e.g. "^~~~~"). The source
+// range is clipped to the given line of the specified length.
+func (src *Source) underline(d *Diagnostic, line, col, length int) string {
+	buf := []byte(fmt.Sprintf("%*s", col, "^"))
+	if d.End > d.Start {
+		lineStart := src.Lines[line-1]
+		start, end := src.offset(d.Start)-lineStart, src.offset(d.End)-lineStart
+		if start < 0 {
+			start = 0
+		}
+		if end > length {
+			end = length
+		}
+		for i := start; i < end; i++ {
+			for len(buf) <= i {
+				buf = append(buf, ' ')
+			}
+			if i != col-1 {
+				buf[i] = '~'
+			}
+		}
+	}
+	return string(buf)
 }
 
 // A Source represents an input source.
@@ -92,35 +294,64 @@ type Source struct {
 	Path string
 	// Input source text.
 	Input string
-	// Lines tracks the start positions of lines within the input stream.
+	// Lines tracks the start offsets of lines within the input stream.
 	Lines []int
+	// File of the input source, which shares the line table of the source.
+	File *token.File
 }
 
 // Position returns the corresponding line:column pair of the given position in
 // the input stream.
-func (src *Source) Position(pos int) (line, column int) {
-	// Implemented using binary search, lines should be sorted in ascending
-	// order.
-	index := sort.SearchInts(src.Lines, pos+1) - 1
-	line = index + 1
-	column = pos - src.Lines[index] + 1
-	return line, column
+func (src *Source) Position(pos token.Pos) (line, column int) {
+	p := src.File.Position(pos)
+	return p.Line, p.Column
+}
+
+// offset returns the byte offset of the given position in the input stream,
+// clamped to the input.
+func (src *Source) offset(pos token.Pos) int {
+	offset := int(pos) - src.File.Base()
+	if offset < 0 {
+		return 0
+	}
+	if offset > len(src.Input) {
+		return len(src.Input)
+	}
+	return offset
 }
 
 // NewSource returns a new source based on the given input. The path is only
 // used in error messages, and "<stdin>" is conventionally used for the standard
 // input stream.
+//
+// The positions of the source coincide with byte offsets, as the file of the
+// source does not belong to any file set.
 func NewSource(path, input string) *Source {
+	return newSource(token.NewFile(path, len(input)), input)
+}
+
+// AddSource returns a new source based on the given input, and adds the file of
+// the source to the given file set, so that its positions are distinct from
+// the positions of other files in the file set. The path is used as file name.
+func AddSource(fset *token.FileSet, path, input string) *Source {
+	return newSource(fset.AddFile(path, -1, len(input)), input)
+}
+
+// newSource returns a new source based on the given file and input.
+func newSource(file *token.File, input string) *Source {
 	src := &Source{
-		Path:  path,
+		Path:  file.Name(),
 		Input: input,
+		File:  file,
 	}
 	for i := 0; i < len(input); {
 		src.Lines = append(src.Lines, i)
 		pos := strings.IndexRune(input[i:], '\n')
-		if pos != -1 {
-			i += pos + 1
+		if pos == -1 {
+			break
 		}
+		i += pos + 1
 	}
+	file.SetLines(src.Lines)
 	return src
 }
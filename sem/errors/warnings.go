@@ -0,0 +1,134 @@
+package errors
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Names of warnings.
+const (
+	// Narrowing warns about implicit conversions to types of lower precision
+	// (e.g. from "double" to "int").
+	Narrowing = "narrowing"
+)
+
+// A WarningState specifies whether a warning is disabled, enabled or promoted
+// to an error.
+type WarningState uint8
+
+// Warning states.
+const (
+	// WarningOff specifies that the warning is disabled.
+	WarningOff WarningState = iota
+	// WarningOn specifies that the warning is enabled.
+	WarningOn
+	// WarningError specifies that the warning is promoted to an error.
+	WarningError
+)
+
+// A WarningConfig maps from warning names to the state of the corresponding
+// warning. The map holds an entry for each known warning.
+//
+// WarningConfig implements the flag.Value interface for -W command line flags,
+// which enable, disable or promote warnings to errors.
+type WarningConfig map[string]WarningState
+
+// NewWarningConfig returns a new warning configuration, holding the default
+// state of the warnings reported by the semantic analysis passes.
+func NewWarningConfig() WarningConfig {
+	return WarningConfig{
+		Narrowing: WarningOn,
+	}
+}
+
+// Register adds a warning of the given name with the given initial state to
+// the configuration, for warnings reported outside of the semantic analysis
+// passes (e.g. by lint checks). An error is returned if the warning name is
+// already in use.
+func (c WarningConfig) Register(name string, state WarningState) error {
+	if _, ok := c[name]; ok {
+		return fmt.Errorf("warning %q already registered", name)
+	}
+	c[name] = state
+	return nil
+}
+
+// String returns the string representation of the flag value.
+func (c WarningConfig) String() string {
+	return ""
+}
+
+// Set updates the state of warnings based on the given command line flag
+// value. The following flag values are supported.
+//
+//    name         enable the named warning
+//    no-name      disable the named warning
+//    error=name   promote the named warning to an error
+//    error        promote all enabled warnings to errors
+func (c WarningConfig) Set(flag string) error {
+	switch {
+	case flag == "error":
+		for name, state := range c {
+			if state == WarningOn {
+				c[name] = WarningError
+			}
+		}
+		return nil
+	case strings.HasPrefix(flag, "error="):
+		name := flag[len("error="):]
+		if err := c.check(name); err != nil {
+			return err
+		}
+		c[name] = WarningError
+		return nil
+	case strings.HasPrefix(flag, "no-"):
+		name := flag[len("no-"):]
+		if err := c.check(name); err != nil {
+			return err
+		}
+		c[name] = WarningOff
+		return nil
+	default:
+		if err := c.check(flag); err != nil {
+			return err
+		}
+		c[flag] = WarningOn
+		return nil
+	}
+}
+
+// check returns an error if the given warning name is unknown.
+func (c WarningConfig) check(name string) error {
+	if _, ok := c[name]; ok {
+		return nil
+	}
+	var names []string
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("unknown warning %q; valid warnings: %s", name, strings.Join(names, ", "))
+}
+
+// Apply returns the diagnostics of the given list with warnings disabled, kept
+// or promoted to errors based on the state of the corresponding warning.
+// Warnings unknown to the configuration are disabled. The diagnostics of the
+// given list are left unmodified.
+func (c WarningConfig) Apply(list List) List {
+	var ds List
+	for _, d := range list {
+		if d.Severity == SeverityWarning {
+			switch c[d.Warning] {
+			case WarningOff:
+				continue
+			case WarningError:
+				e := *d
+				e.Severity = SeverityError
+				d = &e
+			}
+		}
+		ds = append(ds, d)
+	}
+	return ds
+}
@@ -5,18 +5,34 @@ import (
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // universePos specifies a pseudo-position used for identifiers declared in the
 // universe scope.
-const universePos = -1
+const universePos = token.NoPos
 
 // resolve performs identifier resolution, mapping identifiers to corresponding
-// declarations.
-func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
+// declarations. Undeclared identifiers are bound to placeholder declarations of
+// invalid type (see IsUndeclared), and the diagnostics encountered are recorded
+// in diags.
+func resolve(file *ast.File, scopes map[ast.Node]*Scope, diags *errors.List) error {
 	// TODO: Verify that type keywords cannot be redeclared.
 
+	// insert inserts the given declaration into the given scope, and records
+	// semantic analysis errors to allow resolution to continue.
+	insert := func(scope *Scope, decl ast.Decl) error {
+		if err := scope.Insert(decl); err != nil {
+			e, ok := err.(*errors.Diagnostic)
+			if !ok {
+				return errutil.Err(err)
+			}
+			diags.Add(e)
+		}
+		return nil
+	}
+
 	// Pre-pass, add keyword types and universe scope.
 	universe := NewScope(nil)
 	charIdent := &ast.Ident{NamePos: universePos, Name: "char"}
@@ -25,12 +41,20 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 	intIdent := &ast.Ident{NamePos: universePos, Name: "int"}
 	intDecl := &ast.TypeDef{DeclType: intIdent, TypeName: intIdent, Val: &types.Basic{Kind: types.Int}}
 	intIdent.Decl = intDecl
+	floatIdent := &ast.Ident{NamePos: universePos, Name: "float"}
+	floatDecl := &ast.TypeDef{DeclType: floatIdent, TypeName: floatIdent, Val: &types.Basic{Kind: types.Float}}
+	floatIdent.Decl = floatDecl
+	doubleIdent := &ast.Ident{NamePos: universePos, Name: "double"}
+	doubleDecl := &ast.TypeDef{DeclType: doubleIdent, TypeName: doubleIdent, Val: &types.Basic{Kind: types.Double}}
+	doubleIdent.Decl = doubleDecl
 	voidIdent := &ast.Ident{NamePos: universePos, Name: "void"}
 	voidDecl := &ast.TypeDef{DeclType: voidIdent, TypeName: voidIdent, Val: &types.Basic{Kind: types.Void}}
 	voidIdent.Decl = voidDecl
 	universeDecls := []*ast.TypeDef{
 		charDecl,
 		intDecl,
+		floatDecl,
+		doubleDecl,
 		voidDecl,
 	}
 	for _, decl := range universeDecls {
@@ -38,78 +62,120 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 			return errutil.Err(err)
 		}
 	}
+	// The invalid type is not added to the universe scope, as it cannot be
+	// referred to by name. It is used as the type of undeclared identifiers.
+	invalidIdent := &ast.Ident{NamePos: universePos, Name: "invalid type"}
+	invalidIdent.Decl = &ast.TypeDef{DeclType: invalidIdent, TypeName: invalidIdent, Val: &types.Basic{Kind: types.Invalid}}
 
 	// First pass, add global declarations to file scope.
 	fileScope := NewScope(universe)
 	scopes[file] = fileScope
+	fileScope.IsFile = true
 	fileScope.IsDef = func(decl ast.Decl) bool {
 		// Consider variable declarations as tentative definitions; i.e. return
 		// false, unless variable definition.
 		return decl.Value() != nil
 	}
 	for _, decl := range file.Decls {
-		if err := fileScope.Insert(decl); err != nil {
+		if err := insert(fileScope, decl); err != nil {
 			return errutil.Err(err)
 		}
 	}
 
-	// skip specifies that the block statement body of a function declaration
-	// should skip creating a nested scope, as it has already been created by its
-	// function declaration, so that function parameters are placed within the
-	// correct scope.
-	skip := false
-
 	// scope specifies the current lexical scope.
 	scope := fileScope
 
+	// undeclared maps from scopes to the placeholder declarations of the
+	// undeclared identifiers used within the scope, one per name.
+	undeclared := make(map[*Scope]map[string]ast.Decl)
+
+	// undeclaredDecl returns the placeholder declaration of the given undeclared
+	// identifier in the current scope. The placeholder declaration has no
+	// source position, as it is not part of the source.
+	undeclaredDecl := func(name string) ast.Decl {
+		decls, ok := undeclared[scope]
+		if !ok {
+			decls = make(map[string]ast.Decl)
+			undeclared[scope] = decls
+		}
+		if decl, ok := decls[name]; ok {
+			return decl
+		}
+		ident := &ast.Ident{NamePos: token.NoPos, Name: name}
+		decl := &ast.VarDecl{VarType: invalidIdent, VarName: ident}
+		ident.Decl = decl
+		decls[name] = decl
+		return decl
+	}
+
 	// resolve performs identifier resolution, mapping identifiers to the
 	// corresponding declarations of the closest lexical scope.
-	resolve := func(n ast.Node) error {
+	var resolve func(n ast.Node) (bool, error)
+
+	// resolveScope resolves the identifiers of the given nodes within a new
+	// nested scope of the given node, and reverts to the outer scope afterwards.
+	resolveScope := func(n ast.Node, nodes ...ast.Node) error {
+		scope = NewScope(scope)
+		scopes[n] = scope
+		for _, n := range nodes {
+			if err := astutil.Inspect(n, resolve); err != nil {
+				return errutil.Err(err)
+			}
+		}
+		scope = scope.Outer
+		return nil
+	}
+
+	resolve = func(n ast.Node) (bool, error) {
 		switch n := n.(type) {
 		case ast.Decl:
 			// Insert declaration into the scope if not already added by the
 			// file scope pre-pass.
 			if scope != fileScope {
-				if err := scope.Insert(n); err != nil {
-					return errutil.Err(err)
+				if err := insert(scope, n); err != nil {
+					return false, errutil.Err(err)
 				}
 			}
-			// Create nested scope for function definitions.
+			// Create nested scope for function declarations. The function
+			// parameters and the items of the function body share the same
+			// scope, so the block statement of the body is not traversed.
 			if fn, ok := n.(*ast.FuncDecl); ok {
-				if astutil.IsDef(fn) {
-					skip = true
+				nodes := []ast.Node{fn.FuncName, fn.FuncType}
+				if fn.Body != nil {
+					for _, item := range fn.Body.Items {
+						nodes = append(nodes, item)
+					}
+				}
+				if err := resolveScope(fn, nodes...); err != nil {
+					return false, errutil.Err(err)
 				}
-				scope = NewScope(scope)
-				scopes[fn] = scope
+				return false, nil
 			}
 		case *ast.BlockStmt:
-			if !skip {
-				scope = NewScope(scope)
-				scopes[n] = scope
+			// Create nested scope for block statements.
+			nodes := make([]ast.Node, 0, len(n.Items))
+			for _, item := range n.Items {
+				nodes = append(nodes, item)
 			}
-			skip = false
+			if err := resolveScope(n, nodes...); err != nil {
+				return false, errutil.Err(err)
+			}
+			return false, nil
 		case *ast.Ident:
 			decl, ok := scope.Lookup(n.Name)
 			if !ok {
-				return errors.Newf(n.Start(), "undeclared identifier %q", n)
+				diags.Add(errors.Newf(n.Start(), "undeclared identifier %q", n).Range(n.Start(), n.End()))
+				// Bind the undeclared identifier to a placeholder declaration of
+				// invalid type, to prevent cascading errors.
+				decl = undeclaredDecl(n.Name)
 			}
 			n.Decl = decl
 		}
-		return nil
-	}
-
-	// after reverts to the outer scope after traversing block statements.
-	after := func(n ast.Node) error {
-		if _, ok := n.(*ast.BlockStmt); ok {
-			scope = scope.Outer
-		} else if fn, ok := n.(*ast.FuncDecl); ok && !astutil.IsDef(fn) {
-			scope = scope.Outer
-		}
-		return nil
+		return true, nil
 	}
 
-	// Walk the AST of the given file to resolve identifiers.
-	if err := astutil.WalkBeforeAfter(file, resolve, after); err != nil {
+	// Traverse the AST of the given file to resolve identifiers.
+	if err := astutil.Inspect(file, resolve); err != nil {
 		return errutil.Err(err)
 	}
 
@@ -1,6 +1,8 @@
 package sem
 
 import (
+	"fmt"
+
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/sem/errors"
@@ -14,17 +16,22 @@ type Scope struct {
 	Outer *Scope
 	// Identifiers declared within the current scope.
 	Decls map[string]ast.Decl
+	// Linkage of identifiers declared within the current scope.
+	Linkages map[string]Linkage
 	// IsDef reports whether the given declaration is a definition.
 	IsDef func(ast.Decl) bool
+	// IsFile reports whether the scope is a file scope.
+	IsFile bool
 }
 
 // NewScope returns a new lexical scope immediately surrouded by the given outer
 // scope.
 func NewScope(outer *Scope) *Scope {
 	return &Scope{
-		Outer: outer,
-		Decls: make(map[string]ast.Decl),
-		IsDef: astutil.IsDef,
+		Outer:    outer,
+		Decls:    make(map[string]ast.Decl),
+		Linkages: make(map[string]Linkage),
+		IsDef:    astutil.IsDef,
 	}
 }
 
@@ -38,43 +45,109 @@ func (s *Scope) Insert(decl ast.Decl) error {
 	}
 	name := ident.String()
 	prev, ok := s.Decls[name]
+	if ok && prev.Name().NamePos == ident.NamePos {
+		// Identifier already added to scope.
+		return nil
+	}
+	linkage, err := s.linkageOf(decl)
+	if err != nil {
+		// Insert first-time declarations regardless, to prevent subsequent uses
+		// of the identifier from being reported as undeclared.
+		if !ok {
+			s.Decls[name] = decl
+			s.Linkages[name] = linkage
+		}
+		return err
+	}
 	if !ok {
 		s.Decls[name] = decl
+		s.Linkages[name] = linkage
 		return nil
 	}
-	prevIdent := prev.Name()
 
-	if prevIdent.NamePos == ident.NamePos {
-		// Identifier already added to scope.
-		return nil
+	// Previously declared.
+	prevIdent := prev.Name()
+	// notePrev attaches a note of the previous declaration to the given error,
+	// unless the identifier was declared in the universe scope.
+	notePrev := func(err *errors.Diagnostic, format string) error {
+		if prevIdent.Start() != universePos {
+			err.Notef(prevIdent.Start(), format, name)
+		}
+		return err
+	}
+	if !isCompatible(prev.Type(), decl.Type()) {
+		err := errors.Newf(ident.Start(), "redefinition of %q with type %q instead of %q", name, decl.Type(), prev.Type()).Range(ident.Start(), ident.End())
+		return notePrev(err, "previous declaration of %q")
 	}
 
-	// Previously declared.
-	if !types.Equal(prev.Type(), decl.Type()) {
-		return errors.Newf(ident.Start(), "redefinition of %q with type %q instead of %q", name, decl.Type(), prev.Type())
+	// Verify that all declarations of the identifier have the same linkage (see
+	// §6.2.2.7).
+	if prevLinkage := s.Linkages[name]; linkage != prevLinkage {
+		var err *errors.Diagnostic
+		switch {
+		case linkage == Internal:
+			err = errors.Newf(ident.Start(), "static declaration of %q follows non-static declaration", name).Range(ident.Start(), ident.End())
+		case prevLinkage == Internal:
+			err = errors.Newf(ident.Start(), "non-static declaration of %q follows static declaration", name).Range(ident.Start(), ident.End())
+		case linkage == External:
+			err = errors.Newf(ident.Start(), "extern declaration of %q follows non-extern declaration", name).Range(ident.Start(), ident.End())
+		default:
+			err = errors.Newf(ident.Start(), "non-extern declaration of %q follows extern declaration", name).Range(ident.Start(), ident.End())
+		}
+		return notePrev(err, "previous declaration of %q")
 	}
 
 	// The last tentative definition becomes the definition, unless defined
-	// explicitly (e.g. having an initializer or function body).
+	// explicitly (e.g. having an initializer or function body). Declarations
+	// which are neither definitions nor tentative definitions (e.g. extern
+	// variable declarations) never replace tentative definitions.
 	if !s.IsDef(prev) {
-		s.Decls[name] = decl
+		if s.IsDef(decl) || s.isTentativeDef(decl) || !s.isTentativeDef(prev) {
+			s.Decls[name] = decl
+		}
 		return nil
 	}
 
 	// Definition already present in scope.
 	if s.IsDef(decl) {
-		// TODO: Consider adding support for multiple errors (and potentially
-		// warnings and notifications).
-		//
-		// If support for notifications are added, add a note of the previous declaration.
-		//    errors.Notef(prevIdent.Start(), "previous definition of %q", name)
-		return errors.Newf(ident.Start(), "redefinition of %q", name)
+		err := errors.Newf(ident.Start(), "redefinition of %q", name).Range(ident.Start(), ident.End())
+		return notePrev(err, "previous definition of %q")
 	}
 
 	// Declaration of previously declared identifier.
 	return nil
 }
 
+// isCompatible reports whether the types t and u of two declarations of the
+// same identifier are compatible. Top-level qualifiers of parameter types do
+// not affect the compatibility of function types (see §6.7.5.3.15).
+func isCompatible(t, u types.Type) bool {
+	tf, ok1 := t.(*types.Func)
+	uf, ok2 := u.(*types.Func)
+	if !ok1 || !ok2 {
+		return types.Equal(t, u)
+	}
+	if !types.Equal(tf.Result, uf.Result) || len(tf.Params) != len(uf.Params) {
+		return false
+	}
+	for i := range tf.Params {
+		if !types.Equal(paramType(tf.Params[i].Type), paramType(uf.Params[i].Type)) {
+			return false
+		}
+	}
+	return true
+}
+
+// paramType returns the given parameter type without top-level qualifiers. The
+// qualifiers of array elements are retained, as array parameters are adjusted
+// to pointers to the qualified element type (see §6.7.5.3.7).
+func paramType(t types.Type) types.Type {
+	if _, ok := t.(*types.Array); ok {
+		return t
+	}
+	return types.Unqualified(t)
+}
+
 // Lookup returns the declaration of name in the innermost scope of s. The
 // returned boolean variable reports whether a declaration of name was located.
 func (s *Scope) Lookup(name string) (ast.Decl, bool) {
@@ -86,3 +159,112 @@ func (s *Scope) Lookup(name string) (ast.Decl, bool) {
 	}
 	return s.Outer.Lookup(name)
 }
+
+// isTentativeDef reports whether the given declaration is a tentative
+// definition; i.e. a file-scope variable declaration without an initializer,
+// and without a storage-class specifier or with the storage-class specifier
+// static (see §6.9.2.2).
+func (s *Scope) isTentativeDef(decl ast.Decl) bool {
+	if !s.IsFile {
+		return false
+	}
+	if decl, ok := decl.(*ast.VarDecl); ok {
+		return decl.Val == nil && decl.Storage != ast.Extern
+	}
+	return false
+}
+
+// Linkage specifies whether declarations of the same identifier in different
+// scopes refer to the same object or function (see §6.2.2).
+type Linkage uint8
+
+// Linkages.
+const (
+	// NoLinkage specifies that each declaration of the identifier denotes a
+	// unique entity (e.g. local variables).
+	NoLinkage Linkage = iota
+	// Internal specifies that each declaration of the identifier within the
+	// translation unit denotes the same object or function (e.g. static global
+	// variables).
+	Internal
+	// External specifies that each declaration of the identifier within the
+	// entire program denotes the same object or function (e.g. global
+	// variables).
+	External
+)
+
+func (linkage Linkage) String() string {
+	switch linkage {
+	case NoLinkage:
+		return "none"
+	case Internal:
+		return "internal"
+	case External:
+		return "external"
+	default:
+		return fmt.Sprintf("unknown linkage (%d)", uint8(linkage))
+	}
+}
+
+// linkageOf returns the linkage of the given declaration, as determined by its
+// storage-class specifier, the scope in which it is declared and the linkage of
+// prior declarations of the same identifier (see §6.2.2).
+func (s *Scope) linkageOf(decl ast.Decl) (Linkage, error) {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if decl.Storage == ast.Static {
+			// "The declaration of an identifier for a function that has block
+			// scope shall have no explicit storage-class specifier other than
+			// extern." (see §6.7.1.7)
+			if !s.IsFile {
+				return NoLinkage, errors.Newf(decl.StoragePos, "function %q declared in block scope cannot have static storage-class", decl.Name())
+			}
+			return Internal, nil
+		}
+		// "If the declaration of an identifier for a function has no storage-
+		// class specifier, its linkage is determined exactly as if it were
+		// declared with the storage-class specifier extern." (see §6.2.2.5)
+		return s.externLinkage(decl.Name().Name), nil
+	case *ast.VarDecl:
+		switch decl.Storage {
+		case ast.Extern:
+			return s.externLinkage(decl.Name().Name), nil
+		case ast.Static:
+			if s.IsFile {
+				return Internal, nil
+			}
+			return NoLinkage, nil
+		default:
+			if s.IsFile {
+				return External, nil
+			}
+			return NoLinkage, nil
+		}
+	default:
+		// "The following identifiers have no linkage: an identifier declared to
+		// be anything other than an object or a function" (see §6.2.2.6)
+		return NoLinkage, nil
+	}
+}
+
+// externLinkage returns the linkage of an identifier declared with the
+// storage-class specifier extern in s.
+//
+// "For an identifier declared with the storage-class specifier extern in a
+// scope in which a prior declaration of that identifier is visible, if the
+// prior declaration specifies internal or external linkage, the linkage of the
+// identifier at the later declaration is the same as the linkage specified at
+// the prior declaration. If no prior declaration is visible, or if the prior
+// declaration specifies no linkage, then the identifier has external linkage."
+// (see §6.2.2.4)
+func (s *Scope) externLinkage(name string) Linkage {
+	for scope := s; scope != nil; scope = scope.Outer {
+		if _, ok := scope.Decls[name]; ok {
+			if linkage := scope.Linkages[name]; linkage != NoLinkage {
+				return linkage
+			}
+			return External
+		}
+	}
+	return External
+}
@@ -4,13 +4,29 @@ package sem
 import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
 	"github.com/mewmew/uc/sem/typecheck"
 	"github.com/mewmew/uc/types"
 )
 
-// Check performs a static semantic analysis check on the given file.
+// Check performs a static semantic analysis check on the given file, using the
+// default state of warnings (see errors.NewWarningConfig).
 func Check(file *ast.File) (*Info, error) {
+	return CheckWarnings(file, errors.NewWarningConfig())
+}
+
+// CheckWarnings performs a static semantic analysis check on the given file.
+// Warnings are disabled, kept or promoted to errors based on the given warning
+// configuration.
+//
+// Semantic analysis continues past recoverable errors. The diagnostics (errors
+// and warnings) encountered are stored in the returned semantic information,
+// sorted by position. If any errors were encountered, the diagnostics are also
+// returned as an errors.List error, in which case the returned semantic
+// information is partial.
+func CheckWarnings(file *ast.File, warnings errors.WarningConfig) (*Info, error) {
 	// Semantic analysis is done in two passes to allow for forward references.
 	// Firstly, the global declarations are added to the file-scope. Secondly,
 	// the global function declaration bodies are traversed to resolve
@@ -21,21 +37,23 @@ func Check(file *ast.File) (*Info, error) {
 		Types:  make(map[ast.Expr]types.Type),
 		Scopes: make(map[ast.Node]*Scope),
 	}
-	if err := resolve(file, info.Scopes); err != nil {
+	if err := resolve(file, info.Scopes, &info.Diagnostics); err != nil {
 		return nil, errutil.Err(err)
 	}
 
 	// Type-checking.
-	if err := typecheck.Check(file, info.Types); err != nil {
+	if err := typecheck.Check(file, info.Types, &info.Diagnostics); err != nil {
 		return nil, errutil.Err(err)
 	}
 
 	// Semantic analysis.
-	if err := semcheck.Check(file); err != nil {
+	if err := semcheck.Check(file, &info.Diagnostics); err != nil {
 		return nil, errutil.Err(err)
 	}
 
-	return info, nil
+	info.Diagnostics = warnings.Apply(info.Diagnostics)
+	info.Diagnostics.Sort()
+	return info, info.Diagnostics.Err()
 }
 
 // TODO: Consider to move Info to uc/types.
@@ -52,4 +70,46 @@ type Info struct {
 	//    *ast.FuncDecl
 	//    *ast.BlockStmt
 	Scopes map[ast.Node]*Scope
+	// Diagnostics holds the errors and warnings of the program, sorted by
+	// position.
+	Diagnostics errors.List
+}
+
+// IsUndeclared reports whether the given declaration is the placeholder
+// declaration of undeclared identifiers. Placeholder declarations are of invalid
+// type, have no source position, and are shared by the undeclared identifiers
+// of the same name within a scope.
+func IsUndeclared(decl ast.Decl) bool {
+	name := decl.Name()
+	return name != nil && !name.Start().IsValid() && types.IsInvalid(decl.Type())
+}
+
+// EnclosingScopes returns a map from the nodes of the given resolved parse tree
+// to their innermost enclosing scope; i.e. the scope of declarations declared
+// by the nodes. The scope defined by a node encloses the children of the node,
+// but not the node itself. The function body shares the scope of the function
+// declaration, and the file node is enclosed by the universe scope.
+func (info *Info) EnclosingScopes(file *ast.File) map[ast.Node]*Scope {
+	enclosing := make(map[ast.Node]*Scope)
+	fileScope, ok := info.Scopes[file]
+	if !ok {
+		return enclosing
+	}
+	scopes := []*Scope{fileScope.Outer}
+	before := func(n ast.Node) (bool, error) {
+		enclosing[n] = scopes[len(scopes)-1]
+		// Enter the scope defined by the node, if any.
+		if scope, ok := info.Scopes[n]; ok {
+			scopes = append(scopes, scope)
+		}
+		return true, nil
+	}
+	after := func(n ast.Node) error {
+		if _, ok := info.Scopes[n]; ok {
+			scopes = scopes[:len(scopes)-1]
+		}
+		return nil
+	}
+	astutil.InspectBeforeAfter(file, before, after)
+	return enclosing
 }
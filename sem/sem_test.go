@@ -4,7 +4,6 @@ import (
 	"io/ioutil"
 	"testing"
 
-	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/gocc/parser"
 	"github.com/mewmew/uc/gocc/scanner"
@@ -25,6 +24,10 @@ func TestCheckValid(t *testing.T) {
 		{path: "../testdata/extra/semantic/tentative-var-def.c"},
 		{path: "../testdata/extra/semantic/variable-sized-array-arg.c"},
 		{path: "../testdata/extra/semantic/nested-function-def.c"},
+		{path: "../testdata/extra/semantic/storage-class.c"},
+		{path: "../testdata/extra/semantic/const-qualifier.c"},
+		{path: "../testdata/extra/semantic/float-types.c"},
+		{path: "../testdata/extra/semantic/multi-declarators.c"},
 	}
 
 	errors.UseColor = false
@@ -48,13 +51,9 @@ func TestCheckValid(t *testing.T) {
 		f := file.(*ast.File)
 
 		if _, err := sem.Check(f); err != nil {
-			if e, ok := err.(*errutil.ErrInfo); ok {
-				// Unwrap errutil error.
-				err = e.Err
-				if e, ok := err.(*errors.Error); ok {
-					// Unwrap semantic error.
-					e.Src = src
-				}
+			if errs, ok := err.(errors.List); ok {
+				// Add input source information to semantic errors.
+				errs.SetSource(src)
 			}
 			t.Errorf("%q: unexpected error: `%v`", g.path, err.Error())
 		}
@@ -68,293 +67,416 @@ func TestCheckError(t *testing.T) {
 	}{
 		{
 			path: "../testdata/quiet/semantic/s02.c",
-			want: `(../testdata/quiet/semantic/s02.c:3) error: missing return at end of non-void function "foo"
+			want: `(../testdata/quiet/semantic/s02.c:3:5) error: missing return at end of non-void function "foo"
   ; }
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se01.c",
-			want: `(../testdata/incorrect/semantic/se01.c:5) error: undeclared identifier "b"
+			want: `(../testdata/incorrect/semantic/se01.c:5:10) error: undeclared identifier "b"
  a = a + b; // Variable 'b' not defined
          ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se02.c",
-			want: `(../testdata/incorrect/semantic/se02.c:5) error: undeclared identifier "foo"
+			want: `(../testdata/incorrect/semantic/se02.c:5:7) error: undeclared identifier "foo"
   a = foo(a); // Function 'foo' not defined
-      ^`,
+      ^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se03.c",
-			want: `(../testdata/incorrect/semantic/se03.c:3) error: undeclared identifier "output"
+			want: `(../testdata/incorrect/semantic/se03.c:3:3) error: undeclared identifier "output"
   output(0); // Procedure 'output' not defined
-  ^`,
+  ^~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se04.c",
-			want: `(../testdata/incorrect/semantic/se04.c:5) error: redefinition of "a" with type "char" instead of "int"
+			want: `(../testdata/incorrect/semantic/se04.c:5:6) error: redefinition of "a" with type "char" instead of "int"
 char a;  // Redeclaration of 'a'
-     ^`,
+     ^
+(../testdata/incorrect/semantic/se04.c:3:5) note: previous declaration of "a"
+int a;
+    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se05.c",
-			want: `(../testdata/incorrect/semantic/se05.c:5) error: redefinition of "a" with type "void(void)" instead of "int"
+			want: `(../testdata/incorrect/semantic/se05.c:5:6) error: redefinition of "a" with type "void(void)" instead of "int"
 void a(void) {  // Attempt to redefine variable 'a'
-     ^`,
+     ^
+(../testdata/incorrect/semantic/se05.c:3:5) note: previous declaration of "a"
+int a;
+    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se06.c",
-			want: `(../testdata/incorrect/semantic/se06.c:7) error: redefinition of "a"
+			want: `(../testdata/incorrect/semantic/se06.c:7:5) error: redefinition of "a"
 int a(int i) {   // Redeclaration of 'a'
+    ^
+(../testdata/incorrect/semantic/se06.c:3:5) note: previous definition of "a"
+int a(int n) {
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se07.c",
-			want: `(../testdata/incorrect/semantic/se07.c:4) error: returning "int" from a function with incompatible result type "void"
+			want: `(../testdata/incorrect/semantic/se07.c:4:10) error: returning "int" from a function with incompatible result type "void"
   return 2 * n; // Attempt to return value from procedure
-         ^`,
+         ^~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se08.c",
-			want: `(../testdata/incorrect/semantic/se08.c:4) error: returning "void" from a function with incompatible result type "int"
+			want: `(../testdata/incorrect/semantic/se08.c:4:3) error: returning "void" from a function with incompatible result type "int"
   return;  // Void return from function
-  ^`,
+  ^~~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se09.c",
-			want: `(../testdata/incorrect/semantic/se09.c:6) error: returning "char[1]" from a function with incompatible result type "int"
+			want: `(../testdata/incorrect/semantic/se09.c:6:15) error: returning "char[1]" from a function with incompatible result type "int"
   else return x;    // Return from function with erroneous type
               ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se10.c",
-			want: `(../testdata/incorrect/semantic/se10.c:6) error: invalid operation: n[2] (type "int" does not support indexing)
+			want: `(../testdata/incorrect/semantic/se10.c:6:4) error: invalid operation: n[2] (type "int" does not support indexing)
   n[2]; // Index an integer
-   ^`,
+  ~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se11.c",
-			want: `(../testdata/incorrect/semantic/se11.c:4) error: cannot assign to "a" of type "int(void)"
+			want: `(../testdata/incorrect/semantic/se11.c:4:5) error: cannot assign to "a" of type "int(void)"
   a = 1; // 'a' is not an lval
-    ^`,
+  ~~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se12.c",
-			want: `(../testdata/incorrect/semantic/se12.c:6) error: cannot call non-function "a" of type "int"
+			want: `(../testdata/incorrect/semantic/se12.c:6:4) error: cannot call non-function "a" of type "int"
   a(2); // 'a' is not a function
-   ^`,
+  ~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se13.c",
-			want: `(../testdata/incorrect/semantic/se13.c:8) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
+			want: `(../testdata/incorrect/semantic/se13.c:8:5) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
   1 + foo(0); // 'foo' does not return a value
-    ^`,
+  ~~^~~~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se14.c",
-			want: `(../testdata/incorrect/semantic/se14.c:12) error: cannot call non-function "f" of type "int"
+			want: `(../testdata/incorrect/semantic/se14.c:12:4) error: cannot call non-function "f" of type "int"
   f(n);  // 'f' refers only to the local variable
-   ^`,
+  ~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se15.c",
-			want: `(../testdata/incorrect/semantic/se15.c:8) error: calling "q" with too few arguments; expected 3, got 2
+			want: `(../testdata/incorrect/semantic/se15.c:8:8) error: calling "q" with too few arguments; expected 3, got 2
   1 + q(1, 3); // Too few arguments to function 'q'
-       ^`,
+      ~^~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se16.c",
-			want: `(../testdata/incorrect/semantic/se16.c:9) error: calling "d" with too many arguments; expected 2, got 3
+			want: `(../testdata/incorrect/semantic/se16.c:9:4) error: calling "d" with too many arguments; expected 2, got 3
   d(1, 2, 3); // Too many arguments to function 'd'
-   ^`,
+  ~^~~~~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se17.c",
-			want: `(../testdata/incorrect/semantic/se17.c:6) error: invalid operation: hello + 1 (type mismatch between "char[5]" and "int")
+			want: `(../testdata/incorrect/semantic/se17.c:6:8) error: invalid operation: hello + 1 (type mismatch between "char[5]" and "int")
   hello+1; //  Attempt to use char array in arithmetic. (legal in C)
-       ^`,
+  ~~~~~^~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se18.c",
-			want: `(../testdata/incorrect/semantic/se18.c:6) error: cannot assign to "a" of type "char[10]"
+			want: `(../testdata/incorrect/semantic/se18.c:6:5) error: cannot assign to "a" of type "char[10]"
   a = 42;   // assign int to array of char
-    ^`,
+  ~~^~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se19.c",
-			want: `(../testdata/incorrect/semantic/se19.c:5) error: invalid operation: a == 42 (type mismatch between "char[10]" and "int")
+			want: `(../testdata/incorrect/semantic/se19.c:5:8) error: invalid operation: a == 42 (type mismatch between "char[10]" and "int")
   if (a==42) ;
-       ^`,
+      ~^~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se20.c",
-			want: `(../testdata/incorrect/semantic/se20.c:7) error: cannot assign to "a" of type "int[10]"
+			want: `(../testdata/incorrect/semantic/se20.c:7:4) error: cannot assign to "a" of type "int[10]"
   a=b;
-   ^`,
+  ~^~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se21.c",
-			want: `(../testdata/incorrect/semantic/se21.c:5) error: returning "char[10]" from a function with incompatible result type "int"
+			want: `(../testdata/incorrect/semantic/se21.c:5:12) error: returning "char[10]" from a function with incompatible result type "int"
     return bv;  //  Return from function with erroneous type
-           ^`,
+           ^~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se22.c",
-			want: `(../testdata/incorrect/semantic/se22.c:6) error: invalid operation: a + 1 (type mismatch between "char[10]" and "int")
+			want: `(../testdata/incorrect/semantic/se22.c:6:4) error: invalid operation: a + 1 (type mismatch between "char[10]" and "int")
   a+1; // Attempt to apply arithmetic to array reference
-   ^`,
+  ~^~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se23.c",
-			want: `(../testdata/incorrect/semantic/se23.c:6) error: invalid operation: b[0] (type "int" does not support indexing)
+			want: `(../testdata/incorrect/semantic/se23.c:6:11) error: invalid operation: b[0] (type "int" does not support indexing)
   return b[0]; //not an array!
-          ^`,
+         ~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se24.c",
-			want: `(../testdata/incorrect/semantic/se24.c:6) error: cannot assign to "b" of type "int[10]"
+			want: `(../testdata/incorrect/semantic/se24.c:6:5) error: cannot assign to "b" of type "int[10]"
   b = a;  // b cannot be assigned
-    ^`,
+  ~~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se25.c",
-			want: `(../testdata/incorrect/semantic/se25.c:4) error: cannot assign to "(1 + 2)" of type "int"
+			want: `(../testdata/incorrect/semantic/se25.c:4:11) error: cannot assign to "(1 + 2)" of type "int"
   (1 + 2) = 3; //No assignment here!
-          ^`,
+  ~~~~~~~~^~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se26.c",
-			want: `(../testdata/incorrect/semantic/se26.c:9) error: calling "f" with incompatible argument type "char[10]" to parameter of type "int[]"
+			want: `(../testdata/incorrect/semantic/se26.c:9:5) error: calling "f" with incompatible argument type "char[10]" to parameter of type "int[]"
   f(a);
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se27.c",
-			want: `(../testdata/incorrect/semantic/se27.c:4) error: returning "int" from a function with incompatible result type "void"
+			want: `(../testdata/incorrect/semantic/se27.c:4:19) error: returning "int" from a function with incompatible result type "void"
   if (1<2) return 2 * n; // Attempt to return value from procedure
-                  ^`,
+                  ^~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se28.c",
-			want: `(../testdata/incorrect/semantic/se28.c:5) error: returning "int" from a function with incompatible result type "void"
+			want: `(../testdata/incorrect/semantic/se28.c:5:16) error: returning "int" from a function with incompatible result type "void"
   else  return 2 * n; // Attempt to return value from procedure
-               ^`,
+               ^~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se29.c",
-			want: `(../testdata/incorrect/semantic/se29.c:4) error: redefinition of "n" with type "char" instead of "int"
+			want: `(../testdata/incorrect/semantic/se29.c:4:8) error: redefinition of "n" with type "char" instead of "int"
   char n;
-       ^`,
+       ^
+(../testdata/incorrect/semantic/se29.c:3:13) note: previous declaration of "n"
+void a (int n) {
+            ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se30.c",
-			want: `(../testdata/incorrect/semantic/se30.c:6) error: cannot assign to "a" (type mismatch between "int" and "int[10]")
+			want: `(../testdata/incorrect/semantic/se30.c:6:4) error: cannot assign to "a" (type mismatch between "int" and "int[10]")
   a=b;
-   ^`,
+  ~^~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se31.c",
-			want: `(../testdata/incorrect/semantic/se31.c:5) error: redefinition of "a" with type "void(void)" instead of "int"
+			want: `(../testdata/incorrect/semantic/se31.c:5:6) error: redefinition of "a" with type "void(void)" instead of "int"
 void a(void);   // Attempt to redefine  'a' as extern
-     ^`,
+     ^
+(../testdata/incorrect/semantic/se31.c:3:5) note: previous declaration of "a"
+int a;
+    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se32.c",
-			want: `(../testdata/incorrect/semantic/se32.c:6) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
+			want: `(../testdata/incorrect/semantic/se32.c:6:5) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
   1 + foo(0); // 'foo' does not return a value
-    ^`,
+  ~~^~~~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se33.c",
-			want: `(../testdata/incorrect/semantic/se33.c:6) error: calling "q" with too few arguments; expected 3, got 2
+			want: `(../testdata/incorrect/semantic/se33.c:6:8) error: calling "q" with too few arguments; expected 3, got 2
   1 + q(1, 3); // Too few arguments to function 'q'
-       ^`,
+      ~^~~~~~`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se34.c",
-			want: `(../testdata/incorrect/semantic/se34.c:6) error: calling "d" with too many arguments; expected 2, got 3
+			want: `(../testdata/incorrect/semantic/se34.c:6:4) error: calling "d" with too many arguments; expected 2, got 3
   d(1, 2, 3); // Too many arguments to function 'd'
-   ^`,
+  ~^~~~~~~~~`,
 		},
 
 		// Extra test cases.
+		{
+			path: "../testdata/extra/semantic/const-arg.c",
+			want: `(../testdata/extra/semantic/const-arg.c:10:4) error: calling "f" with incompatible argument type "const int[4]" to parameter of type "int[]"
+ f(table);
+   ^~~~~`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-assign.c",
+			want: `(../testdata/extra/semantic/const-assign.c:7:4) error: cannot assign to "x" of const-qualified type "const int"
+ x = 42;
+ ~~^~~~
+(../testdata/extra/semantic/const-assign.c:4:11) note: "x" declared const here
+const int x;
+          ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-index-assign.c",
+			want: `(../testdata/extra/semantic/const-index-assign.c:5:7) error: cannot assign to "a[i]" of const-qualified type "const char"
+ a[i] = 'a';
+ ~~~~~^~~~~
+(../testdata/extra/semantic/const-index-assign.c:4:19) note: "a" declared const here
+void f(const char a[], int i) {
+                  ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-redef.c",
+			want: `(../testdata/extra/semantic/const-redef.c:5:5) error: redefinition of "x" with type "int" instead of "const int"
+int x;
+    ^
+(../testdata/extra/semantic/const-redef.c:4:11) note: previous declaration of "x"
+const int x;
+          ^`,
+		},
 		{
 			path: "../testdata/extra/semantic/extra-void-arg.c",
-			want: `(../testdata/extra/semantic/extra-void-arg.c:4) error: "void" must be the only parameter
+			want: `(../testdata/extra/semantic/extra-void-arg.c:4:7) error: "void" must be the only parameter
 void f(int a, void) {
       ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/incompatible-arg-type.c",
-			want: `(../testdata/extra/semantic/incompatible-arg-type.c:10) error: calling "a" with incompatible argument type "int" to parameter of type "int[]"
+			want: `(../testdata/extra/semantic/incompatible-arg-type.c:10:11) error: calling "a" with incompatible argument type "int" to parameter of type "int[]"
  return a(b);
           ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/float-index.c",
+			want: `(../testdata/extra/semantic/float-index.c:7:4) error: invalid array index; expected integer, got "double"
+ x[1.5];
+   ^~~`,
 		},
 		{
 			path: "../testdata/extra/semantic/index-array.c",
-			want: `(../testdata/extra/semantic/index-array.c:7) error: invalid array index; expected integer, got "int[20]"
+			want: `(../testdata/extra/semantic/index-array.c:7:4) error: invalid array index; expected integer, got "int[20]"
  x[y];
    ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/local-var-redef.c",
-			want: `(../testdata/extra/semantic/local-var-redef.c:6) error: redefinition of "x"
+			want: `(../testdata/extra/semantic/local-var-redef.c:6:6) error: redefinition of "x"
+ int x;
+     ^
+(../testdata/extra/semantic/local-var-redef.c:5:6) note: previous definition of "x"
+ int x;
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/multi-declarator-redef.c",
+			want: `(../testdata/extra/semantic/multi-declarator-redef.c:2:12) error: redefinition of "x"
+ int x, y, x;
+           ^
+(../testdata/extra/semantic/multi-declarator-redef.c:2:6) note: previous definition of "x"
+ int x, y, x;
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/multiple-errors.c",
+			want: `(../testdata/extra/semantic/multiple-errors.c:7:4) error: cannot assign to "x" (type mismatch between "int" and "void")
+ x = f(1);
+ ~~^~~~~~
+(../testdata/extra/semantic/multiple-errors.c:8:6) error: undeclared identifier "y"
+ x = y + 1;
+     ^
+(../testdata/extra/semantic/multiple-errors.c:9:4) error: undeclared identifier "y"
+ f(y[2]);
+   ^
+(../testdata/extra/semantic/multiple-errors.c:10:6) error: undeclared identifier "g"
+ x = g(x);
+     ^
+(../testdata/extra/semantic/multiple-errors.c:11:6) error: redefinition of "x"
+ int x;
+     ^
+(../testdata/extra/semantic/multiple-errors.c:6:6) note: previous definition of "x"
  int x;
      ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/missing-return.c",
-			want: `(../testdata/extra/semantic/missing-return.c:10) error: missing return at end of non-void function "f"
+			want: `(../testdata/extra/semantic/missing-return.c:10:1) error: missing return at end of non-void function "f"
 }
 ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/param-redef.c",
-			want: `(../testdata/extra/semantic/param-redef.c:5) error: redefinition of "x"
+			want: `(../testdata/extra/semantic/param-redef.c:5:6) error: redefinition of "x"
+ int x;
+     ^
+(../testdata/extra/semantic/param-redef.c:4:12) note: previous definition of "x"
+void f(int x) {
+           ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/static-follows-non-static.c",
+			want: `(../testdata/extra/semantic/static-follows-non-static.c:6:12) error: static declaration of "f" follows non-static declaration
+static int f(void) {
+           ^
+(../testdata/extra/semantic/static-follows-non-static.c:4:5) note: previous declaration of "f"
+int f(void);
+    ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/non-static-follows-static.c",
+			want: `(../testdata/extra/semantic/non-static-follows-static.c:5:5) error: non-static declaration of "x" follows static declaration
+int x;
+    ^
+(../testdata/extra/semantic/non-static-follows-static.c:4:12) note: previous declaration of "x"
+static int x;
+           ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/extern-follows-local.c",
+			want: `(../testdata/extra/semantic/extern-follows-local.c:6:13) error: extern declaration of "x" follows non-extern declaration
+ extern int x;
+            ^
+(../testdata/extra/semantic/extern-follows-local.c:5:6) note: previous declaration of "x"
  int x;
      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/static-local-func.c",
+			want: `(../testdata/extra/semantic/static-local-func.c:5:2) error: function "g" declared in block scope cannot have static storage-class
+ static int g(void);
+ ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/unnamed-arg.c",
-			want: `(../testdata/extra/semantic/unnamed-arg.c:4) error: parameter name obmitted
+			want: `(../testdata/extra/semantic/unnamed-arg.c:4:8) error: parameter name obmitted
 void f(int) {
-       ^`,
+       ^~~`,
 		},
 		{
 			path: "../testdata/extra/semantic/variable-sized-array.c",
-			want: `(../testdata/extra/semantic/variable-sized-array.c:5) error: array size or initializer missing for "y"
+			want: `(../testdata/extra/semantic/variable-sized-array.c:5:7) error: array size or initializer missing for "y"
  char y[];
-      ^`,
+ ~~~~~^~~`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-array.c",
-			want: `(../testdata/extra/semantic/void-array.c:5) error: invalid element type "void" of array "x"
+			want: `(../testdata/extra/semantic/void-array.c:5:7) error: invalid element type "void" of array "x"
  void x[10];
-      ^`,
+ ~~~~~^~~~~`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-array-arg.c",
-			want: `(../testdata/extra/semantic/void-array-arg.c:4) error: invalid element type "void" of array "x"
+			want: `(../testdata/extra/semantic/void-array-arg.c:4:13) error: invalid element type "void" of array "x"
 void f(void x[]) {
-            ^`,
+       ~~~~~^~~`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-param.c",
-			want: `(../testdata/extra/semantic/void-param.c:4) error: "x" has invalid type "void"
+			want: `(../testdata/extra/semantic/void-param.c:4:13) error: "x" has invalid type "void"
 void f(void x) {
-            ^`,
+       ~~~~~^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-params.c",
-			want: `(../testdata/extra/semantic/void-params.c:4) error: "void" must be the only parameter
+			want: `(../testdata/extra/semantic/void-params.c:4:7) error: "void" must be the only parameter
 void f(void, void) {
       ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-var.c",
-			want: `(../testdata/extra/semantic/void-var.c:5) error: "x" has invalid type "void"
+			want: `(../testdata/extra/semantic/void-var.c:5:7) error: "x" has invalid type "void"
  void x;
-      ^`,
+ ~~~~~^`,
 		},
 	}
 
@@ -380,13 +502,9 @@ void f(void, void) {
 
 		got := ""
 		if _, err := sem.Check(f); err != nil {
-			if e, ok := err.(*errutil.ErrInfo); ok {
-				// Unwrap errutil error.
-				err = e.Err
-				if e, ok := err.(*errors.Error); ok {
-					// Unwrap semantic error.
-					e.Src = src
-				}
+			if errs, ok := err.(errors.List); ok {
+				// Add input source information to semantic errors.
+				errs.SetSource(src)
 			}
 			got = err.Error()
 		}
@@ -397,3 +515,179 @@ void f(void, void) {
 }
 
 // TODO: add benchmark
+
+func TestCheckWarning(t *testing.T) {
+	var golden = []struct {
+		path string
+		// State of the narrowing warning.
+		state errors.WarningState
+		want  string
+	}{
+		{
+			path:  "../testdata/extra/semantic/narrowing.c",
+			state: errors.WarningOn,
+			want: `(../testdata/extra/semantic/narrowing.c:2:9) warning: implicit conversion from "double" to "float" may lose precision [-W narrowing]
+ return x / 2.0;
+        ^~~~~~~
+(../testdata/extra/semantic/narrowing.c:12:6) warning: implicit conversion from "int" to "char" may lose precision [-W narrowing]
+ c = 300;
+     ^~~
+(../testdata/extra/semantic/narrowing.c:14:6) warning: implicit conversion from "float" to "int" may lose precision [-W narrowing]
+ i = f;
+     ^
+(../testdata/extra/semantic/narrowing.c:18:6) warning: implicit conversion from "int" to "float" may lose precision [-W narrowing]
+ f = i;
+     ^
+(../testdata/extra/semantic/narrowing.c:21:6) warning: implicit conversion from "int" to "float" may lose precision [-W narrowing]
+ f = 16777217;
+     ^~~~~~~~`,
+		},
+		{
+			path:  "../testdata/extra/semantic/narrowing.c",
+			state: errors.WarningOff,
+			want:  "",
+		},
+		{
+			path:  "../testdata/extra/semantic/narrowing.c",
+			state: errors.WarningError,
+			want: `(../testdata/extra/semantic/narrowing.c:2:9) error: implicit conversion from "double" to "float" may lose precision [-W error=narrowing]
+ return x / 2.0;
+        ^~~~~~~
+(../testdata/extra/semantic/narrowing.c:12:6) error: implicit conversion from "int" to "char" may lose precision [-W error=narrowing]
+ c = 300;
+     ^~~
+(../testdata/extra/semantic/narrowing.c:14:6) error: implicit conversion from "float" to "int" may lose precision [-W error=narrowing]
+ i = f;
+     ^
+(../testdata/extra/semantic/narrowing.c:18:6) error: implicit conversion from "int" to "float" may lose precision [-W error=narrowing]
+ f = i;
+     ^
+(../testdata/extra/semantic/narrowing.c:21:6) error: implicit conversion from "int" to "float" may lose precision [-W error=narrowing]
+ f = 16777217;
+     ^~~~~~~~`,
+		},
+	}
+
+	errors.UseColor = false
+
+	for _, g := range golden {
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		input := string(buf)
+		s := scanner.NewFromString(input)
+		src := errors.NewSource(g.path, input)
+
+		p := parser.NewParser()
+		file, err := p.Parse(s)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		f := file.(*ast.File)
+
+		warnings := errors.NewWarningConfig()
+		warnings[errors.Narrowing] = g.state
+		info, err := sem.CheckWarnings(f, warnings)
+		if g.state == errors.WarningError {
+			if err == nil {
+				t.Errorf("%q: expected error, got nil", g.path)
+				continue
+			}
+		} else if err != nil {
+			t.Errorf("%q: unexpected error: `%v`", g.path, err)
+			continue
+		}
+		info.Diagnostics.SetSource(src)
+		got := info.Diagnostics.Error()
+		if got != g.want {
+			t.Errorf("%q: diagnostics mismatch; expected `%v`, got `%v`", g.path, g.want, got)
+		}
+	}
+}
+
+func TestEnclosingScopes(t *testing.T) {
+	const input = `int x;
+int f(int a) {
+	int y;
+	{
+		int z;
+	}
+	return a;
+}
+`
+	file, err := parser.NewParser().Parse(scanner.NewFromString(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := file.(*ast.File)
+	info, err := sem.Check(f)
+	if err != nil {
+		t.Fatalf("unable to check input; %v", err)
+	}
+	scopes := info.EnclosingScopes(f)
+	fn := f.Decls[1].(*ast.FuncDecl)
+	block := fn.Body.Items[1].(*ast.BlockStmt)
+	golden := []struct {
+		n    ast.Node
+		want *sem.Scope
+	}{
+		{n: f, want: info.Scopes[f].Outer},
+		{n: f.Decls[0], want: info.Scopes[f]},
+		{n: fn, want: info.Scopes[f]},
+		{n: fn.FuncType.Params[0], want: info.Scopes[fn]},
+		{n: fn.Body, want: info.Scopes[fn]},
+		{n: fn.Body.Items[0], want: info.Scopes[fn]},
+		{n: block, want: info.Scopes[fn]},
+		{n: block.Items[0], want: info.Scopes[block]},
+	}
+	for i, g := range golden {
+		if got := scopes[g.n]; got != g.want {
+			t.Errorf("%d: scope mismatch of %v; expected %p, got %p", i, g.n, g.want, got)
+		}
+	}
+}
+
+func TestUndeclared(t *testing.T) {
+	const input = `int f(void) {
+	y = 1;
+	y = 2;
+	{
+		y = 3;
+	}
+	return 0;
+}
+`
+	file, err := parser.NewParser().Parse(scanner.NewFromString(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := file.(*ast.File)
+	if _, err := sem.Check(f); err == nil {
+		t.Fatal("expected undeclared identifier errors")
+	}
+	fn := f.Decls[0].(*ast.FuncDecl)
+	// ident returns the left-hand side identifier of the given assignment
+	// statement.
+	ident := func(item ast.BlockItem) *ast.Ident {
+		return item.(*ast.ExprStmt).X.(*ast.BinaryExpr).X.(*ast.Ident)
+	}
+	block := fn.Body.Items[2].(*ast.BlockStmt)
+	y1, y2, y3 := ident(fn.Body.Items[0]), ident(fn.Body.Items[1]), ident(block.Items[0])
+	for _, y := range []*ast.Ident{y1, y2, y3} {
+		if !sem.IsUndeclared(y.Decl) {
+			t.Errorf("%v at %d: expected placeholder declaration, got %#v", y, y.Start(), y.Decl)
+		}
+		if pos := y.Decl.Name().Start(); pos.IsValid() {
+			t.Errorf("%v at %d: expected placeholder declaration without position, got %d", y, y.Start(), pos)
+		}
+	}
+	if y1.Decl != y2.Decl {
+		t.Errorf("expected undeclared identifiers of the same scope to share placeholder declaration")
+	}
+	if y1.Decl == y3.Decl {
+		t.Errorf("expected undeclared identifiers of different scopes to have distinct placeholder declarations")
+	}
+}
@@ -11,14 +11,15 @@ import (
 // NoNestedFunctions disables the checking for nested functions
 var NoNestedFunctions = false
 
-// Check performs static semantic analysis on the given file.
-func Check(file *ast.File) error {
+// Check performs static semantic analysis on the given file, and records the
+// diagnostics encountered in diags.
+func Check(file *ast.File, diags *errors.List) error {
 	for _, decl := range file.Decls {
 		switch decl := decl.(type) {
 		case *ast.FuncDecl:
 			// Check for nested functions.
 			if NoNestedFunctions {
-				if err := checkNestedFunctions(decl); err != nil {
+				if err := checkNestedFunctions(decl, diags); err != nil {
 					return errutil.Err(err)
 				}
 			}
@@ -27,20 +28,23 @@ func Check(file *ast.File) error {
 	return nil
 }
 
-// checkNestedFunctions reports an error if the given function contains any
-// nested function definitions.
-func checkNestedFunctions(fn *ast.FuncDecl) error {
+// checkNestedFunctions records an error in diags for each nested function
+// definition of the given function.
+func checkNestedFunctions(fn *ast.FuncDecl, diags *errors.List) error {
 	if !astutil.IsDef(fn) {
 		return nil
 	}
-	check := func(n ast.Node) error {
-		if n, ok := n.(*ast.FuncDecl); ok {
-			return errors.Newf(n.FuncName.Start(), "nested functions not allowed")
+	check := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			diags.Add(errors.Newf(n.FuncName.Start(), "nested functions not allowed").Range(n.FuncName.Start(), n.FuncName.End()))
+		case ast.Expr:
+			// Expressions contain no function declarations.
+			return false, nil
 		}
-		return nil
+		return true, nil
 	}
-	nop := func(ast.Node) error { return nil }
-	if err := astutil.WalkBeforeAfter(fn.Body, check, nop); err != nil {
+	if err := astutil.Inspect(fn.Body, check); err != nil {
 		return errutil.Err(err)
 	}
 	return nil
@@ -2,6 +2,7 @@ package typecheck
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
@@ -12,14 +13,20 @@ import (
 )
 
 // deduce performs type deduction of expressions, and store the result in
-// exprTypes.
-func deduce(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+// exprTypes. Expressions which fail to type-check are given the invalid type,
+// and the corresponding diagnostics are recorded in diags.
+func deduce(file *ast.File, exprTypes map[ast.Expr]types.Type, diags *errors.List) error {
 	// deduce performs type deduction of the given expression.
 	deduce := func(n ast.Node) error {
 		if expr, ok := n.(ast.Expr); ok {
-			typ, err := typeOf(expr)
+			typ, err := typeOf(expr, exprTypes)
 			if err != nil {
-				return errutil.Err(err)
+				e, ok := err.(*errors.Diagnostic)
+				if !ok {
+					return errutil.Err(err)
+				}
+				diags.Add(e)
+				typ = &types.Basic{Kind: types.Invalid}
 			}
 			exprTypes[expr] = typ
 		}
@@ -34,8 +41,12 @@ func deduce(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 	return nil
 }
 
-// typeOf returns the type of the given expression.
-func typeOf(n ast.Expr) (types.Type, error) {
+// typeOf returns the type of the given expression, based on the previously
+// deduced types of its subexpressions.
+//
+// To prevent cascading errors, expressions with subexpressions of invalid type
+// are given the invalid type without reporting an error.
+func typeOf(n ast.Expr, exprTypes map[ast.Expr]types.Type) (types.Type, error) {
 	switch n := n.(type) {
 	case *ast.BasicLit:
 		// "The type of an integer constant is the first of the corresponding
@@ -46,35 +57,52 @@ func typeOf(n ast.Expr) (types.Type, error) {
 			return &types.Basic{Kind: types.Int}, nil
 		case token.IntLit:
 			return &types.Basic{Kind: types.Int}, nil
+		case token.FloatLit:
+			// "An unsuffixed floating constant has type double. If suffixed by the
+			// letter f or F, it has type float." [C99 draft 6.4.4.2.4]
+			if strings.HasSuffix(n.Val, "f") || strings.HasSuffix(n.Val, "F") {
+				return &types.Basic{Kind: types.Float}, nil
+			}
+			return &types.Basic{Kind: types.Double}, nil
 		default:
 			panic(fmt.Sprintf("support for basic literal type %v not yet implemented", n.Kind))
 		}
 	case *ast.BinaryExpr:
 		// See [C99 draft 6.3.1.8 Usual arithmetic conversions]
-		xType, err := typeOf(n.X)
-		if err != nil {
-			return nil, errutil.Err(err)
-		}
-		yType, err := typeOf(n.Y)
-		if err != nil {
-			return nil, errutil.Err(err)
+		xType, yType := exprTypes[n.X], exprTypes[n.Y]
+		if types.IsInvalid(xType) || types.IsInvalid(yType) {
+			return &types.Basic{Kind: types.Invalid}, nil
 		}
 		if n.Op == token.Assign {
 			if !isAssignable(n.X) {
-				return nil, errors.Newf(n.OpPos, "cannot assign to %q of type %q", n.X, xType)
+				if decl := constDecl(n.X); decl != nil {
+					return nil, errors.Newf(n.OpPos, "cannot assign to %q of const-qualified type %q", n.X, xType).Range(n.Start(), n.End()).Notef(decl.Name().Start(), "%q declared const here", decl.Name())
+				}
+				return nil, errors.Newf(n.OpPos, "cannot assign to %q of type %q", n.X, xType).Range(n.Start(), n.End())
 			}
 			if !isCompatible(xType, yType) {
-				return nil, errors.Newf(n.OpPos, "cannot assign to %q (type mismatch between %q and %q)", n.X, xType, yType)
+				return nil, errors.Newf(n.OpPos, "cannot assign to %q (type mismatch between %q and %q)", n.X, xType, yType).Range(n.Start(), n.End())
 			}
-			// TODO: !types.Equal(higherPrecision(xType, yType), xType) could be
-			// used for loss of percision warning.
+			// NOTE: Implicit conversions which may lose precision are reported
+			// by checkNarrowing during type-checking.
 			return xType, nil
 		}
 		if types.IsVoid(xType) || types.IsVoid(yType) {
-			return nil, errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+			return nil, errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType).Range(n.Start(), n.End())
 		}
 		if !isCompatible(xType, yType) {
-			return nil, errors.Newf(n.OpPos, "invalid operation: %v (type mismatch between %q and %q)", n, xType, yType)
+			return nil, errors.Newf(n.OpPos, "invalid operation: %v (type mismatch between %q and %q)", n, xType, yType).Range(n.Start(), n.End())
+		}
+		switch n.Op {
+		case token.Lt, token.Gt, token.Le, token.Ge, token.Eq, token.Ne, token.Land:
+			// "Each of the operators < (less than), > (greater than), <= (less
+			// than or equal to), and >= (greater than or equal to) shall yield 1
+			// if the specified relation is true and 0 if it is false. The result
+			// has type int." [C99 draft 6.5.8.6]
+			//
+			// The same holds for the equality operators == and != [C99 draft
+			// 6.5.9.3], and the logical AND operator && [C99 draft 6.5.13.3].
+			return &types.Basic{Kind: types.Int}, nil
 		}
 		// TODO: Implement better implicit conversion. Future: Make sure to
 		// promote types early when implementing signed/unsigned types and
@@ -87,7 +115,10 @@ func typeOf(n ast.Expr) (types.Type, error) {
 		if typ, ok := typ.(*types.Func); ok {
 			return typ.Result, nil
 		}
-		return nil, errors.Newf(n.Lparen, "cannot call non-function %q of type %q", n.Name, typ)
+		if types.IsInvalid(typ) {
+			return typ, nil
+		}
+		return nil, errors.Newf(n.Lparen, "cannot call non-function %q of type %q", n.Name, typ).Range(n.Start(), n.End())
 	case *ast.Ident:
 		return n.Decl.Type(), nil
 	case *ast.IndexExpr:
@@ -95,12 +126,22 @@ func typeOf(n ast.Expr) (types.Type, error) {
 		if typ, ok := typ.(*types.Array); ok {
 			return typ.Elem, nil
 		}
-		return nil, errors.Newf(n.Lbracket, "invalid operation: %v (type %q does not support indexing)", n, typ)
+		if types.IsInvalid(typ) {
+			return typ, nil
+		}
+		return nil, errors.Newf(n.Lbracket, "invalid operation: %v (type %q does not support indexing)", n, typ).Range(n.Start(), n.End())
 	case *ast.ParenExpr:
-		return typeOf(n.X)
+		return exprTypes[n.X], nil
 	case *ast.UnaryExpr:
 		// TODO: Add support for pointers.
-		return typeOf(n.X)
+		xType := exprTypes[n.X]
+		if n.Op == token.Not && !types.IsInvalid(xType) {
+			// "The result of the logical negation operator ! is 0 if the value of
+			// its operand compares unequal to 0, 1 if the value of its operand
+			// compares equal to 0. The result has type int." [C99 draft 6.5.3.3.5]
+			return &types.Basic{Kind: types.Int}, nil
+		}
+		return xType, nil
 	default:
 		panic(fmt.Sprintf("support for type %T not yet implemented.", n))
 	}
@@ -109,8 +150,9 @@ func typeOf(n ast.Expr) (types.Type, error) {
 // TODO: Verify isAssignable against the definition of lvalue in the C spec (I
 // tried and failed).
 
-// isAssignable reports whether the given expression is assignable (i.e. a valid
-// lvalue). See [C99 draft 6.3.2.1 Lvalues, arrays, and function designators]
+// isAssignable reports whether the given expression is assignable (i.e. a
+// modifiable lvalue). See [C99 draft 6.3.2.1 Lvalues, arrays, and function
+// designators]
 func isAssignable(x ast.Expr) bool {
 	switch x := x.(type) {
 	case *ast.BasicLit:
@@ -122,7 +164,10 @@ func isAssignable(x ast.Expr) bool {
 	case *ast.Ident:
 		switch typ := x.Decl.Type().(type) {
 		case *types.Basic:
-			return true
+			// "A modifiable lvalue is an lvalue that does not have array type,
+			// does not have an incomplete type, does not have a const-qualified
+			// type" [C99 draft 6.3.2.1.1]
+			return !typ.Const
 		case *types.Array:
 			return false
 		case *types.Func:
@@ -131,6 +176,9 @@ func isAssignable(x ast.Expr) bool {
 			panic(fmt.Sprintf("support for declaration type %T not yet implemented", typ))
 		}
 	case *ast.IndexExpr:
+		if typ, ok := x.Name.Decl.Type().(*types.Array); ok {
+			return !types.IsConst(typ.Elem)
+		}
 		return true
 	case *ast.ParenExpr:
 		return isAssignable(x.X)
@@ -142,6 +190,24 @@ func isAssignable(x ast.Expr) bool {
 	}
 }
 
+// constDecl returns the declaration of the const-qualified object designated by
+// the given lvalue expression, or nil if the lvalue is not const-qualified.
+func constDecl(x ast.Expr) ast.Decl {
+	switch x := x.(type) {
+	case *ast.Ident:
+		if typ, ok := x.Decl.Type().(*types.Basic); ok && typ.Const {
+			return x.Decl
+		}
+	case *ast.IndexExpr:
+		if typ, ok := x.Name.Decl.Type().(*types.Array); ok && types.IsConst(typ.Elem) {
+			return x.Name.Decl
+		}
+	case *ast.ParenExpr:
+		return constDecl(x.X)
+	}
+	return nil
+}
+
 // higherPrecision returns the type of higher precision.
 func higherPrecision(t, u types.Type) types.Type {
 	// TODO: Implement with a list of types sorted by precision when support
@@ -153,6 +219,12 @@ func higherPrecision(t, u types.Type) types.Type {
 				panic(fmt.Sprint(`incorrect use of higherPrecision; "void" does not have precision.`))
 			}
 			// Check for types in order of highest precision.
+			if t.Kind == types.Double || u.Kind == types.Double {
+				return &types.Basic{Kind: types.Double}
+			}
+			if t.Kind == types.Float || u.Kind == types.Float {
+				return &types.Basic{Kind: types.Float}
+			}
 			if t.Kind == types.Int || u.Kind == types.Int {
 				return &types.Basic{Kind: types.Int}
 			}
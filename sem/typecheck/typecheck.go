@@ -3,32 +3,36 @@ package typecheck
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // Check type-checks the given file, and store a mapping from expression nodes
-// to types in exprTypes.
-func Check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+// to types in exprTypes. Type-checking continues past errors, and the
+// diagnostics encountered are recorded in diags.
+func Check(file *ast.File, exprTypes map[ast.Expr]types.Type, diags *errors.List) error {
 	// Deduce the types of expressions.
-	if err := deduce(file, exprTypes); err != nil {
+	if err := deduce(file, exprTypes, diags); err != nil {
 		return errutil.Err(err)
 	}
 
 	// Type-check file.
-	if err := check(file, exprTypes); err != nil {
+	if err := check(file, exprTypes, diags); err != nil {
 		return errutil.Err(err)
 	}
 
 	return nil
 }
 
-// check type-checks the given file.
-func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+// check type-checks the given file, and records the diagnostics in diags.
+func check(file *ast.File, exprTypes map[ast.Expr]types.Type, diags *errors.List) error {
 	// funcs is a stack of function declarations, where the top-most entry
 	// represents the currently active function.
 	var funcs []*types.Func
@@ -37,15 +41,15 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 	check := func(n ast.Node) error {
 		switch n := n.(type) {
 		case *ast.BlockStmt:
-			// Verify that array declarations have an explicit size or an
+			// Verify that array definitions have an explicit size or an
 			// initializer.
 			for _, item := range n.Items {
 				switch item := item.(type) {
 				case *ast.VarDecl:
 					typ := item.Type()
 					if typ, ok := typ.(*types.Array); ok {
-						if typ.Len == 0 && item.Val == nil {
-							return errors.Newf(item.VarName.NamePos, "array size or initializer missing for %q", item.VarName)
+						if typ.Len == 0 && item.Val == nil && item.Storage != ast.Extern {
+							diags.Add(errors.Newf(item.VarName.NamePos, "array size or initializer missing for %q", item.VarName).Range(item.Start(), item.End()))
 						}
 					}
 				}
@@ -61,11 +65,11 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 			// using *ast.Ident, which failed since "void" refers to itself as a
 			// VarDecl, whos types is "void".
 			if n.VarName != nil && types.IsVoid(typ) {
-				return errors.Newf(n.VarName.NamePos, `%q has invalid type "void"`, n.VarName)
+				diags.Add(errors.Newf(n.VarName.NamePos, `%q has invalid type "void"`, n.VarName).Range(n.Start(), n.End()))
 			}
 			if typ, ok := typ.(*types.Array); ok {
 				if types.IsVoid(typ.Elem) {
-					return errors.Newf(n.VarName.NamePos, `invalid element type "void" of array %q`, n.VarName)
+					diags.Add(errors.Newf(n.VarName.NamePos, `invalid element type "void" of array %q`, n.VarName).Range(n.Start(), n.End()))
 				}
 			}
 		case *ast.FuncDecl:
@@ -77,12 +81,12 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				// definitions.
 				for _, param := range n.FuncType.Params {
 					if !types.IsVoid(param.Type()) && param.VarName == nil {
-						return errors.Newf(param.VarType.Start(), "parameter name obmitted")
+						diags.Add(errors.Newf(param.VarType.Start(), "parameter name obmitted").Range(param.Start(), param.End()))
 					}
 				}
 
 				// Verify that non-void functions end with return statement.
-				if !types.IsVoid(n.Type().(*types.Func).Result) {
+				if result := n.Type().(*types.Func).Result; !types.IsVoid(result) && !types.IsInvalid(result) {
 					// endsWithReturn reports whether the given block item ends with
 					// a return statement. The following two terminating statements
 					// are supported, recursively.
@@ -128,7 +132,7 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 					// NOTE: "reaching the } that terminates the main function
 					// returns a value of 0." (see §5.1.2.2.3 in the C11 spec)
 					if missing && n.FuncName.String() != "main" {
-						return errors.Newf(n.Body.Rbrace, "missing return at end of non-void function %q", n.FuncName)
+						diags.Add(errors.Newf(n.Body.Rbrace, "missing return at end of non-void function %q", n.FuncName))
 					}
 				}
 			}
@@ -141,16 +145,20 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				resultType = exprTypes[n.Result]
 			}
 			if !isCompatible(resultType, curFunc.Result) {
-				resultPos := n.Start()
+				var result ast.Node = n
 				if n.Result != nil {
-					resultPos = n.Result.Start()
+					result = n.Result
 				}
-				return errors.Newf(resultPos, "returning %q from a function with incompatible result type %q", resultType, curFunc.Result)
+				diags.Add(errors.Newf(result.Start(), "returning %q from a function with incompatible result type %q", resultType, curFunc.Result).Range(result.Start(), result.End()))
+			} else if n.Result != nil {
+				checkNarrowing(n.Result, resultType, curFunc.Result, diags)
 			}
 		case *ast.CallExpr:
+			// Calls to non-functions have already been reported during type
+			// deduction.
 			funcType, ok := n.Name.Decl.Type().(*types.Func)
 			if !ok {
-				return errors.Newf(n.Lparen, "cannot call non-function %q of type %q", n.Name, funcType)
+				return nil
 			}
 			// TODO: Implement support for functions with variable arguments (i.e.
 			// ellipsis).
@@ -162,10 +170,12 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 
 			// Check number of arguments.
 			if len(n.Args) < len(funcType.Params) {
-				return errors.Newf(n.Lparen, "calling %q with too few arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args))
+				diags.Add(errors.Newf(n.Lparen, "calling %q with too few arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args)).Range(n.Start(), n.End()))
+				return nil
 			}
 			if len(n.Args) > len(funcType.Params) {
-				return errors.Newf(n.Lparen, "calling %q with too many arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args))
+				diags.Add(errors.Newf(n.Lparen, "calling %q with too many arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args)).Range(n.Start(), n.End()))
+				return nil
 			}
 
 			// Check that call argument types match the function parameter types.
@@ -174,14 +184,21 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				argType := exprTypes[arg]
 				paramType := param.Type
 				if !isCompatibleArg(argType, paramType) {
-					return errors.Newf(arg.Start(), "calling %q with incompatible argument type %q to parameter of type %q", n.Name, argType, paramType)
+					diags.Add(errors.Newf(arg.Start(), "calling %q with incompatible argument type %q to parameter of type %q", n.Name, argType, paramType).Range(arg.Start(), arg.End()))
+				} else {
+					checkNarrowing(arg, argType, paramType, diags)
 				}
 			}
+		case *ast.BinaryExpr:
+			if n.Op == token.Assign {
+				checkNarrowing(n.Y, exprTypes[n.Y], exprTypes[n.X], diags)
+			}
 		case *ast.FuncType:
 			for _, param := range n.Params {
 				paramType := param.Type()
 				if len(n.Params) > 1 && types.IsVoid(paramType) {
-					return errors.Newf(n.Lparen, `"void" must be the only parameter`)
+					diags.Add(errors.Newf(n.Lparen, `"void" must be the only parameter`))
+					break
 				}
 			}
 		case *ast.IndexExpr:
@@ -189,8 +206,8 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 			if !ok {
 				panic(fmt.Sprintf("unable to locate type of expression %v", n.Index))
 			}
-			if !types.IsInteger(indexType) {
-				return errors.Newf(n.Index.Start(), "invalid array index; expected integer, got %q", indexType)
+			if !types.IsInteger(indexType) && !types.IsInvalid(indexType) {
+				diags.Add(errors.Newf(n.Index.Start(), "invalid array index; expected integer, got %q", indexType).Range(n.Index.Start(), n.Index.End()))
 			}
 		default:
 			// TODO: Implement type-checking for remaining node types.
@@ -232,17 +249,25 @@ func isCompatibleArg(arg, param types.Type) bool {
 			if param.Len != 0 {
 				return false
 			}
-			return types.Equal(arg.Elem, param.Elem)
+			// The element type of the parameter may add but not drop qualifiers
+			// of the element type of the argument (see §6.5.16.1.1).
+			if types.IsConst(arg.Elem) && !types.IsConst(param.Elem) {
+				return false
+			}
+			return types.Equal(types.Unqualified(arg.Elem), types.Unqualified(param.Elem))
 		}
 	}
 	return false
 }
 
-// isCompatible reports whether t and u are of compatible types.
+// isCompatible reports whether t and u are of compatible types. The invalid
+// type is compatible with any type, to prevent cascading errors.
 func isCompatible(t, u types.Type) bool {
-	if types.Equal(t, u) {
+	if types.Equal(t, u) || types.IsInvalid(t) || types.IsInvalid(u) {
 		return true
 	}
+	// Qualifiers of numerical types are ignored, as the values are converted to
+	// the unqualified type of the destination (see §6.5.16.1.2).
 	if t, ok := t.(types.Numerical); ok {
 		if u, ok := u.(types.Numerical); ok {
 			// TODO: Check for other compatible types (e.g. pointers, array names,
@@ -252,3 +277,101 @@ func isCompatible(t, u types.Type) bool {
 	}
 	return false
 }
+
+// checkNarrowing records a warning in diags if the implicit conversion of the
+// given expression from type t to type u may lose precision (e.g. from "double"
+// to "int", or from "int" to "float"). Constant expressions representable in
+// type u are exempt.
+func checkNarrowing(x ast.Expr, t, u types.Type, diags *errors.List) {
+	from, ok := t.(*types.Basic)
+	if !ok {
+		return
+	}
+	to, ok := u.(*types.Basic)
+	if !ok {
+		return
+	}
+	if precision(from.Kind) == 0 || precision(to.Kind) == 0 {
+		return
+	}
+	// The 24-bit significand of float cannot represent every 32-bit int value.
+	intToFloat := from.Kind == types.Int && to.Kind == types.Float
+	if (precision(to.Kind) >= precision(from.Kind) && !intToFloat) || isRepresentable(x, to) {
+		return
+	}
+	diags.Add(errors.Warningf(x.Start(), errors.Narrowing, "implicit conversion from %q to %q may lose precision", from, to).Range(x.Start(), x.End()))
+}
+
+// precision returns the precision rank of the given basic type kind, or 0 if
+// the type kind is not numerical.
+func precision(kind types.BasicKind) int {
+	switch kind {
+	case types.Char:
+		return 1
+	case types.Int:
+		return 2
+	case types.Float:
+		return 3
+	case types.Double:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// isRepresentable reports whether the given expression is an integer or
+// floating-point constant, which may be represented by the given type; i.e. an
+// integer constant within the range of an integer type or exactly representable
+// by a floating-point type, or a floating-point constant converted to a
+// floating-point type.
+func isRepresentable(x ast.Expr, typ *types.Basic) bool {
+	// Determine the sign of the constant.
+	neg := false
+	for {
+		if paren, ok := x.(*ast.ParenExpr); ok {
+			x = paren.X
+			continue
+		}
+		if unary, ok := x.(*ast.UnaryExpr); ok && unary.Op == token.Sub {
+			neg = !neg
+			x = unary.X
+			continue
+		}
+		break
+	}
+	lit, ok := x.(*ast.BasicLit)
+	if !ok {
+		return false
+	}
+	switch lit.Kind {
+	case token.CharLit:
+		// Character literals are within the range of all integer types, and
+		// exactly representable by all floating-point types.
+		return types.IsInteger(typ) || types.IsFloat(typ)
+	case token.IntLit:
+		val, err := strconv.ParseInt(lit.Val, 10, 64)
+		if err != nil {
+			return false
+		}
+		if neg {
+			val = -val
+		}
+		switch typ.Kind {
+		case types.Char:
+			return math.MinInt8 <= val && val <= math.MaxInt8
+		case types.Int:
+			return math.MinInt32 <= val && val <= math.MaxInt32
+		case types.Float:
+			// Integers of magnitude up to 2^24 are exactly representable by the
+			// 24-bit significand of float.
+			return -1<<24 <= val && val <= 1<<24
+		case types.Double:
+			return true
+		}
+		return false
+	case token.FloatLit:
+		return types.IsFloat(typ)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,11 @@
+const int x;
+const int table[4];
+extern const int y;
+
+int f(const int a[], int i) {
+	return a[i];
+}
+
+int main(void) {
+	return x + y + f(table, 2);
+}
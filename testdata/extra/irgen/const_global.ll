@@ -0,0 +1,27 @@
+@x = constant i32 0
+@table = constant [4 x i32] zeroinitializer
+@y = external constant i32
+
+define i32 @f(i32* %a, i32 %i) {
+0:
+	%1 = alloca i32*
+	store i32* %a, i32** %1
+	%2 = alloca i32
+	store i32 %i, i32* %2
+	%3 = load i32, i32* %2
+	%4 = sext i32 %3 to i64
+	%5 = load i32*, i32** %1
+	%6 = getelementptr i32, i32* %5, i64 %4
+	%7 = load i32, i32* %6
+	ret i32 %7
+}
+
+define i32 @main() {
+0:
+	%1 = load i32, i32* @x
+	%2 = load i32, i32* @y
+	%3 = add i32 %1, %2
+	%4 = call i32 @f(i32* getelementptr ([4 x i32], [4 x i32]* @table, i64 0, i64 0), i32 2)
+	%5 = add i32 %3, %4
+	ret i32 %5
+}
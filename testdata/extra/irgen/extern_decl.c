@@ -0,0 +1,6 @@
+extern int x;
+
+int main(void) {
+	extern int y;
+	return x + y;
+}
@@ -0,0 +1,10 @@
+@x = external global i32
+@y = external global i32
+
+define i32 @main() {
+0:
+	%1 = load i32, i32* @x
+	%2 = load i32, i32* @y
+	%3 = add i32 %1, %2
+	ret i32 %3
+}
@@ -0,0 +1,19 @@
+void putfloat(float x);
+
+double scale(double x, float y) {
+	return x * y + 0.5;
+}
+
+int main(void) {
+	float f;
+	double d;
+	int i;
+	f = 1.5f;
+	d = scale(f, 2.25f) / 3;
+	i = d;
+	if (d >= 1e2 && !f) {
+		d = -d;
+	}
+	putfloat(d - i);
+	return i;
+}
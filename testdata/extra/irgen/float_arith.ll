@@ -0,0 +1,61 @@
+declare void @putfloat(float %x)
+
+define double @scale(double %x, float %y) {
+0:
+	%1 = alloca double
+	store double %x, double* %1
+	%2 = alloca float
+	store float %y, float* %2
+	%3 = load double, double* %1
+	%4 = load float, float* %2
+	%5 = fpext float %4 to double
+	%6 = fmul double %3, %5
+	%7 = fadd double %6, 0.5
+	ret double %7
+}
+
+define i32 @main() {
+0:
+	%f = alloca float
+	%d = alloca double
+	%i = alloca i32
+	store float 1.5, float* %f
+	%1 = load float, float* %f
+	%2 = fpext float %1 to double
+	%3 = call double @scale(double %2, float 2.25)
+	%4 = fdiv double %3, 3.0
+	store double %4, double* %d
+	%5 = load double, double* %d
+	%6 = fptosi double %5 to i32
+	store i32 %6, i32* %i
+	%7 = load double, double* %d
+	%8 = fcmp oge double %7, 100.0
+	br i1 %8, label %9, label %14
+
+9:
+	%10 = load float, float* %f
+	%11 = fcmp oeq float %10, 0.0
+	%12 = zext i1 %11 to i32
+	%13 = icmp ne i32 %12, 0
+	br label %14
+
+14:
+	%15 = phi i1 [ false, %0 ], [ %13, %9 ]
+	br i1 %15, label %16, label %19
+
+16:
+	%17 = load double, double* %d
+	%18 = fneg double %17
+	store double %18, double* %d
+	br label %19
+
+19:
+	%20 = load double, double* %d
+	%21 = load i32, i32* %i
+	%22 = sitofp i32 %21 to double
+	%23 = fsub double %20, %22
+	%24 = fptrunc double %23 to float
+	call void @putfloat(float %24)
+	%25 = load i32, i32* %i
+	ret i32 %25
+}
@@ -0,0 +1,9 @@
+float g;
+double h;
+
+int main(void) {
+	g = 1e400;
+	h = -1e400;
+	g = 1e39;
+	return 0;
+}
@@ -0,0 +1,11 @@
+@g = global float 0.0
+@h = global double 0.0
+
+define i32 @main() {
+0:
+	store float 0x7FF0000000000000, float* @g
+	%1 = fneg double 0x7FF0000000000000
+	store double %1, double* @h
+	store float 0x7FF0000000000000, float* @g
+	ret i32 0
+}
@@ -0,0 +1,11 @@
+char c;
+int i;
+
+int main(void) {
+	c = 65.9;
+	c = 1000.5;
+	i = 3.99;
+	i = 4294967297.5;
+	i = 1e400;
+	return 0;
+}
@@ -0,0 +1,12 @@
+@c = global i8 0
+@i = global i32 0
+
+define i32 @main() {
+0:
+	store i8 65, i8* @c
+	store i8 -24, i8* @c
+	store i32 3, i32* @i
+	store i32 1, i32* @i
+	store i32 u0x7FFFFFFF, i32* @i
+	ret i32 0
+}
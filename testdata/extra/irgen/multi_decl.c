@@ -0,0 +1,10 @@
+int x, y[10];
+
+int main(void) {
+	char a, b[4];
+	a = 'a';
+	b[1] = a;
+	y[2] = b[1];
+	x = y[2];
+	return x;
+}
@@ -0,0 +1,20 @@
+@x = global i32 0
+@y = global [10 x i32] zeroinitializer
+
+define i32 @main() {
+0:
+	%a = alloca i8
+	%b = alloca [4 x i8]
+	store i8 97, i8* %a
+	%1 = load i8, i8* %a
+	%2 = getelementptr [4 x i8], [4 x i8]* %b, i64 0, i64 1
+	store i8 %1, i8* %2
+	%3 = getelementptr [4 x i8], [4 x i8]* %b, i64 0, i64 1
+	%4 = load i8, i8* %3
+	%5 = sext i8 %4 to i32
+	store i32 %5, i32* getelementptr ([10 x i32], [10 x i32]* @y, i64 0, i64 2)
+	%6 = load i32, i32* getelementptr ([10 x i32], [10 x i32]* @y, i64 0, i64 2)
+	store i32 %6, i32* @x
+	%7 = load i32, i32* @x
+	ret i32 %7
+}
@@ -0,0 +1,12 @@
+static int x;
+
+static int f(void);
+
+int main(void) {
+	x = f();
+	return x;
+}
+
+int f(void) {
+	return 42;
+}
@@ -0,0 +1,14 @@
+@x = internal global i32 0
+
+define i32 @main() {
+0:
+	%1 = call i32 @f()
+	store i32 %1, i32* @x
+	%2 = load i32, i32* @x
+	ret i32 %2
+}
+
+define internal i32 @f() {
+0:
+	ret i32 42
+}
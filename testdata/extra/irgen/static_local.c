@@ -0,0 +1,10 @@
+int next(void) {
+	static int n;
+	n = n + 1;
+	if (n > 10) {
+		static int n;
+		n = 1;
+		return n;
+	}
+	return n;
+}
@@ -0,0 +1,21 @@
+@next.n = internal global i32 0
+@next.n.1 = internal global i32 0
+
+define i32 @next() {
+0:
+	%1 = load i32, i32* @next.n
+	%2 = add i32 %1, 1
+	store i32 %2, i32* @next.n
+	%3 = load i32, i32* @next.n
+	%4 = icmp sgt i32 %3, 10
+	br i1 %4, label %5, label %7
+
+5:
+	store i32 1, i32* @next.n.1
+	%6 = load i32, i32* @next.n.1
+	ret i32 %6
+
+7:
+	%8 = load i32, i32* @next.n
+	ret i32 %8
+}
@@ -0,0 +1,2 @@
+// Floating-point literals.
+1.5 2. .25 1e10 2.5E-3 3.14f 1e+2F 42 .x 1e;
@@ -0,0 +1,22 @@
+/* File comment; separated by a blank line. */
+
+// x is documented
+// by a comment group.
+int x; // Trailing comment.
+int y, z; // Trailing comment of y.
+
+/* Block comment doc. */
+typedef int myint;
+
+/* Not a doc comment. */ int w;
+
+// f is documented.
+int f(void) {
+	// a is documented.
+	int a;
+
+	// Not a doc comment.
+
+	a = 1;
+	return a;
+}
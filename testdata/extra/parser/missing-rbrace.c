@@ -0,0 +1,9 @@
+int x;
+
+int f(void) {
+	return x;
+}
+
+int main(void) {
+	x = f();
+	return x;
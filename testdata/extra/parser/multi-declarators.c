@@ -0,0 +1,6 @@
+// Multiple declarators per declaration.
+int x, y[10];
+
+void f(void) {
+	char a, b[4];
+}
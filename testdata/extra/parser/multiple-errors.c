@@ -0,0 +1,19 @@
+int f(int a) {
+	a = (a + ) * a;
+	return a;
+}
+
+int g(void) {
+	return 1
+}
+
+int x y;
+
+int h(int b) {
+	b = b +;
+	return b;
+}
+
+int main(void) {
+	return f(1) + g() + h(2);
+}
@@ -0,0 +1,31 @@
+// Irregular indentation, spacing and brace style.
+typedef   int   myint ;
+extern int   a,b[10],c [ ];
+static char buf[128]; // buffer
+int putchar(int c) ;
+
+
+// add returns the sum of x and y.
+int add(int x,int y){return x+y;}
+static int f ( const int n , char s[] )
+{
+int i;int j , k[3];
+
+  i=0;
+  while(i<n)
+  {
+      if (s[i]=='a') { j = - -n; } else if (!(s[i] == 0) && i >= 2)
+        k[0] = add(i ,j); // k[0] is updated.
+      else
+      return -1;
+    i=i+1;
+    /* Trailing block comment. */
+  }
+  while (i) ;
+  if (i) if (j) i = 1 ; else i = 2;
+  {}
+  { /* Empty block. */ }
+  return;
+}
+int main(void){f(3, buf);}
+// End of file.
@@ -0,0 +1,11 @@
+// Passing const-qualified array to parameter without const-qualifier
+//
+//    calling "F" with incompatible argument type "const int[4]" to parameter of type "int[]"
+const int table[4];
+
+void f(int a[]) {
+}
+
+void g(void) {
+	f(table);
+}
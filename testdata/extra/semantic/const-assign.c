@@ -0,0 +1,8 @@
+// Assignment to const-qualified variable
+//
+//    cannot assign to "X" of const-qualified type "const int"
+const int x;
+
+void f(void) {
+	x = 42;
+}
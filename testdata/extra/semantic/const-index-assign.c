@@ -0,0 +1,6 @@
+// Assignment to element of const-qualified array
+//
+//    cannot assign to "X[i]" of const-qualified type "const char"
+void f(const char a[], int i) {
+	a[i] = 'a';
+}
@@ -0,0 +1,32 @@
+// Valid use of const-qualified types.
+const int x;
+const char table[16];
+
+typedef const int cint;
+
+int sum(const char a[], int n) {
+	int s;
+	int i;
+	s = 0;
+	i = 0;
+	while (i < n) {
+		s = s + a[i];
+		i = i + 1;
+	}
+	return s;
+}
+
+// Top-level qualifiers of parameters do not affect the function type.
+int id(const int a);
+
+int id(int a) {
+	return a;
+}
+
+int main(void) {
+	char buf[16];
+	cint c;
+	int y;
+	y = x + c;
+	return sum(table, 16) + sum(buf, 16) + id(y);
+}
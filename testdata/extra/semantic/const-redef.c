@@ -0,0 +1,5 @@
+// Redeclaration with different qualifiers
+//
+//    redefinition of "X" with type "int" instead of "const int"
+const int x;
+int x;
@@ -0,0 +1,7 @@
+// Extern declaration following local variable declaration
+//
+//    extern declaration of "X" follows non-extern declaration
+void f(void) {
+	int x;
+	extern int x;
+}
@@ -0,0 +1,8 @@
+// Floating-point array index
+//
+//    invalid array index; expected integer, got "double"
+int x[10];
+
+void f(void) {
+	x[1.5];
+}
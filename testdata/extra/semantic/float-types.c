@@ -0,0 +1,23 @@
+// Valid use of floating-point types.
+float f;
+double d;
+
+double avg(double a[], int n) {
+	double sum;
+	int i;
+	sum = 0.0;
+	i = 0;
+	while (i < n) {
+		sum = sum + a[i];
+		i = i + 1;
+	}
+	return sum / n;
+}
+
+int main(void) {
+	double xs[4];
+	f = 1.5f;
+	d = f * 2 + .5e1;
+	xs[0] = d;
+	return avg(xs, 4) > 1.0;
+}
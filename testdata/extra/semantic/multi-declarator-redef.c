@@ -0,0 +1,4 @@
+int main(void) {
+	int x, y, x;
+	return 0;
+}
@@ -0,0 +1,9 @@
+extern int a, b[];
+static char c, d[3];
+const int e, f;
+
+int main(void) {
+	int x, y[2], z;
+	x = y[0] + z + a + b[1] + c + d[2] + e + f;
+	return x;
+}
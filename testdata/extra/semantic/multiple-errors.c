@@ -0,0 +1,13 @@
+void f(int a) {
+	;
+}
+
+int main(void) {
+	int x;
+	x = f(1);
+	x = y + 1;
+	f(y[2]);
+	x = g(x);
+	int x;
+	return x;
+}
@@ -0,0 +1,25 @@
+float half(double x) {
+	return x / 2.0;
+}
+
+int main(void) {
+	char c;
+	int i;
+	float f;
+	double d;
+	c = 'a';
+	c = -128;
+	c = 300;
+	i = c;
+	i = f;
+	f = 1.5;
+	f = half(i);
+	i = !f;
+	f = i;
+	f = c;
+	f = 16777216;
+	f = 16777217;
+	i = d < 1.0;
+	i = f > 0.5 && f < 2.0;
+	return i;
+}
@@ -0,0 +1,5 @@
+// Non-static declaration following static declaration
+//
+//    non-static declaration of "X" follows static declaration
+static int x;
+int x;
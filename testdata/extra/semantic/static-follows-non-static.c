@@ -0,0 +1,8 @@
+// Static declaration following non-static declaration
+//
+//    static declaration of "X" follows non-static declaration
+int f(void);
+
+static int f(void) {
+	return 0;
+}
@@ -0,0 +1,6 @@
+// Block-scope function declaration with static storage-class
+//
+//    function "X" declared in block scope cannot have static storage-class
+void f(void) {
+	static int g(void);
+}
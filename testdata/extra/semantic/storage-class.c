@@ -0,0 +1,19 @@
+// Valid storage-class specifiers and linkage.
+static int x;
+extern int x;
+static int x;
+
+extern int y;
+int y;
+extern int y;
+
+static int f(void);
+extern int f(void);
+
+int f(void) {
+	extern int x;
+	extern int z;
+	static int n;
+	extern char buf[];
+	return x + y + z + n;
+}
@@ -0,0 +1,5 @@
+int main(void) {
+  int x;
+  x = 1 @ 2; // Not OK
+  return x;
+}
@@ -18,3 +18,7 @@ int getint(void){
 int getstring(char s[]) {
 	return scanf("%s", s);
 }
+
+void putfloat(float x) {
+	printf("%g", x);
+}
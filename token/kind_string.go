@@ -4,9 +4,9 @@ package token
 
 import "fmt"
 
-const _Kind_name = "EOFErrorCommentliteralStartIdentIntLitCharLitliteralEndoperatorStartAddSubMulDivAssignEqNeLtLeGtGeLandNotLparenRparenLbracketRbracketLbraceRbraceCommaSemicolonoperatorEndkeywordStartKwElseKwIfKwReturnKwWhilekeywordEnd"
+const _Kind_name = "EOFErrorCommentliteralStartIdentIntLitFloatLitCharLitliteralEndoperatorStartAddSubMulDivAssignEqNeLtLeGtGeLandNotLparenRparenLbracketRbracketLbraceRbraceCommaSemicolonoperatorEndkeywordStartKwConstKwElseKwExternKwIfKwReturnKwStaticKwTypedefKwWhilekeywordEnd"
 
-var _Kind_index = [...]uint8{0, 3, 8, 15, 27, 32, 38, 45, 55, 68, 71, 74, 77, 80, 86, 88, 90, 92, 94, 96, 98, 102, 105, 111, 117, 125, 133, 139, 145, 150, 159, 170, 182, 188, 192, 200, 207, 217}
+var _Kind_index = [...]uint16{0, 3, 8, 15, 27, 32, 38, 46, 53, 63, 76, 79, 82, 85, 88, 94, 96, 98, 100, 102, 104, 106, 110, 113, 119, 125, 133, 141, 147, 153, 158, 167, 178, 190, 197, 203, 211, 215, 223, 231, 240, 247, 257}
 
 func (kind Kind) GoString() string {
 	if kind >= Kind(len(_Kind_index)-1) {
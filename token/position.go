@@ -0,0 +1,275 @@
+package token
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Pos is a compact encoding of a source position within a file set. It can be
+// converted into a Position for a more convenient, but much larger,
+// representation.
+//
+// The Pos value of a byte offset within a file is the sum of the base of the
+// file and the offset. As the first file of a file set has base 0, the
+// positions of a single source file coincide with byte offsets.
+type Pos int
+
+// NoPos is the zero value of positions which are not part of any file; e.g.
+// the positions of predeclared identifiers.
+const NoPos Pos = -1
+
+// IsValid reports whether the position is valid.
+func (p Pos) IsValid() bool {
+	return p != NoPos
+}
+
+// A Position describes a source position, including the file, line and column
+// location.
+type Position struct {
+	// File name; or empty if unknown.
+	Filename string
+	// Byte offset within the file, starting at 0.
+	Offset int
+	// Line number, starting at 1.
+	Line int
+	// Column number (in bytes), starting at 1.
+	Column int
+}
+
+// IsValid reports whether the position is valid.
+func (pos Position) IsValid() bool {
+	return pos.Line > 0
+}
+
+// String returns a string in one of several forms.
+//
+//    file:line:column   valid position with file name
+//    line:column        valid position without file name
+//    file               invalid position with file name
+//    -                  invalid position without file name
+func (pos Position) String() string {
+	s := pos.Filename
+	if pos.IsValid() {
+		if s != "" {
+			s += ":"
+		}
+		s += fmt.Sprintf("%d:%d", pos.Line, pos.Column)
+	}
+	if s == "" {
+		s = "-"
+	}
+	return s
+}
+
+// A File is a handle for a file belonging to a file set. A file has a name,
+// size and line table.
+type File struct {
+	// File name.
+	name string
+	// Pos value range for this file is [base, base+size].
+	base int
+	// File size in bytes.
+	size int
+	// Offsets of the first byte of each line; the first entry is always 0.
+	lines []int
+}
+
+// NewFile returns a new file of the given name and size, with base 0, which
+// does not belong to any file set.
+func NewFile(name string, size int) *File {
+	return &File{name: name, size: size, lines: []int{0}}
+}
+
+// Name returns the file name of the file.
+func (f *File) Name() string {
+	return f.name
+}
+
+// Base returns the base of the file.
+func (f *File) Base() int {
+	return f.base
+}
+
+// Size returns the size of the file.
+func (f *File) Size() int {
+	return f.size
+}
+
+// LineCount returns the number of lines of the file.
+func (f *File) LineCount() int {
+	return len(f.lines)
+}
+
+// Lines returns the line table of the file; i.e. the offsets of the first byte
+// of each line. The line table must not be modified.
+func (f *File) Lines() []int {
+	return f.lines
+}
+
+// SetLines sets the line table of the file, and reports whether it succeeded.
+// The line table consists of the offsets of the first byte of each line, which
+// must be in increasing order and smaller than the file size, starting at 0.
+// The line table is shared with the caller, and must not be modified
+// afterwards.
+func (f *File) SetLines(lines []int) bool {
+	for i, offset := range lines {
+		if (i == 0 && offset != 0) || (i > 0 && offset <= lines[i-1]) || (offset > 0 && offset >= f.size) {
+			return false
+		}
+	}
+	if len(lines) == 0 {
+		lines = []int{0}
+	}
+	f.lines = lines
+	return true
+}
+
+// SetLinesForContent sets the line table of the file based on the given file
+// content.
+func (f *File) SetLinesForContent(content []byte) {
+	lines := []int{0}
+	for offset, b := range content {
+		if b == '\n' && offset+1 < len(content) {
+			lines = append(lines, offset+1)
+		}
+	}
+	f.lines = lines
+}
+
+// Pos returns the Pos value of the given file offset, which must be in the
+// range [0, f.Size()].
+func (f *File) Pos(offset int) Pos {
+	if offset < 0 || offset > f.size {
+		panic(fmt.Sprintf("invalid file offset %d of %q; expected offset in range [0, %d]", offset, f.name, f.size))
+	}
+	return Pos(f.base + offset)
+}
+
+// Offset returns the file offset of the given position, which must be in the
+// range [f.Base(), f.Base()+f.Size()].
+func (f *File) Offset(p Pos) int {
+	offset := int(p) - f.base
+	if offset < 0 || offset > f.size {
+		panic(fmt.Sprintf("invalid position %d of %q; expected position in range [%d, %d]", p, f.name, f.base, f.base+f.size))
+	}
+	return offset
+}
+
+// Line returns the line number of the given position.
+func (f *File) Line(p Pos) int {
+	return f.Position(p).Line
+}
+
+// Position returns the source position of the given position, which must be
+// in the range [f.Base(), f.Base()+f.Size()]. The zero Position is returned
+// for NoPos.
+func (f *File) Position(p Pos) Position {
+	if !p.IsValid() {
+		return Position{}
+	}
+	offset := f.Offset(p)
+	// Implemented using binary search, as lines are sorted in ascending order.
+	index := sort.SearchInts(f.lines, offset+1) - 1
+	return Position{
+		Filename: f.name,
+		Offset:   offset,
+		Line:     index + 1,
+		Column:   offset - f.lines[index] + 1,
+	}
+}
+
+// A FileSet represents a set of source files. The files of a file set occupy
+// disjoint position ranges, so that a position identifies both the file and
+// the offset within the file.
+//
+// Methods of file sets are safe for concurrent use.
+type FileSet struct {
+	// Mutex protecting the file set.
+	mu sync.RWMutex
+	// Base of the next file.
+	base int
+	// Files of the file set, in order of increasing base.
+	files []*File
+}
+
+// NewFileSet returns a new file set.
+func NewFileSet() *FileSet {
+	return &FileSet{}
+}
+
+// Base returns the minimum base that must be provided to AddFile when adding
+// the next file.
+func (s *FileSet) Base() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.base
+}
+
+// AddFile adds a new file of the given name and size to the file set, and
+// returns it. If base is negative, the current base of the file set is used;
+// otherwise base must not be smaller than Base.
+//
+// The positions of the file are in the range [base, base+size]. The base of the
+// file set is advanced past the file, to base+size+1, so that the end of file
+// position of the file is distinct from the positions of the next file.
+func (s *FileSet) AddFile(filename string, base, size int) *File {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if base < 0 {
+		base = s.base
+	}
+	if base < s.base {
+		panic(fmt.Sprintf("invalid base %d of %q; expected base >= %d", base, filename, s.base))
+	}
+	if size < 0 {
+		panic(fmt.Sprintf("invalid size %d of %q; expected size >= 0", size, filename))
+	}
+	f := &File{name: filename, base: base, size: size, lines: []int{0}}
+	s.files = append(s.files, f)
+	s.base = base + size + 1
+	return f
+}
+
+// File returns the file containing the given position; or nil if not found.
+func (s *FileSet) File(p Pos) *File {
+	if !p.IsValid() {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i := sort.Search(len(s.files), func(i int) bool {
+		return s.files[i].base > int(p)
+	}) - 1
+	if i < 0 {
+		return nil
+	}
+	f := s.files[i]
+	if int(p) > f.base+f.size {
+		return nil
+	}
+	return f
+}
+
+// Position returns the source position of the given position; or the zero
+// Position if not part of the file set.
+func (s *FileSet) Position(p Pos) Position {
+	if f := s.File(p); f != nil {
+		return f.Position(p)
+	}
+	return Position{}
+}
+
+// Iterate calls f for the files of the file set in the order they were added
+// until f returns false.
+func (s *FileSet) Iterate(f func(*File) bool) {
+	s.mu.RLock()
+	files := make([]*File, len(s.files))
+	copy(files, s.files)
+	s.mu.RUnlock()
+	for _, file := range files {
+		if !f(file) {
+			break
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package token
+
+import "testing"
+
+func TestFileSetPosition(t *testing.T) {
+	fset := NewFileSet()
+	a := fset.AddFile("a.c", -1, 8)
+	a.SetLinesForContent([]byte("int x;\n\n"))
+	b := fset.AddFile("b.c", -1, 13)
+	b.SetLinesForContent([]byte("int y;\nint z;"))
+	c := fset.AddFile("c.c", -1, 0)
+
+	golden := []struct {
+		pos  Pos
+		want string
+	}{
+		// a.c
+		{pos: a.Pos(0), want: "a.c:1:1"},
+		{pos: a.Pos(4), want: "a.c:1:5"},
+		{pos: a.Pos(6), want: "a.c:1:7"},
+		{pos: a.Pos(7), want: "a.c:2:1"},
+		// End of file.
+		{pos: a.Pos(8), want: "a.c:2:2"},
+		// b.c
+		{pos: b.Pos(0), want: "b.c:1:1"},
+		{pos: b.Pos(11), want: "b.c:2:5"},
+		{pos: b.Pos(13), want: "b.c:2:7"},
+		// c.c (empty file)
+		{pos: c.Pos(0), want: "c.c:1:1"},
+		// Invalid positions.
+		{pos: NoPos, want: "-"},
+		{pos: Pos(c.Base() + 1), want: "-"},
+	}
+	for _, g := range golden {
+		if got := fset.Position(g.pos).String(); got != g.want {
+			t.Errorf("position mismatch of %d; expected %q, got %q", g.pos, g.want, got)
+		}
+	}
+
+	// Verify that the files occupy disjoint position ranges.
+	if got, want := b.Base(), a.Base()+a.Size()+1; got != want {
+		t.Errorf("base mismatch of b.c; expected %d, got %d", want, got)
+	}
+	if got := fset.File(a.Pos(8)); got != a {
+		t.Errorf("file mismatch of end of a.c; expected a.c, got %v", got)
+	}
+	if got := b.Offset(b.Pos(11)); got != 11 {
+		t.Errorf("offset mismatch; expected 11, got %d", got)
+	}
+}
+
+func TestFileSetLines(t *testing.T) {
+	f := NewFile("a.c", 10)
+	golden := []struct {
+		lines []int
+		want  bool
+	}{
+		{lines: []int{0}, want: true},
+		{lines: []int{0, 3, 7}, want: true},
+		{lines: []int{1, 3}, want: false},
+		{lines: []int{0, 3, 3}, want: false},
+		{lines: []int{0, 10}, want: false},
+	}
+	for _, g := range golden {
+		if got := f.SetLines(g.lines); got != g.want {
+			t.Errorf("SetLines(%v) mismatch; expected %v, got %v", g.lines, g.want, got)
+		}
+	}
+	// Positions of files without file set coincide with byte offsets. Invalid
+	// line tables are ignored.
+	if got, want := f.Position(Pos(4)).String(), "a.c:2:2"; got != want {
+		t.Errorf("position mismatch; expected %q, got %q", want, got)
+	}
+}
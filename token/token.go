@@ -11,7 +11,7 @@ type Token struct {
 	// The string value of the token.
 	Val string
 	// Start position in the input string.
-	Pos int
+	Pos Pos
 }
 
 func (tok Token) String() string {
@@ -35,9 +35,10 @@ const (
 	literalStart
 
 	// Identifiers and basic literals.
-	Ident   // main (also includes type names)
-	IntLit  // 123
-	CharLit // 'a', '\n'
+	Ident    // main (also includes type names)
+	IntLit   // 123
+	FloatLit // 1.5, 2.0e-3, 3.14f
+	CharLit  // 'a', '\n'
 
 	literalEnd
 
@@ -71,9 +72,12 @@ const (
 	keywordStart
 
 	// Keywords.
+	KwConst   // const
 	KwElse    // else
+	KwExtern  // extern
 	KwIf      // if
 	KwReturn  // return
+	KwStatic  // static
 	KwTypedef // typedef
 	KwWhile   // while
 
@@ -87,6 +91,7 @@ func (kind Kind) String() string {
 		Comment:   "comment",
 		Ident:     "identifier",
 		IntLit:    "integer literal",
+		FloatLit:  "floating-point literal",
 		CharLit:   "character literal",
 		Add:       "+",
 		Sub:       "-",
@@ -109,9 +114,12 @@ func (kind Kind) String() string {
 		Rbrace:    "}",
 		Comma:     ",",
 		Semicolon: ";",
+		KwConst:   "const",
 		KwElse:    "else",
+		KwExtern:  "extern",
 		KwIf:      "if",
 		KwReturn:  "return",
+		KwStatic:  "static",
 		KwTypedef: "typedef",
 		KwWhile:   "while",
 	}
@@ -135,9 +143,12 @@ func (kind Kind) IsOperator() bool {
 
 // Keywords is the set of valid keywords in the µC programming language.
 var Keywords = map[string]Kind{
+	"const":   KwConst,
 	"else":    KwElse,
+	"extern":  KwExtern,
 	"if":      KwIf,
 	"return":  KwReturn,
+	"static":  KwStatic,
 	"typedef": KwTypedef,
 	"while":   KwWhile,
 }
@@ -32,9 +32,13 @@ type (
 	//
 	//    char
 	//    int
+	//    double
+	//    const int
 	Basic struct {
 		// Kind of basic type.
 		Kind BasicKind
+		// Const specifies whether the type is const-qualified (see §6.7.3).
+		Const bool
 	}
 
 	// An Array represents an array type.
@@ -43,6 +47,7 @@ type (
 	//
 	//    int[]
 	//    char[128]
+	//    const int[10]
 	Array struct {
 		// Element type.
 		Elem Type
@@ -74,9 +79,11 @@ type BasicKind int
 const (
 	Invalid BasicKind = iota // invalid type
 
-	Char // "char"
-	Int  // "int"
-	Void // "void"
+	Char   // "char"
+	Int    // "int"
+	Float  // "float"
+	Double // "double"
+	Void   // "void"
 )
 
 // A Field represents a field declaration in a struct type, or a parameter
@@ -94,16 +101,57 @@ type Field struct {
 }
 
 func (field *Field) String() string {
-	if len(field.Name) > 0 {
-		return fmt.Sprintf("%v %v", field.Type, field.Name)
+	return Decl(field.Name, field.Type)
+}
+
+// Decl returns the declaration of an identifier of the given name and type,
+// using the declarator syntax of C; e.g.
+//
+//    int x
+//    char buf[128]
+//    int add(int a, int b)
+//    int puts(char s[])
+//
+// An abstract declarator (e.g. "char[128]") is returned if the name is empty.
+func Decl(name string, t Type) string {
+	return declarator(t, name)
+}
+
+// declarator returns the declaration of the given declarator of type t.
+func declarator(t Type, d string) string {
+	switch t := t.(type) {
+	case *Array:
+		if t.Len > 0 {
+			return declarator(t.Elem, fmt.Sprintf("%s[%d]", d, t.Len))
+		}
+		return declarator(t.Elem, d+"[]")
+	case *Func:
+		buf := new(bytes.Buffer)
+		buf.WriteString(d)
+		buf.WriteString("(")
+		if len(t.Params) == 0 {
+			buf.WriteString("void")
+		}
+		for i, param := range t.Params {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(param.String())
+		}
+		buf.WriteString(")")
+		return declarator(t.Result, buf.String())
+	default:
+		if len(d) == 0 || d[0] == '[' || d[0] == '(' {
+			return t.String() + d
+		}
+		return t.String() + " " + d
 	}
-	return field.Type.String()
 }
 
 // Equal reports whether t and u are of equal type.
 func (t *Basic) Equal(u Type) bool {
 	if u, ok := u.(*Basic); ok {
-		return t.Kind == u.Kind
+		return t.Kind == u.Kind && t.Const == u.Const
 	}
 	return false
 }
@@ -140,6 +188,53 @@ func Equal(t, u Type) bool {
 	return t.Equal(u)
 }
 
+// NewConst returns a const-qualified version of the given type. The elements of
+// const-qualified arrays are const-qualified (see §6.7.3.8).
+func NewConst(t Type) Type {
+	switch t := t.(type) {
+	case *Basic:
+		return &Basic{Kind: t.Kind, Const: true}
+	case *Array:
+		return &Array{Elem: NewConst(t.Elem), Len: t.Len}
+	default:
+		panic(fmt.Sprintf("support for const-qualified type %T not yet implemented", t))
+	}
+}
+
+// Unqualified returns the unqualified version of the given type.
+func Unqualified(t Type) Type {
+	switch t := t.(type) {
+	case *Basic:
+		return &Basic{Kind: t.Kind}
+	case *Array:
+		return &Array{Elem: Unqualified(t.Elem), Len: t.Len}
+	default:
+		return t
+	}
+}
+
+// IsConst reports whether the given type is const-qualified. Arrays are
+// const-qualified if their elements are const-qualified.
+func IsConst(t Type) bool {
+	switch t := t.(type) {
+	case *Basic:
+		return t.Const
+	case *Array:
+		return IsConst(t.Elem)
+	default:
+		return false
+	}
+}
+
+// IsInvalid reports whether the given type is invalid (e.g. the type of an
+// undeclared identifier).
+func IsInvalid(t Type) bool {
+	if t, ok := t.(*Basic); ok {
+		return t.Kind == Invalid
+	}
+	return false
+}
+
 // IsVoid reports whether the given type is a void type.
 func IsVoid(t Type) bool {
 	if t, ok := t.(*Basic); ok {
@@ -160,12 +255,24 @@ func IsInteger(t Type) bool {
 	return false
 }
 
+// IsFloat reports whether the given type is a floating-point type (i.e.
+// "float" or "double").
+func IsFloat(t Type) bool {
+	if t, ok := t.(*Basic); ok {
+		switch t.Kind {
+		case Float, Double:
+			return true
+		}
+	}
+	return false
+}
+
 // IsNumerical reports whether the given type is numerical.
 func (t *Basic) IsNumerical() bool {
 	switch t.Kind {
-	case Int, Char:
+	case Int, Char, Float, Double:
 		return true
-	case Void:
+	case Invalid, Void:
 		return false
 	default:
 		panic(fmt.Sprintf("types.Basic.IsNumerical: unknown basic type (%d)", int(t.Kind)))
@@ -174,11 +281,17 @@ func (t *Basic) IsNumerical() bool {
 
 func (t *Basic) String() string {
 	names := map[BasicKind]string{
-		Char: "char",
-		Int:  "int",
-		Void: "void",
+		Invalid: "invalid type",
+		Char:    "char",
+		Int:     "int",
+		Float:   "float",
+		Double:  "double",
+		Void:    "void",
 	}
 	if s, ok := names[t.Kind]; ok {
+		if t.Const {
+			return "const " + s
+		}
 		return s
 	}
 	return fmt.Sprintf("unknown basic type (%d)", int(t.Kind))
@@ -192,13 +305,7 @@ func (t *Array) String() string {
 }
 
 func (t *Func) String() string {
-	buf := new(bytes.Buffer)
-	fmt.Fprintf(buf, "%v(", t.Result)
-	for _, param := range t.Params {
-		buf.WriteString(param.String())
-	}
-	buf.WriteString(")")
-	return buf.String()
+	return Decl("", t)
 }
 
 // Verify that the µC types implement the Type interface.
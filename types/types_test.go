@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/mewmew/uc/types"
+)
+
+func TestDecl(t *testing.T) {
+	var (
+		intType   = &types.Basic{Kind: types.Int}
+		charType  = &types.Basic{Kind: types.Char}
+		voidType  = &types.Basic{Kind: types.Void}
+		constChar = &types.Basic{Kind: types.Char, Const: true}
+	)
+	golden := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{name: "x", typ: intType, want: "int x"},
+		{name: "", typ: intType, want: "int"},
+		{name: "buf", typ: &types.Array{Elem: charType, Len: 128}, want: "char buf[128]"},
+		{name: "", typ: &types.Array{Elem: charType, Len: 128}, want: "char[128]"},
+		{name: "s", typ: &types.Array{Elem: constChar}, want: "const char s[]"},
+		{
+			name: "add",
+			typ:  &types.Func{Result: intType, Params: []*types.Field{{Type: intType, Name: "a"}, {Type: intType, Name: "b"}}},
+			want: "int add(int a, int b)",
+		},
+		{
+			name: "puts",
+			typ:  &types.Func{Result: intType, Params: []*types.Field{{Type: &types.Array{Elem: charType}, Name: "s"}}},
+			want: "int puts(char s[])",
+		},
+		{
+			name: "",
+			typ:  &types.Func{Result: intType, Params: []*types.Field{{Type: &types.Array{Elem: charType, Len: 10}}}},
+			want: "int(char[10])",
+		},
+		{
+			name: "f",
+			typ:  &types.Func{Result: voidType, Params: []*types.Field{{Type: voidType}}},
+			want: "void f(void)",
+		},
+		{name: "g", typ: &types.Func{Result: intType}, want: "int g(void)"},
+	}
+	for _, g := range golden {
+		got := types.Decl(g.name, g.typ)
+		if got != g.want {
+			t.Errorf("%q: declaration mismatch; expected %q, got %q", g.name, g.want, got)
+		}
+	}
+}
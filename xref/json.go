@@ -0,0 +1,80 @@
+package xref
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// jsonEntry is the JSON encoding of an entry.
+type jsonEntry struct {
+	// Name of the symbol.
+	Name string `json:"name"`
+	// Qualified name of the symbol.
+	QualName string `json:"qualifiedName"`
+	// Qualified name of the enclosing function; or empty if none.
+	Scope string `json:"scope,omitempty"`
+	// Kind of the symbol (e.g. "parameter").
+	Kind string `json:"kind"`
+	// Role of the entry (e.g. "definition").
+	Role string `json:"role"`
+	// Type of the symbol.
+	Type string `json:"type"`
+	// Input source path.
+	Path string `json:"path"`
+	// Line number, starting at 1.
+	Line int `json:"line"`
+	// Column number, starting at 1.
+	Column int `json:"column"`
+	// Byte offset within the input source.
+	Offset int `json:"offset"`
+}
+
+// WriteJSON writes the entries of the given files to w, as a JSON array of
+// objects indented by three spaces; e.g.
+//
+//    [
+//       {
+//          "name": "a",
+//          "qualifiedName": "f.a",
+//          "scope": "f",
+//          "kind": "parameter",
+//          "role": "definition",
+//          "type": "int",
+//          "path": "foo.c",
+//          "line": 3,
+//          "column": 11,
+//          "offset": 10
+//       }
+//    ]
+func WriteJSON(w io.Writer, files []*File) error {
+	entries := []*jsonEntry{}
+	for _, f := range files {
+		for _, entry := range f.Entries {
+			pos := entry.Ident.Start()
+			line, col := f.Src.Position(pos)
+			entries = append(entries, &jsonEntry{
+				Name:     entry.Name,
+				QualName: entry.QualName,
+				Scope:    entry.Scope,
+				Kind:     entry.Kind.String(),
+				Role:     entry.Role.String(),
+				Type:     entry.Decl.Type().String(),
+				Path:     f.Src.Path,
+				Line:     line,
+				Column:   col,
+				Offset:   int(pos) - f.Src.File.Base(),
+			})
+		}
+	}
+	buf, err := json.MarshalIndent(entries, "", "   ")
+	if err != nil {
+		return errutil.Err(err)
+	}
+	buf = append(buf, '\n')
+	if _, err := w.Write(buf); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
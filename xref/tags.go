@@ -0,0 +1,138 @@
+package xref
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/sem"
+)
+
+// A tag is a tags file entry of a declaration or definition.
+type tag struct {
+	// Tag name.
+	name string
+	// Entry of the tag.
+	entry *Entry
+	// Input source path.
+	path string
+	// Line number, starting at 1.
+	line int
+	// Byte offset of the start of the line.
+	lineStart int
+	// Content of the line, excluding the line terminator.
+	text string
+}
+
+// tags returns the tags of the declarations and definitions of the given file.
+// Symbols with qualified names are tagged by both their name and qualified
+// name.
+func (f *File) tags() []*tag {
+	var tags []*tag
+	for _, entry := range f.Entries {
+		if entry.Role == Use {
+			continue
+		}
+		line, _ := f.Src.Position(entry.Ident.Start())
+		lineStart := f.Src.Lines[line-1]
+		lineEnd := len(f.Src.Input)
+		if line < len(f.Src.Lines) {
+			lineEnd = f.Src.Lines[line]
+		}
+		t := &tag{
+			name:      entry.Name,
+			entry:     entry,
+			path:      f.Src.Path,
+			line:      line,
+			lineStart: lineStart,
+			text:      strings.TrimRight(f.Src.Input[lineStart:lineEnd], "\r\n"),
+		}
+		tags = append(tags, t)
+		if entry.QualName != entry.Name {
+			qual := *t
+			qual.name = entry.QualName
+			tags = append(tags, &qual)
+		}
+	}
+	return tags
+}
+
+// WriteCtags writes a tags file of the declarations and definitions of the
+// given files to w, in the extended format of universal-ctags, sorted by tag
+// name; e.g.
+//
+//    f	foo.c	/^int f(int a) {$/;"	f	line:3	typeref:typename:int(int a)
+//    f.a	foo.c	/^int f(int a) {$/;"	z	line:3	function:f	typeref:typename:int
+//    h	foo.c	/^static int h;$/;"	v	line:7	typeref:typename:int	file:
+func WriteCtags(w io.Writer, files []*File) error {
+	var tags []*tag
+	for _, f := range files {
+		tags = append(tags, f.tags()...)
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		if tags[i].name != tags[j].name {
+			return tags[i].name < tags[j].name
+		}
+		if tags[i].path != tags[j].path {
+			return tags[i].path < tags[j].path
+		}
+		return tags[i].line < tags[j].line
+	})
+	buf := &bytes.Buffer{}
+	buf.WriteString("!_TAG_FILE_FORMAT\t2\t/extended format; --format=1 will not append ;\" to lines/\n")
+	buf.WriteString("!_TAG_FILE_SORTED\t1\t/0=unsorted, 1=sorted, 2=foldcase/\n")
+	buf.WriteString("!_TAG_PROGRAM_NAME\tuxref\t//\n")
+	for _, t := range tags {
+		fmt.Fprintf(buf, "%s\t%s\t/^%s$/;\"\t%s\tline:%d", t.name, t.path, escapePattern(t.text), t.entry.Kind.Letter(), t.line)
+		if len(t.entry.Scope) > 0 {
+			fmt.Fprintf(buf, "\tfunction:%s", t.entry.Scope)
+		}
+		fmt.Fprintf(buf, "\ttyperef:typename:%s", t.entry.Decl.Type())
+		if t.entry.Linkage == sem.Internal {
+			// Symbols with internal linkage are only visible within the file.
+			buf.WriteString("\tfile:")
+		}
+		buf.WriteString("\n")
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// escapePattern escapes the backslashes and slashes of the given search
+// pattern of a tags file.
+func escapePattern(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return strings.Replace(s, `/`, `\/`, -1)
+}
+
+// WriteEtags writes a tags file of the declarations and definitions of the
+// given files to w, in the format of etags. Each file is described by a
+// section, which lists the tags of the file in source order; e.g.
+//
+//    \f
+//    foo.c,42
+//    int f(int a) {\x7Ff\x013,0
+//    int f(int a) {\x7Ff.a\x013,0
+func WriteEtags(w io.Writer, files []*File) error {
+	buf := &bytes.Buffer{}
+	for _, f := range files {
+		section := &bytes.Buffer{}
+		for _, t := range f.tags() {
+			// The tag pattern is the content of the line up to and including the
+			// identifier of the tag.
+			end := int(t.entry.Ident.End()) - f.Src.File.Base() - t.lineStart
+			fmt.Fprintf(section, "%s\x7f%s\x01%d,%d\n", t.text[:end], t.name, t.line, t.lineStart)
+		}
+		fmt.Fprintf(buf, "\f\n%s,%d\n", f.Src.Path, section.Len())
+		buf.Write(section.Bytes())
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+// Package xref implements cross-reference indexing of resolved parse trees,
+// and the generation of tags files from the index.
+//
+// The index records every declaration, definition and use of the symbols
+// declared within a source file. Symbols are identified by qualified names,
+// which are prefixed by the names of the enclosing functions of local symbols,
+// and by the numbers of the enclosing nested blocks of each function (in
+// source order, starting at 1), separated by periods; e.g.
+//
+//    x        global variable x, or local extern declaration of x
+//    f        function f
+//    f.a      parameter a of function f
+//    f.g      nested function g of function f
+//    f.g.y    local variable y of nested function g of function f
+//    f.1.y    local variable y of the first nested block of function f
+package xref
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/sem/errors"
+)
+
+// A File is the cross-reference index of a source file.
+type File struct {
+	// Input source of the file.
+	Src *errors.Source
+	// Entries of the index, in source order.
+	Entries []*Entry
+}
+
+// An Entry is a declaration, definition or use of a symbol.
+type Entry struct {
+	// Name of the symbol.
+	Name string
+	// Qualified name of the symbol; equal to Name for symbols declared at file
+	// scope and for block scope declarations of symbols with linkage (e.g.
+	// extern variables).
+	QualName string
+	// Qualified name of the function enclosing the declaration of the symbol
+	// (excluding the numbers of nested blocks within the function); or empty if
+	// not local to a function.
+	Scope string
+	// Kind of the symbol.
+	Kind Kind
+	// Role of the entry.
+	Role Role
+	// Linkage of the symbol.
+	Linkage sem.Linkage
+	// Identifier of the entry.
+	Ident *ast.Ident
+	// Declaration of the symbol; for declarations and definitions, the
+	// declaration of the entry itself.
+	Decl ast.Decl
+}
+
+// Kind specifies the kind of a symbol.
+type Kind uint8
+
+// Symbol kinds.
+const (
+	// Function definition.
+	Function Kind = iota
+	// Function prototype.
+	Prototype
+	// Global variable.
+	Variable
+	// Extern variable declaration.
+	ExternVariable
+	// Local variable.
+	Local
+	// Function parameter.
+	Parameter
+	// Type definition.
+	TypeDef
+)
+
+// String returns the name of the symbol kind, as used by universal-ctags.
+func (kind Kind) String() string {
+	switch kind {
+	case Function:
+		return "function"
+	case Prototype:
+		return "prototype"
+	case Variable:
+		return "variable"
+	case ExternVariable:
+		return "externvar"
+	case Local:
+		return "local"
+	case Parameter:
+		return "parameter"
+	case TypeDef:
+		return "typedef"
+	default:
+		return fmt.Sprintf("unknown symbol kind (%d)", uint8(kind))
+	}
+}
+
+// Letter returns the single-letter name of the symbol kind, as used by
+// universal-ctags.
+func (kind Kind) Letter() string {
+	switch kind {
+	case Function:
+		return "f"
+	case Prototype:
+		return "p"
+	case Variable:
+		return "v"
+	case ExternVariable:
+		return "x"
+	case Local:
+		return "l"
+	case Parameter:
+		return "z"
+	case TypeDef:
+		return "t"
+	default:
+		return "?"
+	}
+}
+
+// Role specifies the role of an entry.
+type Role uint8
+
+// Entry roles.
+const (
+	// Definition of the symbol.
+	Definition Role = iota
+	// Declaration of the symbol, which is not a definition.
+	Declaration
+	// Use of the symbol.
+	Use
+)
+
+// String returns the name of the entry role.
+func (role Role) String() string {
+	switch role {
+	case Definition:
+		return "definition"
+	case Declaration:
+		return "declaration"
+	case Use:
+		return "use"
+	default:
+		return fmt.Sprintf("unknown entry role (%d)", uint8(role))
+	}
+}
+
+// A symbol holds the scope information of a declaration.
+type symbol struct {
+	// Scope in which the symbol is declared.
+	scope *sem.Scope
+	// Enclosing functions of the declaration, from outermost to innermost.
+	funcs []*ast.FuncDecl
+	// Qualifier of local symbols; the names of the enclosing functions and the
+	// numbers of the enclosing nested blocks.
+	path []string
+	// Length of the qualifier of the innermost enclosing function.
+	fnLen int
+	// Parameter declaration.
+	param bool
+}
+
+// Index returns the cross-reference index of the given resolved parse tree of
+// a source file. Identifiers referring to declarations outside of the file
+// (e.g. predeclared types) are omitted.
+func Index(src *errors.Source, file *ast.File, info *sem.Info) *File {
+	syms := make(map[ast.Decl]*symbol)
+	// Map from declared identifiers to their declarations.
+	names := make(map[*ast.Ident]ast.Decl)
+	var idents []*ast.Ident
+	scopes := info.EnclosingScopes(file)
+	var funcs []*ast.FuncDecl
+	var path []string
+	// Number of nested blocks within each enclosing function, and the length of
+	// the qualifier of each enclosing function.
+	var nblocks, fnLens []int
+	params := make(map[*ast.VarDecl]bool)
+	before := func(n ast.Node) (bool, error) {
+		switch n := n.(type) {
+		case ast.Decl:
+			if name := n.Name(); name != nil {
+				names[name] = n
+				sym := &symbol{scope: scopes[n], funcs: funcs, path: path}
+				if len(fnLens) > 0 {
+					sym.fnLen = fnLens[len(fnLens)-1]
+				}
+				if n, ok := n.(*ast.VarDecl); ok {
+					sym.param = params[n]
+				}
+				syms[n] = sym
+			}
+		case *ast.FuncType:
+			for _, param := range n.Params {
+				params[param] = true
+			}
+		case *ast.Ident:
+			idents = append(idents, n)
+		}
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			funcs = append(funcs[:len(funcs):len(funcs)], n)
+			path = append(path[:len(path):len(path)], n.FuncName.Name)
+			nblocks = append(nblocks, 0)
+			fnLens = append(fnLens, len(path))
+		case *ast.BlockStmt:
+			if _, ok := info.Scopes[n]; ok && len(nblocks) > 0 {
+				nblocks[len(nblocks)-1]++
+				path = append(path[:len(path):len(path)], strconv.Itoa(nblocks[len(nblocks)-1]))
+			}
+		}
+		return true, nil
+	}
+	after := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			funcs = funcs[:len(funcs)-1]
+			path = path[:len(path)-1]
+			nblocks = nblocks[:len(nblocks)-1]
+			fnLens = fnLens[:len(fnLens)-1]
+		case *ast.BlockStmt:
+			if _, ok := info.Scopes[n]; ok && len(nblocks) > 0 {
+				path = path[:len(path)-1]
+			}
+		}
+		return nil
+	}
+	astutil.InspectBeforeAfter(file, before, after)
+	sort.SliceStable(idents, func(i, j int) bool {
+		return idents[i].Start() < idents[j].Start()
+	})
+
+	f := &File{Src: src}
+	for _, ident := range idents {
+		decl, isDecl := names[ident]
+		if !isDecl {
+			decl = ident.Decl
+		}
+		sym, ok := syms[decl]
+		if !ok {
+			// Predeclared or unresolved identifier.
+			continue
+		}
+		entry := &Entry{
+			Name:     ident.Name,
+			QualName: ident.Name,
+			Kind:     sym.kind(decl),
+			Role:     Use,
+			Linkage:  sym.scope.Linkages[decl.Name().Name],
+			Ident:    ident,
+			Decl:     decl,
+		}
+		if isDecl {
+			entry.Role = sym.role(decl)
+		}
+		if sym.isLocal(decl) {
+			entry.Scope = strings.Join(sym.path[:sym.fnLen], ".")
+			entry.QualName = strings.Join(sym.path, ".") + "." + ident.Name
+		}
+		f.Entries = append(f.Entries, entry)
+	}
+	return f
+}
+
+// isLocal reports whether the given declaration is local to a function; i.e.
+// declared in block scope, and either without linkage or a nested function
+// definition.
+func (sym *symbol) isLocal(decl ast.Decl) bool {
+	if len(sym.funcs) == 0 {
+		return false
+	}
+	if _, ok := decl.(*ast.FuncDecl); ok && astutil.IsDef(decl) {
+		return true
+	}
+	return sym.scope.Linkages[decl.Name().Name] == sem.NoLinkage
+}
+
+// kind returns the symbol kind of the given declaration.
+func (sym *symbol) kind(decl ast.Decl) Kind {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if astutil.IsDef(decl) {
+			return Function
+		}
+		return Prototype
+	case *ast.VarDecl:
+		switch {
+		case sym.param:
+			return Parameter
+		case !astutil.IsDef(decl):
+			return ExternVariable
+		case sym.scope.IsFile:
+			return Variable
+		default:
+			return Local
+		}
+	case *ast.TypeDef:
+		return TypeDef
+	default:
+		panic(fmt.Sprintf("support for %T not yet implemented", decl))
+	}
+}
+
+// role returns the role of the given declaration. Tentative definitions of
+// global variables are definitions. Parameters of function prototypes are
+// declarations, and the parameters of function definitions are definitions.
+func (sym *symbol) role(decl ast.Decl) Role {
+	if sym.param {
+		if astutil.IsDef(sym.funcs[len(sym.funcs)-1]) {
+			return Definition
+		}
+		return Declaration
+	}
+	if astutil.IsDef(decl) {
+		return Definition
+	}
+	return Declaration
+}
@@ -0,0 +1,139 @@
+package xref_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/mewmew/uc/hand/parser"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/xref"
+)
+
+const input = `typedef int T;
+int x;
+int f(int a);
+int f(int a) {
+	T y;
+	extern int z;
+	int g(void) { return y; }
+	y = a + x;
+	{
+		int y;
+		y = a;
+	}
+	return g() / z;
+}
+static int h;
+`
+
+// index returns the cross-reference index of the input.
+func index(t *testing.T) *xref.File {
+	src := semerrors.NewSource("foo.c", input)
+	file, err := parser.ParseString(input, src)
+	if err != nil {
+		t.Fatalf("unable to parse input; %v", err)
+	}
+	info, err := sem.Check(file)
+	if err != nil {
+		t.Fatalf("unable to check input; %v", err)
+	}
+	return xref.Index(src, file, info)
+}
+
+func TestIndex(t *testing.T) {
+	want := []string{
+		"1:13 T T typedef definition",
+		"2:5 x x variable definition",
+		"3:5 f f prototype declaration",
+		"3:11 a f.a parameter declaration",
+		"4:5 f f function definition",
+		"4:11 a f.a parameter definition",
+		"5:2 T T typedef use",
+		"5:4 y f.y local definition",
+		"6:13 z z externvar declaration",
+		"7:6 g f.g function definition",
+		"7:23 y f.y local use",
+		"8:2 y f.y local use",
+		"8:6 a f.a parameter use",
+		"8:10 x x variable use",
+		"10:7 y f.1.y local definition",
+		"11:3 y f.1.y local use",
+		"11:7 a f.a parameter use",
+		"13:9 g f.g function use",
+		"13:15 z z externvar use",
+		"15:12 h h variable definition",
+	}
+	f := index(t)
+	var got []string
+	for _, entry := range f.Entries {
+		line, col := f.Src.Position(entry.Ident.Start())
+		got = append(got, fmt.Sprintf("%d:%d %s %s %v %v", line, col, entry.Name, entry.QualName, entry.Kind, entry.Role))
+	}
+	if len(got) != len(want) {
+		t.Fatalf("entries mismatch; expected %q, got %q", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d mismatch; expected %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestWriteCtags(t *testing.T) {
+	const want = `!_TAG_FILE_FORMAT	2	/extended format; --format=1 will not append ;" to lines/
+!_TAG_FILE_SORTED	1	/0=unsorted, 1=sorted, 2=foldcase/
+!_TAG_PROGRAM_NAME	uxref	//
+T	foo.c	/^typedef int T;$/;"	t	line:1	typeref:typename:int
+a	foo.c	/^int f(int a);$/;"	z	line:3	function:f	typeref:typename:int
+a	foo.c	/^int f(int a) {$/;"	z	line:4	function:f	typeref:typename:int
+f	foo.c	/^int f(int a);$/;"	p	line:3	typeref:typename:int(int a)
+f	foo.c	/^int f(int a) {$/;"	f	line:4	typeref:typename:int(int a)
+f.1.y	foo.c	/^		int y;$/;"	l	line:10	function:f	typeref:typename:int
+f.a	foo.c	/^int f(int a);$/;"	z	line:3	function:f	typeref:typename:int
+f.a	foo.c	/^int f(int a) {$/;"	z	line:4	function:f	typeref:typename:int
+f.g	foo.c	/^	int g(void) { return y; }$/;"	f	line:7	function:f	typeref:typename:int(void)
+f.y	foo.c	/^	T y;$/;"	l	line:5	function:f	typeref:typename:int
+g	foo.c	/^	int g(void) { return y; }$/;"	f	line:7	function:f	typeref:typename:int(void)
+h	foo.c	/^static int h;$/;"	v	line:15	typeref:typename:int	file:
+x	foo.c	/^int x;$/;"	v	line:2	typeref:typename:int
+y	foo.c	/^	T y;$/;"	l	line:5	function:f	typeref:typename:int
+y	foo.c	/^		int y;$/;"	l	line:10	function:f	typeref:typename:int
+z	foo.c	/^	extern int z;$/;"	x	line:6	typeref:typename:int
+`
+	buf := &bytes.Buffer{}
+	if err := xref.WriteCtags(buf, []*xref.File{index(t)}); err != nil {
+		t.Fatalf("unable to write tags; %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("tags mismatch; expected %q, got %q", want, got)
+	}
+}
+
+func TestWriteEtags(t *testing.T) {
+	const section = "typedef int T\x7fT\x011,0\n" +
+		"int x\x7fx\x012,15\n" +
+		"int f\x7ff\x013,22\n" +
+		"int f(int a\x7fa\x013,22\n" +
+		"int f(int a\x7ff.a\x013,22\n" +
+		"int f\x7ff\x014,36\n" +
+		"int f(int a\x7fa\x014,36\n" +
+		"int f(int a\x7ff.a\x014,36\n" +
+		"\tT y\x7fy\x015,51\n" +
+		"\tT y\x7ff.y\x015,51\n" +
+		"\textern int z\x7fz\x016,57\n" +
+		"\tint g\x7fg\x017,72\n" +
+		"\tint g\x7ff.g\x017,72\n" +
+		"\t\tint y\x7fy\x0110,114\n" +
+		"\t\tint y\x7ff.1.y\x0110,114\n" +
+		"static int h\x7fh\x0115,154\n"
+	want := fmt.Sprintf("\f\nfoo.c,%d\n%s", len(section), section)
+	buf := &bytes.Buffer{}
+	if err := xref.WriteEtags(buf, []*xref.File{index(t)}); err != nil {
+		t.Fatalf("unable to write tags; %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("tags mismatch; expected %q, got %q", want, got)
+	}
+}